@@ -1,9 +1,50 @@
+//go:build darwin && cgo && !purego
+
 package accel
 
 // #include <Accelerate/Accelerate.h>
 // #cgo LDFLAGS: -framework Accelerate
 import "C"
 
+import "unsafe"
+
+func toError(code C.vImage_Error) error {
+	switch code {
+	case C.kvImageNoError:
+		return nil
+	case C.kvImageRoiLargerThanInputBuffer:
+		return ErrImageRoiLargerThanInputBuffer
+	case C.kvImageInvalidKernelSize:
+		return ErrImageInvalidKernelSize
+	case C.kvImageInvalidEdgeStyle:
+		return ErrImageInvalidEdgeStyle
+	case C.kvImageInvalidOffset_X:
+		return ErrImageInvalidOffsetX
+	case C.kvImageInvalidOffset_Y:
+		return ErrImageInvalidOffsetY
+	case C.kvImageMemoryAllocationError:
+		return ErrImageMemoryAllocationError
+	case C.kvImageNullPointerArgument:
+		return ErrImageNullPointerArgument
+	case C.kvImageInvalidParameter:
+		return ErrImageInvalidParameter
+	case C.kvImageBufferSizeMismatch:
+		return ErrImageBufferSizeMismatch
+	case C.kvImageUnknownFlagsBit:
+		return ErrImageUnknownFlagsBit
+	}
+	return ErrOther(int(code))
+}
+
+func (vib *VImageBuffer) toC() C.vImage_Buffer {
+	var cv C.vImage_Buffer
+	cv.data = unsafe.Pointer(&vib.Data[0])
+	cv.width = C.vImagePixelCount(vib.Width)
+	cv.height = C.vImagePixelCount(vib.Height)
+	cv.rowBytes = C.size_t(vib.RowBytes)
+	return cv
+}
+
 // VImagePermuteChannels_ARGB8888 reorders the channels in an ARGB8888 image.
 //
 // permuteMap:
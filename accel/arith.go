@@ -0,0 +1,235 @@
+//go:build darwin && cgo && !purego
+
+package accel
+
+// #include <Accelerate/Accelerate.h>
+import "C"
+
+// Vsub subtracts input2 from input1. vDSP_vsub takes the subtrahend
+// first; the operands here are in the same order as for Vadd.
+func Vsub(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	C.vDSP_vsub((*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// Vdiv divides input1 by input2. vDSP_vdiv takes the divisor first; the
+// operands here are in the same order as for Vmul.
+func Vdiv(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	C.vDSP_vdiv((*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// Vsmul multiplies every element of input by mult.
+func Vsmul(input []float32, inputStride int, mult float32, output []float32, outputStride int) {
+	C.vDSP_vsmul((*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.float)(&mult), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// Svdiv divides numerator by every element of input.
+func Svdiv(numerator float32, input []float32, inputStride int, output []float32, outputStride int) {
+	C.vDSP_svdiv((*C.float)(&numerator), (*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// Vma is vector multiply and add: output = input1*input2 + input3.
+func Vma(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	C.vDSP_vma((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&input3[0]), C.vDSP_Stride(stride3), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(output)/outputStride))
+}
+
+// Vmsa is vector multiply and scalar add: output = input1*input2 + add.
+func Vmsa(input1 []float32, stride1 int, input2 []float32, stride2 int, add float32, output []float32, outputStride int) {
+	C.vDSP_vmsa((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&add), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// Vmsb is vector multiply and subtract: output = input1*input2 - input3.
+func Vmsb(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	C.vDSP_vmsb((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&input3[0]), C.vDSP_Stride(stride3), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(output)/outputStride))
+}
+
+// Vam is vector add and multiply: output = (input1+input2) * input3.
+func Vam(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	C.vDSP_vam((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&input3[0]), C.vDSP_Stride(stride3), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(output)/outputStride))
+}
+
+// Vsbm is vector subtract and multiply: output = (input1-input2) * input3.
+func Vsbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	C.vDSP_vsbm((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&input3[0]), C.vDSP_Stride(stride3), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(output)/outputStride))
+}
+
+// Vasm is vector add and scalar multiply: output = (input1+input2) * mult.
+func Vasm(input1 []float32, stride1 int, input2 []float32, stride2 int, mult float32, output []float32, outputStride int) {
+	C.vDSP_vasm((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&mult), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// Vsbsm is vector subtract and scalar multiply: output = (input1-input2) * mult.
+func Vsbsm(input1 []float32, stride1 int, input2 []float32, stride2 int, mult float32, output []float32, outputStride int) {
+	C.vDSP_vsbsm((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&mult), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// Vsma is vector scalar multiply and vector add: output = input1*mult + input2.
+func Vsma(input1 []float32, stride1 int, mult float32, input2 []float32, stride2 int, output []float32, outputStride int) {
+	C.vDSP_vsma((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&mult), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// Vsmsb is vector scalar multiply and vector subtract: output = input1*mult - input2.
+func Vsmsb(input1 []float32, stride1 int, mult float32, input2 []float32, stride2 int, output []float32, outputStride int) {
+	C.vDSP_vsmsb((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&mult), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// Vmma is vector multiply, multiply and add: output = input1*input2 + input3*input4.
+func Vmma(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	C.vDSP_vmma((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&input3[0]), C.vDSP_Stride(stride3), (*C.float)(&input4[0]), C.vDSP_Stride(stride4), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(input4)/stride4, len(output)/outputStride))
+}
+
+// Vmmsb is vector multiply, multiply and subtract: output = input1*input2 - input3*input4.
+func Vmmsb(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	C.vDSP_vmmsb((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&input3[0]), C.vDSP_Stride(stride3), (*C.float)(&input4[0]), C.vDSP_Stride(stride4), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(input4)/stride4, len(output)/outputStride))
+}
+
+// Vaam is vector add, add and multiply: output = (input1+input2) * (input3+input4).
+func Vaam(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	C.vDSP_vaam((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&input3[0]), C.vDSP_Stride(stride3), (*C.float)(&input4[0]), C.vDSP_Stride(stride4), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(input4)/stride4, len(output)/outputStride))
+}
+
+// Vsbsbm is vector subtract, subtract and multiply: output = (input1-input2) * (input3-input4).
+func Vsbsbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	C.vDSP_vsbsbm((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&input3[0]), C.vDSP_Stride(stride3), (*C.float)(&input4[0]), C.vDSP_Stride(stride4), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(input4)/stride4, len(output)/outputStride))
+}
+
+// Vasbm is vector add, subtract and multiply: output = (input1+input2) * (input3-input4).
+func Vasbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	C.vDSP_vasbm((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&input3[0]), C.vDSP_Stride(stride3), (*C.float)(&input4[0]), C.vDSP_Stride(stride4), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(input4)/stride4, len(output)/outputStride))
+}
+
+// Vintb interpolates linearly between two vectors: output = input1 + fraction*(input2-input1).
+func Vintb(input1 []float32, stride1 int, input2 []float32, stride2 int, fraction float32, output []float32, outputStride int) {
+	C.vDSP_vintb((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&fraction), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// Vdist stores the distance sqrt(input1² + input2²) for every pair of elements.
+func Vdist(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	C.vDSP_vdist((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// Vpoly evaluates the polynomial with the given coefficients, highest degree
+// first, at every element of input. The degree of the polynomial is
+// len(coefficients)/coeffStride - 1; Vpoly panics with ErrLengthMismatch if
+// that is negative.
+func Vpoly(coefficients []float32, coeffStride int, input []float32, inputStride int, output []float32, outputStride int) {
+	degree := vpolyDegree(len(coefficients), coeffStride)
+	C.vDSP_vpoly((*C.float)(&coefficients[0]), C.vDSP_Stride(coeffStride), (*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride), C.vDSP_Length(degree))
+}
+
+// Vlint interpolates linearly in table at every fractional index in indices.
+// An index with integer part i and fraction f gives
+// table[i] + f*(table[i+1]-table[i]), so i must lie in [0, len(table)-2].
+func Vlint(table []float32, indices []float32, indicesStride int, output []float32, outputStride int) {
+	C.vDSP_vlint((*C.float)(&table[0]), (*C.float)(&indices[0]), C.vDSP_Stride(indicesStride), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(indices)/indicesStride, len(output)/outputStride), C.vDSP_Length(len(table)))
+}
+
+// VsubD is the double-precision version of Vsub.
+func VsubD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	C.vDSP_vsubD((*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// VdivD is the double-precision version of Vdiv.
+func VdivD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	C.vDSP_vdivD((*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// VsmulD is the double-precision version of Vsmul.
+func VsmulD(input []float64, inputStride int, mult float64, output []float64, outputStride int) {
+	C.vDSP_vsmulD((*C.double)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&mult), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// SvdivD is the double-precision version of Svdiv.
+func SvdivD(numerator float64, input []float64, inputStride int, output []float64, outputStride int) {
+	C.vDSP_svdivD((*C.double)(&numerator), (*C.double)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// VmaD is the double-precision version of Vma.
+func VmaD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	C.vDSP_vmaD((*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&input3[0]), C.vDSP_Stride(stride3), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(output)/outputStride))
+}
+
+// VmsaD is the double-precision version of Vmsa.
+func VmsaD(input1 []float64, stride1 int, input2 []float64, stride2 int, add float64, output []float64, outputStride int) {
+	C.vDSP_vmsaD((*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&add), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// VmsbD is the double-precision version of Vmsb.
+func VmsbD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	C.vDSP_vmsbD((*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&input3[0]), C.vDSP_Stride(stride3), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(output)/outputStride))
+}
+
+// VamD is the double-precision version of Vam.
+func VamD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	C.vDSP_vamD((*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&input3[0]), C.vDSP_Stride(stride3), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(output)/outputStride))
+}
+
+// VsbmD is the double-precision version of Vsbm.
+func VsbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	C.vDSP_vsbmD((*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&input3[0]), C.vDSP_Stride(stride3), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(output)/outputStride))
+}
+
+// VasmD is the double-precision version of Vasm.
+func VasmD(input1 []float64, stride1 int, input2 []float64, stride2 int, mult float64, output []float64, outputStride int) {
+	C.vDSP_vasmD((*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&mult), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// VsbsmD is the double-precision version of Vsbsm.
+func VsbsmD(input1 []float64, stride1 int, input2 []float64, stride2 int, mult float64, output []float64, outputStride int) {
+	C.vDSP_vsbsmD((*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&mult), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// VsmaD is the double-precision version of Vsma.
+func VsmaD(input1 []float64, stride1 int, mult float64, input2 []float64, stride2 int, output []float64, outputStride int) {
+	C.vDSP_vsmaD((*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&mult), (*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// VsmsbD is the double-precision version of Vsmsb.
+func VsmsbD(input1 []float64, stride1 int, mult float64, input2 []float64, stride2 int, output []float64, outputStride int) {
+	C.vDSP_vsmsbD((*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&mult), (*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// VmmaD is the double-precision version of Vmma.
+func VmmaD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	C.vDSP_vmmaD((*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&input3[0]), C.vDSP_Stride(stride3), (*C.double)(&input4[0]), C.vDSP_Stride(stride4), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(input4)/stride4, len(output)/outputStride))
+}
+
+// VmmsbD is the double-precision version of Vmmsb.
+func VmmsbD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	C.vDSP_vmmsbD((*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&input3[0]), C.vDSP_Stride(stride3), (*C.double)(&input4[0]), C.vDSP_Stride(stride4), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(input4)/stride4, len(output)/outputStride))
+}
+
+// VaamD is the double-precision version of Vaam.
+func VaamD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	C.vDSP_vaamD((*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&input3[0]), C.vDSP_Stride(stride3), (*C.double)(&input4[0]), C.vDSP_Stride(stride4), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(input4)/stride4, len(output)/outputStride))
+}
+
+// VsbsbmD is the double-precision version of Vsbsbm.
+func VsbsbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	C.vDSP_vsbsbmD((*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&input3[0]), C.vDSP_Stride(stride3), (*C.double)(&input4[0]), C.vDSP_Stride(stride4), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(input4)/stride4, len(output)/outputStride))
+}
+
+// VasbmD is the double-precision version of Vasbm.
+func VasbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	C.vDSP_vasbmD((*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&input3[0]), C.vDSP_Stride(stride3), (*C.double)(&input4[0]), C.vDSP_Stride(stride4), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(input4)/stride4, len(output)/outputStride))
+}
+
+// VintbD is the double-precision version of Vintb.
+func VintbD(input1 []float64, stride1 int, input2 []float64, stride2 int, fraction float64, output []float64, outputStride int) {
+	C.vDSP_vintbD((*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&fraction), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// VdistD is the double-precision version of Vdist.
+func VdistD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	C.vDSP_vdistD((*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// VpolyD is the double-precision version of Vpoly.
+func VpolyD(coefficients []float64, coeffStride int, input []float64, inputStride int, output []float64, outputStride int) {
+	degree := vpolyDegree(len(coefficients), coeffStride)
+	C.vDSP_vpolyD((*C.double)(&coefficients[0]), C.vDSP_Stride(coeffStride), (*C.double)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride), C.vDSP_Length(degree))
+}
+
+// VlintD is the double-precision version of Vlint.
+func VlintD(table []float64, indices []float64, indicesStride int, output []float64, outputStride int) {
+	C.vDSP_vlintD((*C.double)(&table[0]), (*C.double)(&indices[0]), C.vDSP_Stride(indicesStride), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(indices)/indicesStride, len(output)/outputStride), C.vDSP_Length(len(table)))
+}
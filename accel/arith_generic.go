@@ -0,0 +1,176 @@
+package accel
+
+import "math"
+
+func vsubGeneric[T floating](input1 []T, stride1 int, input2 []T, stride2 int, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = input1[i*stride1] - input2[i*stride2]
+	}
+}
+
+func vdivGeneric[T floating](input1 []T, stride1 int, input2 []T, stride2 int, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = input1[i*stride1] / input2[i*stride2]
+	}
+}
+
+func vsmulGeneric[T floating](input []T, inputStride int, mult T, output []T, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = input[i*inputStride] * mult
+	}
+}
+
+func svdivGeneric[T floating](numerator T, input []T, inputStride int, output []T, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = numerator / input[i*inputStride]
+	}
+}
+
+func vmaGeneric[T floating](input1 []T, stride1 int, input2 []T, stride2 int, input3 []T, stride3 int, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = input1[i*stride1]*input2[i*stride2] + input3[i*stride3]
+	}
+}
+
+func vmsaGeneric[T floating](input1 []T, stride1 int, input2 []T, stride2 int, add T, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = input1[i*stride1]*input2[i*stride2] + add
+	}
+}
+
+func vmsbGeneric[T floating](input1 []T, stride1 int, input2 []T, stride2 int, input3 []T, stride3 int, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = input1[i*stride1]*input2[i*stride2] - input3[i*stride3]
+	}
+}
+
+func vamGeneric[T floating](input1 []T, stride1 int, input2 []T, stride2 int, input3 []T, stride3 int, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = (input1[i*stride1] + input2[i*stride2]) * input3[i*stride3]
+	}
+}
+
+func vsbmGeneric[T floating](input1 []T, stride1 int, input2 []T, stride2 int, input3 []T, stride3 int, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = (input1[i*stride1] - input2[i*stride2]) * input3[i*stride3]
+	}
+}
+
+func vasmGeneric[T floating](input1 []T, stride1 int, input2 []T, stride2 int, mult T, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = (input1[i*stride1] + input2[i*stride2]) * mult
+	}
+}
+
+func vsbsmGeneric[T floating](input1 []T, stride1 int, input2 []T, stride2 int, mult T, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = (input1[i*stride1] - input2[i*stride2]) * mult
+	}
+}
+
+func vsmaGeneric[T floating](input1 []T, stride1 int, mult T, input2 []T, stride2 int, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = input1[i*stride1]*mult + input2[i*stride2]
+	}
+}
+
+func vsmsbGeneric[T floating](input1 []T, stride1 int, mult T, input2 []T, stride2 int, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = input1[i*stride1]*mult - input2[i*stride2]
+	}
+}
+
+func vmmaGeneric[T floating](input1 []T, stride1 int, input2 []T, stride2 int, input3 []T, stride3 int, input4 []T, stride4 int, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(input4)/stride4, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = input1[i*stride1]*input2[i*stride2] + input3[i*stride3]*input4[i*stride4]
+	}
+}
+
+func vmmsbGeneric[T floating](input1 []T, stride1 int, input2 []T, stride2 int, input3 []T, stride3 int, input4 []T, stride4 int, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(input4)/stride4, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = input1[i*stride1]*input2[i*stride2] - input3[i*stride3]*input4[i*stride4]
+	}
+}
+
+func vaamGeneric[T floating](input1 []T, stride1 int, input2 []T, stride2 int, input3 []T, stride3 int, input4 []T, stride4 int, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(input4)/stride4, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = (input1[i*stride1] + input2[i*stride2]) * (input3[i*stride3] + input4[i*stride4])
+	}
+}
+
+func vsbsbmGeneric[T floating](input1 []T, stride1 int, input2 []T, stride2 int, input3 []T, stride3 int, input4 []T, stride4 int, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(input4)/stride4, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = (input1[i*stride1] - input2[i*stride2]) * (input3[i*stride3] - input4[i*stride4])
+	}
+}
+
+func vasbmGeneric[T floating](input1 []T, stride1 int, input2 []T, stride2 int, input3 []T, stride3 int, input4 []T, stride4 int, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(input3)/stride3, len(input4)/stride4, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = (input1[i*stride1] + input2[i*stride2]) * (input3[i*stride3] - input4[i*stride4])
+	}
+}
+
+func vintbGeneric[T floating](input1 []T, stride1 int, input2 []T, stride2 int, fraction T, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = input1[i*stride1] + fraction*(input2[i*stride2]-input1[i*stride1])
+	}
+}
+
+func vdistGeneric[T floating](input1 []T, stride1 int, input2 []T, stride2 int, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = T(math.Hypot(float64(input1[i*stride1]), float64(input2[i*stride2])))
+	}
+}
+
+// vpolyDegree returns the degree of the polynomial that Vpoly evaluates and
+// panics if there isn't at least one coefficient.
+func vpolyDegree(coefficients, coeffStride int) int {
+	degree := coefficients/coeffStride - 1
+	if degree < 0 {
+		panic(ErrLengthMismatch)
+	}
+	return degree
+}
+
+func vpolyGeneric[T floating](coefficients []T, coeffStride int, input []T, inputStride int, output []T, outputStride int) {
+	degree := vpolyDegree(len(coefficients), coeffStride)
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		x := input[i*inputStride]
+		var y T
+		for p := 0; p <= degree; p++ {
+			y = y*x + coefficients[p*coeffStride]
+		}
+		output[i*outputStride] = y
+	}
+}
+
+func vlintGeneric[T floating](table []T, indices []T, indicesStride int, output []T, outputStride int) {
+	n := minLenGeneric(len(indices)/indicesStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		x := indices[i*indicesStride]
+		j := int(x)
+		f := x - T(j)
+		output[i*outputStride] = table[j] + f*(table[j+1]-table[j])
+	}
+}
@@ -0,0 +1,230 @@
+//go:build !darwin || !cgo || purego
+
+package accel
+
+// Vsub subtracts input2 from input1. vDSP_vsub takes the subtrahend
+// first; the operands here are in the same order as for Vadd.
+func Vsub(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	vsubGeneric(input1, stride1, input2, stride2, output, outputStride)
+}
+
+// Vdiv divides input1 by input2. vDSP_vdiv takes the divisor first; the
+// operands here are in the same order as for Vmul.
+func Vdiv(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	vdivGeneric(input1, stride1, input2, stride2, output, outputStride)
+}
+
+// Vsmul multiplies every element of input by mult.
+func Vsmul(input []float32, inputStride int, mult float32, output []float32, outputStride int) {
+	vsmulGeneric(input, inputStride, mult, output, outputStride)
+}
+
+// Svdiv divides numerator by every element of input.
+func Svdiv(numerator float32, input []float32, inputStride int, output []float32, outputStride int) {
+	svdivGeneric(numerator, input, inputStride, output, outputStride)
+}
+
+// Vma is vector multiply and add: output = input1*input2 + input3.
+func Vma(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	vmaGeneric(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+// Vmsa is vector multiply and scalar add: output = input1*input2 + add.
+func Vmsa(input1 []float32, stride1 int, input2 []float32, stride2 int, add float32, output []float32, outputStride int) {
+	vmsaGeneric(input1, stride1, input2, stride2, add, output, outputStride)
+}
+
+// Vmsb is vector multiply and subtract: output = input1*input2 - input3.
+func Vmsb(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	vmsbGeneric(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+// Vam is vector add and multiply: output = (input1+input2) * input3.
+func Vam(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	vamGeneric(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+// Vsbm is vector subtract and multiply: output = (input1-input2) * input3.
+func Vsbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	vsbmGeneric(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+// Vasm is vector add and scalar multiply: output = (input1+input2) * mult.
+func Vasm(input1 []float32, stride1 int, input2 []float32, stride2 int, mult float32, output []float32, outputStride int) {
+	vasmGeneric(input1, stride1, input2, stride2, mult, output, outputStride)
+}
+
+// Vsbsm is vector subtract and scalar multiply: output = (input1-input2) * mult.
+func Vsbsm(input1 []float32, stride1 int, input2 []float32, stride2 int, mult float32, output []float32, outputStride int) {
+	vsbsmGeneric(input1, stride1, input2, stride2, mult, output, outputStride)
+}
+
+// Vsma is vector scalar multiply and vector add: output = input1*mult + input2.
+func Vsma(input1 []float32, stride1 int, mult float32, input2 []float32, stride2 int, output []float32, outputStride int) {
+	vsmaGeneric(input1, stride1, mult, input2, stride2, output, outputStride)
+}
+
+// Vsmsb is vector scalar multiply and vector subtract: output = input1*mult - input2.
+func Vsmsb(input1 []float32, stride1 int, mult float32, input2 []float32, stride2 int, output []float32, outputStride int) {
+	vsmsbGeneric(input1, stride1, mult, input2, stride2, output, outputStride)
+}
+
+// Vmma is vector multiply, multiply and add: output = input1*input2 + input3*input4.
+func Vmma(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	vmmaGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+// Vmmsb is vector multiply, multiply and subtract: output = input1*input2 - input3*input4.
+func Vmmsb(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	vmmsbGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+// Vaam is vector add, add and multiply: output = (input1+input2) * (input3+input4).
+func Vaam(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	vaamGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+// Vsbsbm is vector subtract, subtract and multiply: output = (input1-input2) * (input3-input4).
+func Vsbsbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	vsbsbmGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+// Vasbm is vector add, subtract and multiply: output = (input1+input2) * (input3-input4).
+func Vasbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	vasbmGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+// Vintb interpolates linearly between two vectors: output = input1 + fraction*(input2-input1).
+func Vintb(input1 []float32, stride1 int, input2 []float32, stride2 int, fraction float32, output []float32, outputStride int) {
+	vintbGeneric(input1, stride1, input2, stride2, fraction, output, outputStride)
+}
+
+// Vdist stores the distance sqrt(input1² + input2²) for every pair of elements.
+func Vdist(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	vdistGeneric(input1, stride1, input2, stride2, output, outputStride)
+}
+
+// Vpoly evaluates the polynomial with the given coefficients, highest degree
+// first, at every element of input. The degree of the polynomial is
+// len(coefficients)/coeffStride - 1; Vpoly panics with ErrLengthMismatch if
+// that is negative.
+func Vpoly(coefficients []float32, coeffStride int, input []float32, inputStride int, output []float32, outputStride int) {
+	vpolyGeneric(coefficients, coeffStride, input, inputStride, output, outputStride)
+}
+
+// Vlint interpolates linearly in table at every fractional index in indices.
+// An index with integer part i and fraction f gives
+// table[i] + f*(table[i+1]-table[i]), so i must lie in [0, len(table)-2].
+func Vlint(table []float32, indices []float32, indicesStride int, output []float32, outputStride int) {
+	vlintGeneric(table, indices, indicesStride, output, outputStride)
+}
+
+// VsubD is the double-precision version of Vsub.
+func VsubD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	vsubGeneric(input1, stride1, input2, stride2, output, outputStride)
+}
+
+// VdivD is the double-precision version of Vdiv.
+func VdivD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	vdivGeneric(input1, stride1, input2, stride2, output, outputStride)
+}
+
+// VsmulD is the double-precision version of Vsmul.
+func VsmulD(input []float64, inputStride int, mult float64, output []float64, outputStride int) {
+	vsmulGeneric(input, inputStride, mult, output, outputStride)
+}
+
+// SvdivD is the double-precision version of Svdiv.
+func SvdivD(numerator float64, input []float64, inputStride int, output []float64, outputStride int) {
+	svdivGeneric(numerator, input, inputStride, output, outputStride)
+}
+
+// VmaD is the double-precision version of Vma.
+func VmaD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	vmaGeneric(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+// VmsaD is the double-precision version of Vmsa.
+func VmsaD(input1 []float64, stride1 int, input2 []float64, stride2 int, add float64, output []float64, outputStride int) {
+	vmsaGeneric(input1, stride1, input2, stride2, add, output, outputStride)
+}
+
+// VmsbD is the double-precision version of Vmsb.
+func VmsbD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	vmsbGeneric(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+// VamD is the double-precision version of Vam.
+func VamD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	vamGeneric(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+// VsbmD is the double-precision version of Vsbm.
+func VsbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	vsbmGeneric(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+// VasmD is the double-precision version of Vasm.
+func VasmD(input1 []float64, stride1 int, input2 []float64, stride2 int, mult float64, output []float64, outputStride int) {
+	vasmGeneric(input1, stride1, input2, stride2, mult, output, outputStride)
+}
+
+// VsbsmD is the double-precision version of Vsbsm.
+func VsbsmD(input1 []float64, stride1 int, input2 []float64, stride2 int, mult float64, output []float64, outputStride int) {
+	vsbsmGeneric(input1, stride1, input2, stride2, mult, output, outputStride)
+}
+
+// VsmaD is the double-precision version of Vsma.
+func VsmaD(input1 []float64, stride1 int, mult float64, input2 []float64, stride2 int, output []float64, outputStride int) {
+	vsmaGeneric(input1, stride1, mult, input2, stride2, output, outputStride)
+}
+
+// VsmsbD is the double-precision version of Vsmsb.
+func VsmsbD(input1 []float64, stride1 int, mult float64, input2 []float64, stride2 int, output []float64, outputStride int) {
+	vsmsbGeneric(input1, stride1, mult, input2, stride2, output, outputStride)
+}
+
+// VmmaD is the double-precision version of Vmma.
+func VmmaD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	vmmaGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+// VmmsbD is the double-precision version of Vmmsb.
+func VmmsbD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	vmmsbGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+// VaamD is the double-precision version of Vaam.
+func VaamD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	vaamGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+// VsbsbmD is the double-precision version of Vsbsbm.
+func VsbsbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	vsbsbmGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+// VasbmD is the double-precision version of Vasbm.
+func VasbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	vasbmGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+// VintbD is the double-precision version of Vintb.
+func VintbD(input1 []float64, stride1 int, input2 []float64, stride2 int, fraction float64, output []float64, outputStride int) {
+	vintbGeneric(input1, stride1, input2, stride2, fraction, output, outputStride)
+}
+
+// VdistD is the double-precision version of Vdist.
+func VdistD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	vdistGeneric(input1, stride1, input2, stride2, output, outputStride)
+}
+
+// VpolyD is the double-precision version of Vpoly.
+func VpolyD(coefficients []float64, coeffStride int, input []float64, inputStride int, output []float64, outputStride int) {
+	vpolyGeneric(coefficients, coeffStride, input, inputStride, output, outputStride)
+}
+
+// VlintD is the double-precision version of Vlint.
+func VlintD(table []float64, indices []float64, indicesStride int, output []float64, outputStride int) {
+	vlintGeneric(table, indices, indicesStride, output, outputStride)
+}
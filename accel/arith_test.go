@@ -0,0 +1,105 @@
+package accel
+
+import (
+	"math"
+	"testing"
+)
+
+func TestArithmetic(t *testing.T) {
+	a := []float32{1.5, -2, 3, 0.25, 4, -0.5}
+	b := []float32{0.5, 4, -1.5, 2, -3, 1}
+	c := []float32{2, -0.5, 1, 3, 0.75, -4}
+	d := []float32{-1, 2.5, 0.5, -2, 1, 3}
+	n := len(a)
+	cases := []struct {
+		name string
+		fn   func(out []float32)
+		want func(a, b, c, d float32) float32
+	}{
+		{"Vsub", func(o []float32) { Vsub(a, 1, b, 1, o, 1) }, func(a, b, c, d float32) float32 { return a - b }},
+		{"Vdiv", func(o []float32) { Vdiv(a, 1, b, 1, o, 1) }, func(a, b, c, d float32) float32 { return a / b }},
+		{"Vsmul", func(o []float32) { Vsmul(a, 1, 3, o, 1) }, func(a, b, c, d float32) float32 { return a * 3 }},
+		{"Svdiv", func(o []float32) { Svdiv(3, b, 1, o, 1) }, func(a, b, c, d float32) float32 { return 3 / b }},
+		{"Vma", func(o []float32) { Vma(a, 1, b, 1, c, 1, o, 1) }, func(a, b, c, d float32) float32 { return a*b + c }},
+		{"Vmsa", func(o []float32) { Vmsa(a, 1, b, 1, 2, o, 1) }, func(a, b, c, d float32) float32 { return a*b + 2 }},
+		{"Vmsb", func(o []float32) { Vmsb(a, 1, b, 1, c, 1, o, 1) }, func(a, b, c, d float32) float32 { return a*b - c }},
+		{"Vam", func(o []float32) { Vam(a, 1, b, 1, c, 1, o, 1) }, func(a, b, c, d float32) float32 { return (a + b) * c }},
+		{"Vsbm", func(o []float32) { Vsbm(a, 1, b, 1, c, 1, o, 1) }, func(a, b, c, d float32) float32 { return (a - b) * c }},
+		{"Vasm", func(o []float32) { Vasm(a, 1, b, 1, 2, o, 1) }, func(a, b, c, d float32) float32 { return (a + b) * 2 }},
+		{"Vsbsm", func(o []float32) { Vsbsm(a, 1, b, 1, 2, o, 1) }, func(a, b, c, d float32) float32 { return (a - b) * 2 }},
+		{"Vsma", func(o []float32) { Vsma(a, 1, 2, b, 1, o, 1) }, func(a, b, c, d float32) float32 { return a*2 + b }},
+		{"Vsmsb", func(o []float32) { Vsmsb(a, 1, 2, b, 1, o, 1) }, func(a, b, c, d float32) float32 { return a*2 - b }},
+		{"Vmma", func(o []float32) { Vmma(a, 1, b, 1, c, 1, d, 1, o, 1) }, func(a, b, c, d float32) float32 { return a*b + c*d }},
+		{"Vmmsb", func(o []float32) { Vmmsb(a, 1, b, 1, c, 1, d, 1, o, 1) }, func(a, b, c, d float32) float32 { return a*b - c*d }},
+		{"Vaam", func(o []float32) { Vaam(a, 1, b, 1, c, 1, d, 1, o, 1) }, func(a, b, c, d float32) float32 { return (a + b) * (c + d) }},
+		{"Vsbsbm", func(o []float32) { Vsbsbm(a, 1, b, 1, c, 1, d, 1, o, 1) }, func(a, b, c, d float32) float32 { return (a - b) * (c - d) }},
+		{"Vasbm", func(o []float32) { Vasbm(a, 1, b, 1, c, 1, d, 1, o, 1) }, func(a, b, c, d float32) float32 { return (a + b) * (c - d) }},
+		{"Vintb", func(o []float32) { Vintb(a, 1, b, 1, 0.25, o, 1) }, func(a, b, c, d float32) float32 { return a + 0.25*(b-a) }},
+		{"Vdist", func(o []float32) { Vdist(a, 1, b, 1, o, 1) }, func(a, b, c, d float32) float32 { return float32(math.Sqrt(float64(a*a + b*b))) }},
+		{"Vpoly", func(o []float32) { Vpoly([]float32{2, -1, 0.5}, 1, a, 1, o, 1) }, func(a, b, c, d float32) float32 { return 2*a*a - a + 0.5 }},
+	}
+	for _, tc := range cases {
+		out := make([]float32, n)
+		tc.fn(out)
+		for i := range out {
+			if want := tc.want(a[i], b[i], c[i], d[i]); !almostEqual32(out[i], want, maxFloatDiffErr) {
+				t.Errorf("%s[%d] = %f; want %f", tc.name, i, out[i], want)
+			}
+		}
+	}
+
+	// Strided operands keep their order: every other element of a minus
+	// the first three of b, written to every third element of the output.
+	out := make([]float32, 9)
+	for i := range out {
+		out[i] = float32(math.NaN())
+	}
+	Vsub(a, 2, b[:3], 1, out, 3)
+	for i := 0; i < 3; i++ {
+		if want := a[2*i] - b[i]; out[3*i] != want {
+			t.Errorf("strided Vsub[%d] = %f; want %f", i, out[3*i], want)
+		}
+		if !math.IsNaN(float64(out[3*i+1])) || !math.IsNaN(float64(out[3*i+2])) {
+			t.Errorf("strided Vsub wrote between output elements at %d", 3*i)
+		}
+	}
+
+	// Every second coefficient, so the polynomial is x² + 3.
+	Vpoly([]float32{1, 9, 0, 9, 3, 9}, 2, a, 1, out[:n], 1)
+	for i, x := range a {
+		if want := x*x + 3; !almostEqual32(out[i], want, maxFloatDiffErr) {
+			t.Errorf("strided Vpoly(%f) = %f; want %f", x, out[i], want)
+		}
+	}
+}
+
+func TestVpolyNoCoefficients(t *testing.T) {
+	x := []float32{1, 2}
+	for name, f := range map[string]func(){
+		"Vpoly empty":         func() { Vpoly(nil, 1, x, 1, make([]float32, 2), 1) },
+		"Vpoly short stride":  func() { Vpoly([]float32{1}, 2, x, 1, make([]float32, 2), 1) },
+		"VpolyD empty":        func() { VpolyD([]float64{}, 1, []float64{1, 2}, 1, make([]float64, 2), 1) },
+		"VpolyD short stride": func() { VpolyD([]float64{1, 2}, 3, []float64{1, 2}, 1, make([]float64, 2), 1) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != ErrLengthMismatch {
+					t.Errorf("%s panicked with %v; want %v", name, r, ErrLengthMismatch)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestVlint(t *testing.T) {
+	table := []float32{0, 10, 20, 40}
+	indices := []float32{0, 0.5, 1.25, 2.75, 2}
+	output := make([]float32, len(indices))
+	Vlint(table, indices, 1, output, 1)
+	for i, want := range []float32{0, 5, 12.5, 35, 20} {
+		if !almostEqual32(output[i], want, maxFloatDiffErr) {
+			t.Errorf("Vlint(%f) = %f; want %f", indices[i], output[i], want)
+		}
+	}
+}
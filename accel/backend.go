@@ -0,0 +1,409 @@
+package accel
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+var (
+	ErrUnknownBackend     = errors.New("accel: unknown backend")
+	ErrUnsupportedBackend = errors.New("accel: backend does not implement the requested capability")
+)
+
+// Backend is an implementation of some of the vDSP, vForce and vImage
+// entry points exposed by this package. Backend itself only names the
+// implementation; the entry points are grouped into capability interfaces
+// (FFTBackend, BiquadBackend, VectorBackend, VectorBackendD, VForceBackend
+// and VImageBackend) that a backend implements as it sees fit. Every method
+// has the same signature and semantics as the package level function of the
+// same name.
+//
+// The "purego" backend is always registered. The "accelerate" backend and a
+// "verify" backend comparing the two are registered when the package is
+// built against the Accelerate framework. All three implement every
+// capability interface.
+type Backend interface {
+	// Name returns the name the backend is registered under.
+	Name() string
+}
+
+// FFTBackend is a Backend that creates FFT setups.
+type FFTBackend interface {
+	Backend
+
+	CreateFFTSetup(log2n int, radix FFTRadix) (BackendFFTSetup, error)
+	CreateFFTSetupD(log2n int, radix FFTRadix) (BackendFFTSetupD, error)
+}
+
+// BiquadBackend is a Backend that creates biquad filter setups.
+type BiquadBackend interface {
+	Backend
+
+	CreateBiquadSetup(sections []BiquadCoefficients) (BackendBiquadSetup, error)
+	CreateBiquadmSetup(channels [][]BiquadCoefficients) (BackendBiquadmSetup, error)
+}
+
+// VectorBackend is a Backend implementing the single-precision vDSP
+// vector functions: conversions, arithmetic, statistics, windows and
+// convolution.
+type VectorBackend interface {
+	Backend
+
+	Vflt8(input []int8, inputStride int, output []float32, outputStride int)
+	Vflt8_byte(input []byte, inputStride int, output []float32, outputStride int)
+	Vfltu8(input []byte, inputStride int, output []float32, outputStride int)
+	Vflt16(input []int16, inputStride int, output []float32, outputStride int)
+	Vflt16_byte(input []byte, inputStride int, output []float32, outputStride int)
+	Vflt32(input []int32, inputStride int, output []float32, outputStride int)
+	Vflt32_byte(input []byte, inputStride int, output []float32, outputStride int)
+	Vdpsp(input []float64, inputStride int, output []float32, outputStride int)
+	Vdpsp_byte(input []byte, inputStride int, output []float32, outputStride int)
+	Ctoz(input []complex64, inputStride int, output DSPSplitComplex, outputStride int)
+	Ctoz_float(input []float32, inputStride int, output DSPSplitComplex, outputStride int)
+	Ctoz_byte(input []byte, inputStride int, output DSPSplitComplex, outputStride int)
+	Ztoc(input DSPSplitComplex, inputStride int, output []complex64, outputStride int)
+	Ztoc_float(input DSPSplitComplex, inputStride int, output []float32, outputStride int)
+	Ztoc_byte(input DSPSplitComplex, inputStride int, output []byte, outputStride int)
+	Vclr(vec []float32, stride int)
+	Vfill(value float32, output []float32, stride int)
+	Vclip(input []float32, inputStride int, low, high float32, output []float32, outputStride int)
+	Vthr(input []float32, inputStride int, low float32, output []float32, outputStride int)
+	Desamp(input []float32, desamplingFactor int, coeff []float32, output []float32)
+	Zrdesamp(input DSPSplitComplex, decimationFactor int, coefficients []float32, output DSPSplitComplex)
+	Zvphas(input DSPSplitComplex, inputStride int, output []float32, outputStride int)
+	Zidotpr(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex)
+	Zvcmul(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex, resultStride int)
+	Vfix16(input []float32, inputStride int, output []int16, outputStride int)
+	Vfix16_byte(input []float32, inputStride int, output []byte, outputStride int)
+	Vadd(input1 []float32, input1Stride int, input2 []float32, input2Stride int, output []float32, outputStride int)
+	Vsmsa(input []float32, inputStride int, mult, add float32, output []float32, outputStride int)
+	Vabs(input []float32, inputStride int, output []float32, outputStride int)
+	Vsq(input []float32, inputStride int, output []float32, outputStride int)
+	Zvabs(input DSPSplitComplex, inputStride int, output []float32, outputStride int)
+	Maxv(input []float32, stride int) float32
+	Minv(input []float32, stride int) float32
+	Sve(input []float32, inputStride int) float32
+	Vavlin(input []float32, inputStride int, count float32, output []float32, outputStride int)
+	Vmul(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int)
+	Vsadd(input []float32, inputStride int, add float32, output []float32, outputStride int)
+	Vsdiv(input []float32, inputStride int, divisor float32, output []float32, outputStride int)
+	Vneg(input []float32, inputStride int, output []float32, outputStride int)
+	Vswsum(input []float32, inputStride int, output []float32, outputStride, windowLen int)
+	Vdbcon(input []float32, inputStride int, zeroReference float32, output []float32, outputStride int, flag DBFlag)
+	Meanv(input []float32, stride int) float32
+	HannWindow(output []float32, flag WindowFlag)
+	HammWindow(output []float32, flag WindowFlag)
+	BlkmanWindow(output []float32, flag WindowFlag)
+	Conv(input []float32, inputStride int, filter []float32, filterStride int, output []float32, outputStride int)
+	Zconv(input DSPSplitComplex, inputStride int, filter DSPSplitComplex, filterStride int, output DSPSplitComplex, outputStride int)
+	Zvmul(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex, resultStride int, conjugate bool)
+	Zvconj(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int)
+	Rmsqv(input []float32, stride int) float32
+	Measqv(input []float32, stride int) float32
+	Svesq(input []float32, stride int) float32
+	Svs(input []float32, stride int) float32
+	Maxmgv(input []float32, stride int) float32
+	Maxvi(input []float32, stride int) (float32, int)
+	Minvi(input []float32, stride int) (float32, int)
+	Normalize(input []float32, inputStride int, output []float32, outputStride int) (mean, stdDev float32)
+	Vsub(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int)
+	Vdiv(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int)
+	Vsmul(input []float32, inputStride int, mult float32, output []float32, outputStride int)
+	Svdiv(numerator float32, input []float32, inputStride int, output []float32, outputStride int)
+	Vma(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int)
+	Vmsa(input1 []float32, stride1 int, input2 []float32, stride2 int, add float32, output []float32, outputStride int)
+	Vmsb(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int)
+	Vam(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int)
+	Vsbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int)
+	Vasm(input1 []float32, stride1 int, input2 []float32, stride2 int, mult float32, output []float32, outputStride int)
+	Vsbsm(input1 []float32, stride1 int, input2 []float32, stride2 int, mult float32, output []float32, outputStride int)
+	Vsma(input1 []float32, stride1 int, mult float32, input2 []float32, stride2 int, output []float32, outputStride int)
+	Vsmsb(input1 []float32, stride1 int, mult float32, input2 []float32, stride2 int, output []float32, outputStride int)
+	Vmma(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int)
+	Vmmsb(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int)
+	Vaam(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int)
+	Vsbsbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int)
+	Vasbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int)
+	Vintb(input1 []float32, stride1 int, input2 []float32, stride2 int, fraction float32, output []float32, outputStride int)
+	Vdist(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int)
+	Vpoly(coefficients []float32, coeffStride int, input []float32, inputStride int, output []float32, outputStride int)
+	Vlint(table []float32, indices []float32, indicesStride int, output []float32, outputStride int)
+}
+
+// VectorBackendD is a Backend implementing the double-precision vDSP
+// vector functions. It is the float64 counterpart of VectorBackend.
+type VectorBackendD interface {
+	Backend
+
+	ZvabsD(input DSPDoubleSplitComplex, inputStride int, output []float64, outputStride int)
+	VclipD(input []float64, inputStride int, low, high float64, output []float64, outputStride int)
+	VthrD(input []float64, inputStride int, low float64, output []float64, outputStride int)
+	VaddD(input1 []float64, input1Stride int, input2 []float64, input2Stride int, output []float64, outputStride int)
+	VsmsaD(input []float64, inputStride int, mult, add float64, output []float64, outputStride int)
+	VabsD(input []float64, inputStride int, output []float64, outputStride int)
+	VsqD(input []float64, inputStride int, output []float64, outputStride int)
+	MaxvD(input []float64, stride int) float64
+	MinvD(input []float64, stride int) float64
+	SveD(input []float64, inputStride int) float64
+	VmulD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int)
+	VsaddD(input []float64, inputStride int, add float64, output []float64, outputStride int)
+	VnegD(input []float64, inputStride int, output []float64, outputStride int)
+	MeanvD(input []float64, stride int) float64
+	Vflt8D(input []int8, inputStride int, output []float64, outputStride int)
+	Vfltu8D(input []byte, inputStride int, output []float64, outputStride int)
+	Vflt16D(input []int16, inputStride int, output []float64, outputStride int)
+	Vflt32D(input []int32, inputStride int, output []float64, outputStride int)
+	Vspdp(input []float32, inputStride int, output []float64, outputStride int)
+	Vfix16D(input []float64, inputStride int, output []int16, outputStride int)
+	CtozD(input []complex128, inputStride int, output DSPDoubleSplitComplex, outputStride int)
+	ZtocD(input DSPDoubleSplitComplex, inputStride int, output []complex128, outputStride int)
+	VclrD(vec []float64, stride int)
+	VfillD(value float64, output []float64, stride int)
+	DesampD(input []float64, desamplingFactor int, coeff []float64, output []float64)
+	ZrdesampD(input DSPDoubleSplitComplex, decimationFactor int, coefficients []float64, output DSPDoubleSplitComplex)
+	ZvphasD(input DSPDoubleSplitComplex, inputStride int, output []float64, outputStride int)
+	ZidotprD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex)
+	ZvcmulD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex, resultStride int)
+	VavlinD(input []float64, inputStride int, count float64, output []float64, outputStride int)
+	VsdivD(input []float64, inputStride int, divisor float64, output []float64, outputStride int)
+	VswsumD(input []float64, inputStride int, output []float64, outputStride, windowLen int)
+	VdbconD(input []float64, inputStride int, zeroReference float64, output []float64, outputStride int, flag DBFlag)
+	HannWindowD(output []float64, flag WindowFlag)
+	HammWindowD(output []float64, flag WindowFlag)
+	BlkmanWindowD(output []float64, flag WindowFlag)
+	ConvD(input []float64, inputStride int, filter []float64, filterStride int, output []float64, outputStride int)
+	ZconvD(input DSPDoubleSplitComplex, inputStride int, filter DSPDoubleSplitComplex, filterStride int, output DSPDoubleSplitComplex, outputStride int)
+	ZvmulD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex, resultStride int, conjugate bool)
+	ZvconjD(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int)
+	RmsqvD(input []float64, stride int) float64
+	MeasqvD(input []float64, stride int) float64
+	SvesqD(input []float64, stride int) float64
+	SvsD(input []float64, stride int) float64
+	MaxmgvD(input []float64, stride int) float64
+	MaxviD(input []float64, stride int) (float64, int)
+	MinviD(input []float64, stride int) (float64, int)
+	NormalizeD(input []float64, inputStride int, output []float64, outputStride int) (mean, stdDev float64)
+	VsubD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int)
+	VdivD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int)
+	VsmulD(input []float64, inputStride int, mult float64, output []float64, outputStride int)
+	SvdivD(numerator float64, input []float64, inputStride int, output []float64, outputStride int)
+	VmaD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int)
+	VmsaD(input1 []float64, stride1 int, input2 []float64, stride2 int, add float64, output []float64, outputStride int)
+	VmsbD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int)
+	VamD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int)
+	VsbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int)
+	VasmD(input1 []float64, stride1 int, input2 []float64, stride2 int, mult float64, output []float64, outputStride int)
+	VsbsmD(input1 []float64, stride1 int, input2 []float64, stride2 int, mult float64, output []float64, outputStride int)
+	VsmaD(input1 []float64, stride1 int, mult float64, input2 []float64, stride2 int, output []float64, outputStride int)
+	VsmsbD(input1 []float64, stride1 int, mult float64, input2 []float64, stride2 int, output []float64, outputStride int)
+	VmmaD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int)
+	VmmsbD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int)
+	VaamD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int)
+	VsbsbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int)
+	VasbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int)
+	VintbD(input1 []float64, stride1 int, input2 []float64, stride2 int, fraction float64, output []float64, outputStride int)
+	VdistD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int)
+	VpolyD(coefficients []float64, coeffStride int, input []float64, inputStride int, output []float64, outputStride int)
+	VlintD(table []float64, indices []float64, indicesStride int, output []float64, outputStride int)
+}
+
+// VForceBackend is a Backend implementing the vForce element-wise math
+// functions in both precisions.
+type VForceBackend interface {
+	Backend
+
+	Vvlog10_float(input, output []float32)
+	Vvexpf(input, output []float32)
+	Vvlogf(input, output []float32)
+	Vvlog2f(input, output []float32)
+	Vvsqrtf(input, output []float32)
+	Vvrsqrtf(input, output []float32)
+	Vvsinf(input, output []float32)
+	Vvcosf(input, output []float32)
+	Vvtanf(input, output []float32)
+	Vvtanhf(input, output []float32)
+	Vvrecf(input, output []float32)
+	Vvfloorf(input, output []float32)
+	Vvceilf(input, output []float32)
+	Vvintf(input, output []float32)
+	Vvpowf(base, exponent, output []float32)
+	Vvatan2f(y, x, output []float32)
+	Vvsincosf(input, sin, cos []float32)
+	Vvexp(input, output []float64)
+	Vvlog(input, output []float64)
+	Vvlog2(input, output []float64)
+	Vvsqrt(input, output []float64)
+	Vvrsqrt(input, output []float64)
+	Vvsin(input, output []float64)
+	Vvcos(input, output []float64)
+	Vvtan(input, output []float64)
+	Vvtanh(input, output []float64)
+	Vvrec(input, output []float64)
+	Vvfloor(input, output []float64)
+	Vvceil(input, output []float64)
+	Vvint(input, output []float64)
+	Vvpow(base, exponent, output []float64)
+	Vvatan2(y, x, output []float64)
+	Vvsincos(input, sin, cos []float64)
+	Vvlog10(input, output []float64)
+}
+
+// VImageBackend is a Backend implementing the vImage functions.
+type VImageBackend interface {
+	Backend
+
+	VImagePermuteChannels_ARGB8888(src, dst *VImageBuffer, permuteMap [4]uint8, flags VImageFlag) error
+	VImageAlphaBlend_ARGB8888(srcTop, srcBottom, dst *VImageBuffer, flags VImageFlag) error
+	VImagePremultipliedConstAlphaBlend_ARGB8888(srcTop *VImageBuffer, constAlpha uint8, srcBottom, dst *VImageBuffer, flags VImageFlag) error
+	VImageRichardsonLucyDeConvolve_ARGBFFFF(src, dst *VImageBuffer, tempBuffer []byte, roiX, roiY int, kernel, kernel2 []float32, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2 int, backgroundColor [4]float32, iterationCount int, flags VImageFlag) error
+	VImageRichardsonLucyDeConvolve_ARGB8888(src, dst *VImageBuffer, tempBuffer []byte, roiX, roiY int, kernel, kernel2 []int16, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, divisor, divisor2 int, backgroundColor [4]uint8, iterationCount int, flags VImageFlag) error
+	VImageConvolve_ARGB8888(src, dst *VImageBuffer, tempBuffer []byte, roiX, roiY int, kernel []int16, kernelHeight, kernelWidth, divisor int, backgroundColor [4]uint8, flags VImageFlag) error
+	VImageHistogramCalculation_ARGB8888(src *VImageBuffer, flags VImageFlag) ([4][]int, error)
+	VImageHistogramCalculation_Planar8(src *VImageBuffer, flags VImageFlag) ([]int, error)
+}
+
+// BackendFFTSetup is a single-precision FFT setup created by a Backend.
+// *FFTSetup implements it.
+type BackendFFTSetup interface {
+	Zrip(ioData DSPSplitComplex, stride, log2n int, direction FFTDirection)
+	Zrop(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection)
+	Zip(ioData DSPSplitComplex, stride, log2n int, direction FFTDirection)
+	Zop(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection)
+	Zop3(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection)
+	Zop5(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection)
+	Zip2D(ioData DSPSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection)
+	Zop2D(input DSPSplitComplex, inputRowStride, inputColStride int, output DSPSplitComplex, outputRowStride, outputColStride, log2nCols, log2nRows int, direction FFTDirection)
+	Zrip2D(ioData DSPSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection)
+	ZripM(ioData DSPSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection)
+	ZropM(input DSPSplitComplex, inputStride, inputSignalStride int, output DSPSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection)
+	ZipM(ioData DSPSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection)
+	ZopM(input DSPSplitComplex, inputStride, inputSignalStride int, output DSPSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection)
+	Destroy()
+}
+
+// BackendFFTSetupD is a double-precision FFT setup created by a Backend.
+// *FFTSetupD implements it.
+type BackendFFTSetupD interface {
+	Zrip(ioData DSPDoubleSplitComplex, stride, log2n int, direction FFTDirection)
+	Zrop(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection)
+	Zip(ioData DSPDoubleSplitComplex, stride, log2n int, direction FFTDirection)
+	Zop(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection)
+	Zop3(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection)
+	Zop5(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection)
+	Zip2D(ioData DSPDoubleSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection)
+	Zop2D(input DSPDoubleSplitComplex, inputRowStride, inputColStride int, output DSPDoubleSplitComplex, outputRowStride, outputColStride, log2nCols, log2nRows int, direction FFTDirection)
+	Zrip2D(ioData DSPDoubleSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection)
+	ZripM(ioData DSPDoubleSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection)
+	ZropM(input DSPDoubleSplitComplex, inputStride, inputSignalStride int, output DSPDoubleSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection)
+	ZipM(ioData DSPDoubleSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection)
+	ZopM(input DSPDoubleSplitComplex, inputStride, inputSignalStride int, output DSPDoubleSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection)
+	Destroy()
+}
+
+// BackendBiquadSetup is a biquad cascade created by a Backend.
+// *BiquadSetup implements it.
+type BackendBiquadSetup interface {
+	Biquad(delay []float32, input []float32, inputStride int, output []float32, outputStride int)
+	Destroy()
+}
+
+// BackendBiquadmSetup is a multichannel biquad cascade created by a
+// Backend. *BiquadmSetup implements it.
+type BackendBiquadmSetup interface {
+	Biquadm(input [][]float32, inputStride int, output [][]float32, outputStride int)
+	ResetState()
+	Destroy()
+}
+
+var (
+	backendsMu sync.RWMutex
+	backends   = map[string]Backend{}
+)
+
+// RegisterBackend makes a backend available by the provided name. If
+// RegisterBackend is called twice with the same name or if backend is nil,
+// it panics.
+func RegisterBackend(name string, backend Backend) {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+	if backend == nil {
+		panic("accel: RegisterBackend backend is nil")
+	}
+	if _, dup := backends[name]; dup {
+		panic("accel: RegisterBackend called twice for backend " + name)
+	}
+	backends[name] = backend
+}
+
+// LookupBackend returns the backend registered under name.
+func LookupBackend(name string) (Backend, error) {
+	backendsMu.RLock()
+	defer backendsMu.RUnlock()
+	if b := backends[name]; b != nil {
+		return b, nil
+	}
+	return nil, ErrUnknownBackend
+}
+
+// LookupBackendAs returns the backend registered under name as the
+// capability interface T, for example
+//
+//	vb, err := accel.LookupBackendAs[accel.VectorBackend]("purego")
+//
+// It returns ErrUnsupportedBackend if the backend doesn't implement T.
+func LookupBackendAs[T Backend](name string) (T, error) {
+	var zero T
+	b, err := LookupBackend(name)
+	if err != nil {
+		return zero, err
+	}
+	c, ok := b.(T)
+	if !ok {
+		return zero, ErrUnsupportedBackend
+	}
+	return c, nil
+}
+
+// Backends returns a sorted list of the names of the registered backends.
+func Backends() []string {
+	return BackendsWith[Backend]()
+}
+
+// BackendsWith returns a sorted list of the names of the registered
+// backends that implement the capability interface T.
+func BackendsWith[T Backend]() []string {
+	backendsMu.RLock()
+	defer backendsMu.RUnlock()
+	names := make([]string, 0, len(backends))
+	for name, b := range backends {
+		if _, ok := b.(T); ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// capability returns b as the capability interface T. It panics with
+// ErrUnsupportedBackend if b doesn't implement T.
+func capability[T Backend](b Backend) T {
+	c, ok := b.(T)
+	if !ok {
+		panic(ErrUnsupportedBackend)
+	}
+	return c
+}
+
+// DefaultBackend returns the registered backend matching the
+// implementation the package was built with: "accelerate" when available
+// and "purego" otherwise. The package level functions call that
+// implementation directly rather than going through the registry, so
+// registering other backends doesn't change their behavior.
+func DefaultBackend() Backend {
+	if b, err := LookupBackend("accelerate"); err == nil {
+		return b
+	}
+	b, _ := LookupBackend("purego")
+	return b
+}
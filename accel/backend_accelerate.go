@@ -0,0 +1,821 @@
+//go:build darwin && cgo && !purego
+
+package accel
+
+func init() {
+	RegisterBackend("accelerate", accelerateBackend{})
+	RegisterBackend("verify", NewVerifyBackend(accelerateBackend{}, genericBackend{}, 1e-4))
+}
+
+var (
+	_ FFTBackend     = accelerateBackend{}
+	_ BiquadBackend  = accelerateBackend{}
+	_ VectorBackend  = accelerateBackend{}
+	_ VectorBackendD = accelerateBackend{}
+	_ VForceBackend  = accelerateBackend{}
+	_ VImageBackend  = accelerateBackend{}
+)
+
+// accelerateBackend forwards to the Accelerate framework wrappers.
+type accelerateBackend struct{}
+
+func (accelerateBackend) Name() string {
+	return "accelerate"
+}
+
+func (accelerateBackend) CreateFFTSetup(log2n int, radix FFTRadix) (BackendFFTSetup, error) {
+	// Avoid returning a typed nil inside the interface on failure.
+	fs, err := CreateFFTSetup(log2n, radix)
+	if err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+func (accelerateBackend) CreateFFTSetupD(log2n int, radix FFTRadix) (BackendFFTSetupD, error) {
+	fs, err := CreateFFTSetupD(log2n, radix)
+	if err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+func (accelerateBackend) CreateBiquadSetup(sections []BiquadCoefficients) (BackendBiquadSetup, error) {
+	bs, err := CreateBiquadSetup(sections)
+	if err != nil {
+		return nil, err
+	}
+	return bs, nil
+}
+
+func (accelerateBackend) CreateBiquadmSetup(channels [][]BiquadCoefficients) (BackendBiquadmSetup, error) {
+	bs, err := CreateBiquadmSetup(channels)
+	if err != nil {
+		return nil, err
+	}
+	return bs, nil
+}
+
+func (accelerateBackend) Vflt8(input []int8, inputStride int, output []float32, outputStride int) {
+	Vflt8(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vflt8_byte(input []byte, inputStride int, output []float32, outputStride int) {
+	Vflt8_byte(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vfltu8(input []byte, inputStride int, output []float32, outputStride int) {
+	Vfltu8(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vflt16(input []int16, inputStride int, output []float32, outputStride int) {
+	Vflt16(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vflt16_byte(input []byte, inputStride int, output []float32, outputStride int) {
+	Vflt16_byte(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vflt32(input []int32, inputStride int, output []float32, outputStride int) {
+	Vflt32(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vflt32_byte(input []byte, inputStride int, output []float32, outputStride int) {
+	Vflt32_byte(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vdpsp(input []float64, inputStride int, output []float32, outputStride int) {
+	Vdpsp(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vdpsp_byte(input []byte, inputStride int, output []float32, outputStride int) {
+	Vdpsp_byte(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Ctoz(input []complex64, inputStride int, output DSPSplitComplex, outputStride int) {
+	Ctoz(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Ctoz_float(input []float32, inputStride int, output DSPSplitComplex, outputStride int) {
+	Ctoz_float(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Ctoz_byte(input []byte, inputStride int, output DSPSplitComplex, outputStride int) {
+	Ctoz_byte(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Ztoc(input DSPSplitComplex, inputStride int, output []complex64, outputStride int) {
+	Ztoc(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Ztoc_float(input DSPSplitComplex, inputStride int, output []float32, outputStride int) {
+	Ztoc_float(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Ztoc_byte(input DSPSplitComplex, inputStride int, output []byte, outputStride int) {
+	Ztoc_byte(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vclr(vec []float32, stride int) {
+	Vclr(vec, stride)
+}
+
+func (accelerateBackend) Vfill(value float32, output []float32, stride int) {
+	Vfill(value, output, stride)
+}
+
+func (accelerateBackend) Vclip(input []float32, inputStride int, low, high float32, output []float32, outputStride int) {
+	Vclip(input, inputStride, low, high, output, outputStride)
+}
+
+func (accelerateBackend) Vthr(input []float32, inputStride int, low float32, output []float32, outputStride int) {
+	Vthr(input, inputStride, low, output, outputStride)
+}
+
+func (accelerateBackend) Desamp(input []float32, desamplingFactor int, coeff []float32, output []float32) {
+	Desamp(input, desamplingFactor, coeff, output)
+}
+
+func (accelerateBackend) Zrdesamp(input DSPSplitComplex, decimationFactor int, coefficients []float32, output DSPSplitComplex) {
+	Zrdesamp(input, decimationFactor, coefficients, output)
+}
+
+func (accelerateBackend) Zvphas(input DSPSplitComplex, inputStride int, output []float32, outputStride int) {
+	Zvphas(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Zidotpr(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex) {
+	Zidotpr(input1, stride1, input2, stride2, result)
+}
+
+func (accelerateBackend) Zvcmul(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex, resultStride int) {
+	Zvcmul(input1, stride1, input2, stride2, result, resultStride)
+}
+
+func (accelerateBackend) Vfix16(input []float32, inputStride int, output []int16, outputStride int) {
+	Vfix16(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vfix16_byte(input []float32, inputStride int, output []byte, outputStride int) {
+	Vfix16_byte(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vadd(input1 []float32, input1Stride int, input2 []float32, input2Stride int, output []float32, outputStride int) {
+	Vadd(input1, input1Stride, input2, input2Stride, output, outputStride)
+}
+
+func (accelerateBackend) Vsmsa(input []float32, inputStride int, mult, add float32, output []float32, outputStride int) {
+	Vsmsa(input, inputStride, mult, add, output, outputStride)
+}
+
+func (accelerateBackend) Vabs(input []float32, inputStride int, output []float32, outputStride int) {
+	Vabs(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vsq(input []float32, inputStride int, output []float32, outputStride int) {
+	Vsq(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Zvabs(input DSPSplitComplex, inputStride int, output []float32, outputStride int) {
+	Zvabs(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) ZvabsD(input DSPDoubleSplitComplex, inputStride int, output []float64, outputStride int) {
+	ZvabsD(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Maxv(input []float32, stride int) float32 {
+	return Maxv(input, stride)
+}
+
+func (accelerateBackend) Minv(input []float32, stride int) float32 {
+	return Minv(input, stride)
+}
+
+func (accelerateBackend) Sve(input []float32, inputStride int) float32 {
+	return Sve(input, inputStride)
+}
+
+func (accelerateBackend) Vavlin(input []float32, inputStride int, count float32, output []float32, outputStride int) {
+	Vavlin(input, inputStride, count, output, outputStride)
+}
+
+func (accelerateBackend) Vmul(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	Vmul(input1, stride1, input2, stride2, output, outputStride)
+}
+
+func (accelerateBackend) Vsadd(input []float32, inputStride int, add float32, output []float32, outputStride int) {
+	Vsadd(input, inputStride, add, output, outputStride)
+}
+
+func (accelerateBackend) Vsdiv(input []float32, inputStride int, divisor float32, output []float32, outputStride int) {
+	Vsdiv(input, inputStride, divisor, output, outputStride)
+}
+
+func (accelerateBackend) Vneg(input []float32, inputStride int, output []float32, outputStride int) {
+	Vneg(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vswsum(input []float32, inputStride int, output []float32, outputStride, windowLen int) {
+	Vswsum(input, inputStride, output, outputStride, windowLen)
+}
+
+func (accelerateBackend) Vdbcon(input []float32, inputStride int, zeroReference float32, output []float32, outputStride int, flag DBFlag) {
+	Vdbcon(input, inputStride, zeroReference, output, outputStride, flag)
+}
+
+func (accelerateBackend) Meanv(input []float32, stride int) float32 {
+	return Meanv(input, stride)
+}
+
+func (accelerateBackend) HannWindow(output []float32, flag WindowFlag) {
+	HannWindow(output, flag)
+}
+
+func (accelerateBackend) HammWindow(output []float32, flag WindowFlag) {
+	HammWindow(output, flag)
+}
+
+func (accelerateBackend) BlkmanWindow(output []float32, flag WindowFlag) {
+	BlkmanWindow(output, flag)
+}
+
+func (accelerateBackend) Vvlog10_float(input, output []float32) {
+	Vvlog10_float(input, output)
+}
+
+func (accelerateBackend) VImagePermuteChannels_ARGB8888(src, dst *VImageBuffer, permuteMap [4]uint8, flags VImageFlag) error {
+	return VImagePermuteChannels_ARGB8888(src, dst, permuteMap, flags)
+}
+
+func (accelerateBackend) VImageAlphaBlend_ARGB8888(srcTop, srcBottom, dst *VImageBuffer, flags VImageFlag) error {
+	return VImageAlphaBlend_ARGB8888(srcTop, srcBottom, dst, flags)
+}
+
+func (accelerateBackend) VImagePremultipliedConstAlphaBlend_ARGB8888(srcTop *VImageBuffer, constAlpha uint8, srcBottom, dst *VImageBuffer, flags VImageFlag) error {
+	return VImagePremultipliedConstAlphaBlend_ARGB8888(srcTop, constAlpha, srcBottom, dst, flags)
+}
+
+func (accelerateBackend) VImageRichardsonLucyDeConvolve_ARGBFFFF(src, dst *VImageBuffer, tempBuffer []byte, roiX, roiY int, kernel, kernel2 []float32, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2 int, backgroundColor [4]float32, iterationCount int, flags VImageFlag) error {
+	return VImageRichardsonLucyDeConvolve_ARGBFFFF(src, dst, tempBuffer, roiX, roiY, kernel, kernel2, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, backgroundColor, iterationCount, flags)
+}
+
+func (accelerateBackend) VImageRichardsonLucyDeConvolve_ARGB8888(src, dst *VImageBuffer, tempBuffer []byte, roiX, roiY int, kernel, kernel2 []int16, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, divisor, divisor2 int, backgroundColor [4]uint8, iterationCount int, flags VImageFlag) error {
+	return VImageRichardsonLucyDeConvolve_ARGB8888(src, dst, tempBuffer, roiX, roiY, kernel, kernel2, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, divisor, divisor2, backgroundColor, iterationCount, flags)
+}
+
+func (accelerateBackend) VImageConvolve_ARGB8888(src, dst *VImageBuffer, tempBuffer []byte, roiX, roiY int, kernel []int16, kernelHeight, kernelWidth, divisor int, backgroundColor [4]uint8, flags VImageFlag) error {
+	return VImageConvolve_ARGB8888(src, dst, tempBuffer, roiX, roiY, kernel, kernelHeight, kernelWidth, divisor, backgroundColor, flags)
+}
+
+func (accelerateBackend) VImageHistogramCalculation_ARGB8888(src *VImageBuffer, flags VImageFlag) ([4][]int, error) {
+	return VImageHistogramCalculation_ARGB8888(src, flags)
+}
+
+func (accelerateBackend) VImageHistogramCalculation_Planar8(src *VImageBuffer, flags VImageFlag) ([]int, error) {
+	return VImageHistogramCalculation_Planar8(src, flags)
+}
+
+func (accelerateBackend) VclipD(input []float64, inputStride int, low, high float64, output []float64, outputStride int) {
+	VclipD(input, inputStride, low, high, output, outputStride)
+}
+
+func (accelerateBackend) VthrD(input []float64, inputStride int, low float64, output []float64, outputStride int) {
+	VthrD(input, inputStride, low, output, outputStride)
+}
+
+func (accelerateBackend) VaddD(input1 []float64, input1Stride int, input2 []float64, input2Stride int, output []float64, outputStride int) {
+	VaddD(input1, input1Stride, input2, input2Stride, output, outputStride)
+}
+
+func (accelerateBackend) VsmsaD(input []float64, inputStride int, mult, add float64, output []float64, outputStride int) {
+	VsmsaD(input, inputStride, mult, add, output, outputStride)
+}
+
+func (accelerateBackend) VabsD(input []float64, inputStride int, output []float64, outputStride int) {
+	VabsD(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) VsqD(input []float64, inputStride int, output []float64, outputStride int) {
+	VsqD(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) MaxvD(input []float64, stride int) float64 {
+	return MaxvD(input, stride)
+}
+
+func (accelerateBackend) MinvD(input []float64, stride int) float64 {
+	return MinvD(input, stride)
+}
+
+func (accelerateBackend) SveD(input []float64, inputStride int) float64 {
+	return SveD(input, inputStride)
+}
+
+func (accelerateBackend) VmulD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	VmulD(input1, stride1, input2, stride2, output, outputStride)
+}
+
+func (accelerateBackend) VsaddD(input []float64, inputStride int, add float64, output []float64, outputStride int) {
+	VsaddD(input, inputStride, add, output, outputStride)
+}
+
+func (accelerateBackend) VnegD(input []float64, inputStride int, output []float64, outputStride int) {
+	VnegD(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) MeanvD(input []float64, stride int) float64 {
+	return MeanvD(input, stride)
+}
+
+func (accelerateBackend) Vflt8D(input []int8, inputStride int, output []float64, outputStride int) {
+	Vflt8D(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vfltu8D(input []byte, inputStride int, output []float64, outputStride int) {
+	Vfltu8D(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vflt16D(input []int16, inputStride int, output []float64, outputStride int) {
+	Vflt16D(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vflt32D(input []int32, inputStride int, output []float64, outputStride int) {
+	Vflt32D(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vspdp(input []float32, inputStride int, output []float64, outputStride int) {
+	Vspdp(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vfix16D(input []float64, inputStride int, output []int16, outputStride int) {
+	Vfix16D(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) CtozD(input []complex128, inputStride int, output DSPDoubleSplitComplex, outputStride int) {
+	CtozD(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) ZtocD(input DSPDoubleSplitComplex, inputStride int, output []complex128, outputStride int) {
+	ZtocD(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) VclrD(vec []float64, stride int) {
+	VclrD(vec, stride)
+}
+
+func (accelerateBackend) VfillD(value float64, output []float64, stride int) {
+	VfillD(value, output, stride)
+}
+
+func (accelerateBackend) DesampD(input []float64, desamplingFactor int, coeff []float64, output []float64) {
+	DesampD(input, desamplingFactor, coeff, output)
+}
+
+func (accelerateBackend) ZrdesampD(input DSPDoubleSplitComplex, decimationFactor int, coefficients []float64, output DSPDoubleSplitComplex) {
+	ZrdesampD(input, decimationFactor, coefficients, output)
+}
+
+func (accelerateBackend) ZvphasD(input DSPDoubleSplitComplex, inputStride int, output []float64, outputStride int) {
+	ZvphasD(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) ZidotprD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex) {
+	ZidotprD(input1, stride1, input2, stride2, result)
+}
+
+func (accelerateBackend) ZvcmulD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex, resultStride int) {
+	ZvcmulD(input1, stride1, input2, stride2, result, resultStride)
+}
+
+func (accelerateBackend) VavlinD(input []float64, inputStride int, count float64, output []float64, outputStride int) {
+	VavlinD(input, inputStride, count, output, outputStride)
+}
+
+func (accelerateBackend) VsdivD(input []float64, inputStride int, divisor float64, output []float64, outputStride int) {
+	VsdivD(input, inputStride, divisor, output, outputStride)
+}
+
+func (accelerateBackend) VswsumD(input []float64, inputStride int, output []float64, outputStride, windowLen int) {
+	VswsumD(input, inputStride, output, outputStride, windowLen)
+}
+
+func (accelerateBackend) VdbconD(input []float64, inputStride int, zeroReference float64, output []float64, outputStride int, flag DBFlag) {
+	VdbconD(input, inputStride, zeroReference, output, outputStride, flag)
+}
+
+func (accelerateBackend) HannWindowD(output []float64, flag WindowFlag) {
+	HannWindowD(output, flag)
+}
+
+func (accelerateBackend) HammWindowD(output []float64, flag WindowFlag) {
+	HammWindowD(output, flag)
+}
+
+func (accelerateBackend) BlkmanWindowD(output []float64, flag WindowFlag) {
+	BlkmanWindowD(output, flag)
+}
+
+func (accelerateBackend) Conv(input []float32, inputStride int, filter []float32, filterStride int, output []float32, outputStride int) {
+	Conv(input, inputStride, filter, filterStride, output, outputStride)
+}
+
+func (accelerateBackend) Zconv(input DSPSplitComplex, inputStride int, filter DSPSplitComplex, filterStride int, output DSPSplitComplex, outputStride int) {
+	Zconv(input, inputStride, filter, filterStride, output, outputStride)
+}
+
+func (accelerateBackend) ConvD(input []float64, inputStride int, filter []float64, filterStride int, output []float64, outputStride int) {
+	ConvD(input, inputStride, filter, filterStride, output, outputStride)
+}
+
+func (accelerateBackend) ZconvD(input DSPDoubleSplitComplex, inputStride int, filter DSPDoubleSplitComplex, filterStride int, output DSPDoubleSplitComplex, outputStride int) {
+	ZconvD(input, inputStride, filter, filterStride, output, outputStride)
+}
+
+func (accelerateBackend) Zvmul(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex, resultStride int, conjugate bool) {
+	Zvmul(input1, stride1, input2, stride2, result, resultStride, conjugate)
+}
+
+func (accelerateBackend) Zvconj(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int) {
+	Zvconj(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) ZvmulD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex, resultStride int, conjugate bool) {
+	ZvmulD(input1, stride1, input2, stride2, result, resultStride, conjugate)
+}
+
+func (accelerateBackend) ZvconjD(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int) {
+	ZvconjD(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Rmsqv(input []float32, stride int) float32 {
+	return Rmsqv(input, stride)
+}
+
+func (accelerateBackend) Measqv(input []float32, stride int) float32 {
+	return Measqv(input, stride)
+}
+
+func (accelerateBackend) Svesq(input []float32, stride int) float32 {
+	return Svesq(input, stride)
+}
+
+func (accelerateBackend) Svs(input []float32, stride int) float32 {
+	return Svs(input, stride)
+}
+
+func (accelerateBackend) Maxmgv(input []float32, stride int) float32 {
+	return Maxmgv(input, stride)
+}
+
+func (accelerateBackend) RmsqvD(input []float64, stride int) float64 {
+	return RmsqvD(input, stride)
+}
+
+func (accelerateBackend) MeasqvD(input []float64, stride int) float64 {
+	return MeasqvD(input, stride)
+}
+
+func (accelerateBackend) SvesqD(input []float64, stride int) float64 {
+	return SvesqD(input, stride)
+}
+
+func (accelerateBackend) SvsD(input []float64, stride int) float64 {
+	return SvsD(input, stride)
+}
+
+func (accelerateBackend) MaxmgvD(input []float64, stride int) float64 {
+	return MaxmgvD(input, stride)
+}
+
+func (accelerateBackend) Maxvi(input []float32, stride int) (float32, int) {
+	return Maxvi(input, stride)
+}
+
+func (accelerateBackend) Minvi(input []float32, stride int) (float32, int) {
+	return Minvi(input, stride)
+}
+
+func (accelerateBackend) Normalize(input []float32, inputStride int, output []float32, outputStride int) (mean, stdDev float32) {
+	return Normalize(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) MaxviD(input []float64, stride int) (float64, int) {
+	return MaxviD(input, stride)
+}
+
+func (accelerateBackend) MinviD(input []float64, stride int) (float64, int) {
+	return MinviD(input, stride)
+}
+
+func (accelerateBackend) NormalizeD(input []float64, inputStride int, output []float64, outputStride int) (mean, stdDev float64) {
+	return NormalizeD(input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vsub(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	Vsub(input1, stride1, input2, stride2, output, outputStride)
+}
+
+func (accelerateBackend) Vdiv(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	Vdiv(input1, stride1, input2, stride2, output, outputStride)
+}
+
+func (accelerateBackend) Vsmul(input []float32, inputStride int, mult float32, output []float32, outputStride int) {
+	Vsmul(input, inputStride, mult, output, outputStride)
+}
+
+func (accelerateBackend) Svdiv(numerator float32, input []float32, inputStride int, output []float32, outputStride int) {
+	Svdiv(numerator, input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vma(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	Vma(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+func (accelerateBackend) Vmsa(input1 []float32, stride1 int, input2 []float32, stride2 int, add float32, output []float32, outputStride int) {
+	Vmsa(input1, stride1, input2, stride2, add, output, outputStride)
+}
+
+func (accelerateBackend) Vmsb(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	Vmsb(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+func (accelerateBackend) Vam(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	Vam(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+func (accelerateBackend) Vsbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	Vsbm(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+func (accelerateBackend) Vasm(input1 []float32, stride1 int, input2 []float32, stride2 int, mult float32, output []float32, outputStride int) {
+	Vasm(input1, stride1, input2, stride2, mult, output, outputStride)
+}
+
+func (accelerateBackend) Vsbsm(input1 []float32, stride1 int, input2 []float32, stride2 int, mult float32, output []float32, outputStride int) {
+	Vsbsm(input1, stride1, input2, stride2, mult, output, outputStride)
+}
+
+func (accelerateBackend) Vsma(input1 []float32, stride1 int, mult float32, input2 []float32, stride2 int, output []float32, outputStride int) {
+	Vsma(input1, stride1, mult, input2, stride2, output, outputStride)
+}
+
+func (accelerateBackend) Vsmsb(input1 []float32, stride1 int, mult float32, input2 []float32, stride2 int, output []float32, outputStride int) {
+	Vsmsb(input1, stride1, mult, input2, stride2, output, outputStride)
+}
+
+func (accelerateBackend) Vmma(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	Vmma(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (accelerateBackend) Vmmsb(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	Vmmsb(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (accelerateBackend) Vaam(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	Vaam(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (accelerateBackend) Vsbsbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	Vsbsbm(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (accelerateBackend) Vasbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	Vasbm(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (accelerateBackend) Vintb(input1 []float32, stride1 int, input2 []float32, stride2 int, fraction float32, output []float32, outputStride int) {
+	Vintb(input1, stride1, input2, stride2, fraction, output, outputStride)
+}
+
+func (accelerateBackend) Vdist(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	Vdist(input1, stride1, input2, stride2, output, outputStride)
+}
+
+func (accelerateBackend) Vpoly(coefficients []float32, coeffStride int, input []float32, inputStride int, output []float32, outputStride int) {
+	Vpoly(coefficients, coeffStride, input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) Vlint(table []float32, indices []float32, indicesStride int, output []float32, outputStride int) {
+	Vlint(table, indices, indicesStride, output, outputStride)
+}
+
+func (accelerateBackend) VsubD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	VsubD(input1, stride1, input2, stride2, output, outputStride)
+}
+
+func (accelerateBackend) VdivD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	VdivD(input1, stride1, input2, stride2, output, outputStride)
+}
+
+func (accelerateBackend) VsmulD(input []float64, inputStride int, mult float64, output []float64, outputStride int) {
+	VsmulD(input, inputStride, mult, output, outputStride)
+}
+
+func (accelerateBackend) SvdivD(numerator float64, input []float64, inputStride int, output []float64, outputStride int) {
+	SvdivD(numerator, input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) VmaD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	VmaD(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+func (accelerateBackend) VmsaD(input1 []float64, stride1 int, input2 []float64, stride2 int, add float64, output []float64, outputStride int) {
+	VmsaD(input1, stride1, input2, stride2, add, output, outputStride)
+}
+
+func (accelerateBackend) VmsbD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	VmsbD(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+func (accelerateBackend) VamD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	VamD(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+func (accelerateBackend) VsbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	VsbmD(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+func (accelerateBackend) VasmD(input1 []float64, stride1 int, input2 []float64, stride2 int, mult float64, output []float64, outputStride int) {
+	VasmD(input1, stride1, input2, stride2, mult, output, outputStride)
+}
+
+func (accelerateBackend) VsbsmD(input1 []float64, stride1 int, input2 []float64, stride2 int, mult float64, output []float64, outputStride int) {
+	VsbsmD(input1, stride1, input2, stride2, mult, output, outputStride)
+}
+
+func (accelerateBackend) VsmaD(input1 []float64, stride1 int, mult float64, input2 []float64, stride2 int, output []float64, outputStride int) {
+	VsmaD(input1, stride1, mult, input2, stride2, output, outputStride)
+}
+
+func (accelerateBackend) VsmsbD(input1 []float64, stride1 int, mult float64, input2 []float64, stride2 int, output []float64, outputStride int) {
+	VsmsbD(input1, stride1, mult, input2, stride2, output, outputStride)
+}
+
+func (accelerateBackend) VmmaD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	VmmaD(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (accelerateBackend) VmmsbD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	VmmsbD(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (accelerateBackend) VaamD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	VaamD(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (accelerateBackend) VsbsbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	VsbsbmD(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (accelerateBackend) VasbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	VasbmD(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (accelerateBackend) VintbD(input1 []float64, stride1 int, input2 []float64, stride2 int, fraction float64, output []float64, outputStride int) {
+	VintbD(input1, stride1, input2, stride2, fraction, output, outputStride)
+}
+
+func (accelerateBackend) VdistD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	VdistD(input1, stride1, input2, stride2, output, outputStride)
+}
+
+func (accelerateBackend) VpolyD(coefficients []float64, coeffStride int, input []float64, inputStride int, output []float64, outputStride int) {
+	VpolyD(coefficients, coeffStride, input, inputStride, output, outputStride)
+}
+
+func (accelerateBackend) VlintD(table []float64, indices []float64, indicesStride int, output []float64, outputStride int) {
+	VlintD(table, indices, indicesStride, output, outputStride)
+}
+
+func (accelerateBackend) Vvexpf(input, output []float32) {
+	Vvexpf(input, output)
+}
+
+func (accelerateBackend) Vvlogf(input, output []float32) {
+	Vvlogf(input, output)
+}
+
+func (accelerateBackend) Vvlog2f(input, output []float32) {
+	Vvlog2f(input, output)
+}
+
+func (accelerateBackend) Vvsqrtf(input, output []float32) {
+	Vvsqrtf(input, output)
+}
+
+func (accelerateBackend) Vvrsqrtf(input, output []float32) {
+	Vvrsqrtf(input, output)
+}
+
+func (accelerateBackend) Vvsinf(input, output []float32) {
+	Vvsinf(input, output)
+}
+
+func (accelerateBackend) Vvcosf(input, output []float32) {
+	Vvcosf(input, output)
+}
+
+func (accelerateBackend) Vvtanf(input, output []float32) {
+	Vvtanf(input, output)
+}
+
+func (accelerateBackend) Vvtanhf(input, output []float32) {
+	Vvtanhf(input, output)
+}
+
+func (accelerateBackend) Vvrecf(input, output []float32) {
+	Vvrecf(input, output)
+}
+
+func (accelerateBackend) Vvfloorf(input, output []float32) {
+	Vvfloorf(input, output)
+}
+
+func (accelerateBackend) Vvceilf(input, output []float32) {
+	Vvceilf(input, output)
+}
+
+func (accelerateBackend) Vvintf(input, output []float32) {
+	Vvintf(input, output)
+}
+
+func (accelerateBackend) Vvpowf(base, exponent, output []float32) {
+	Vvpowf(base, exponent, output)
+}
+
+func (accelerateBackend) Vvatan2f(y, x, output []float32) {
+	Vvatan2f(y, x, output)
+}
+
+func (accelerateBackend) Vvsincosf(input, sin, cos []float32) {
+	Vvsincosf(input, sin, cos)
+}
+
+func (accelerateBackend) Vvexp(input, output []float64) {
+	Vvexp(input, output)
+}
+
+func (accelerateBackend) Vvlog(input, output []float64) {
+	Vvlog(input, output)
+}
+
+func (accelerateBackend) Vvlog2(input, output []float64) {
+	Vvlog2(input, output)
+}
+
+func (accelerateBackend) Vvsqrt(input, output []float64) {
+	Vvsqrt(input, output)
+}
+
+func (accelerateBackend) Vvrsqrt(input, output []float64) {
+	Vvrsqrt(input, output)
+}
+
+func (accelerateBackend) Vvsin(input, output []float64) {
+	Vvsin(input, output)
+}
+
+func (accelerateBackend) Vvcos(input, output []float64) {
+	Vvcos(input, output)
+}
+
+func (accelerateBackend) Vvtan(input, output []float64) {
+	Vvtan(input, output)
+}
+
+func (accelerateBackend) Vvtanh(input, output []float64) {
+	Vvtanh(input, output)
+}
+
+func (accelerateBackend) Vvrec(input, output []float64) {
+	Vvrec(input, output)
+}
+
+func (accelerateBackend) Vvfloor(input, output []float64) {
+	Vvfloor(input, output)
+}
+
+func (accelerateBackend) Vvceil(input, output []float64) {
+	Vvceil(input, output)
+}
+
+func (accelerateBackend) Vvint(input, output []float64) {
+	Vvint(input, output)
+}
+
+func (accelerateBackend) Vvpow(base, exponent, output []float64) {
+	Vvpow(base, exponent, output)
+}
+
+func (accelerateBackend) Vvatan2(y, x, output []float64) {
+	Vvatan2(y, x, output)
+}
+
+func (accelerateBackend) Vvsincos(input, sin, cos []float64) {
+	Vvsincos(input, sin, cos)
+}
+
+func (accelerateBackend) Vvlog10(input, output []float64) {
+	Vvlog10(input, output)
+}
@@ -0,0 +1,969 @@
+package accel
+
+func init() {
+	RegisterBackend("purego", genericBackend{})
+}
+
+var (
+	_ FFTBackend     = genericBackend{}
+	_ BiquadBackend  = genericBackend{}
+	_ VectorBackend  = genericBackend{}
+	_ VectorBackendD = genericBackend{}
+	_ VForceBackend  = genericBackend{}
+	_ VImageBackend  = genericBackend{}
+)
+
+// genericBackend is the portable pure Go implementation. It is the backend
+// behind the package level functions when Accelerate is not available.
+type genericBackend struct{}
+
+func (genericBackend) Name() string {
+	return "purego"
+}
+
+func (genericBackend) CreateFFTSetup(log2n int, radix FFTRadix) (BackendFFTSetup, error) {
+	plan, err := newFFTPlan(log2n, radix)
+	if err != nil {
+		return nil, err
+	}
+	return &genericFFTSetup{plan}, nil
+}
+
+func (genericBackend) CreateFFTSetupD(log2n int, radix FFTRadix) (BackendFFTSetupD, error) {
+	plan, err := newFFTPlan(log2n, radix)
+	if err != nil {
+		return nil, err
+	}
+	return &genericFFTSetupD{plan}, nil
+}
+
+func (genericBackend) CreateBiquadSetup(sections []BiquadCoefficients) (BackendBiquadSetup, error) {
+	if len(sections) == 0 {
+		return nil, ErrFailedToCreateBiquadSetup
+	}
+	return &genericBiquadSetup{append([]BiquadCoefficients(nil), sections...)}, nil
+}
+
+func (genericBackend) CreateBiquadmSetup(channels [][]BiquadCoefficients) (BackendBiquadmSetup, error) {
+	channels, delays, err := newBiquadmState(channels)
+	if err != nil {
+		return nil, err
+	}
+	return &genericBiquadmSetup{channels, delays}, nil
+}
+
+func (genericBackend) Vflt8(input []int8, inputStride int, output []float32, outputStride int) {
+	vflt8Generic(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vflt8_byte(input []byte, inputStride int, output []float32, outputStride int) {
+	vflt8ByteGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vfltu8(input []byte, inputStride int, output []float32, outputStride int) {
+	vfltu8Generic(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vflt16(input []int16, inputStride int, output []float32, outputStride int) {
+	vflt16Generic(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vflt16_byte(input []byte, inputStride int, output []float32, outputStride int) {
+	vflt16ByteGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vflt32(input []int32, inputStride int, output []float32, outputStride int) {
+	vflt32Generic(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vflt32_byte(input []byte, inputStride int, output []float32, outputStride int) {
+	vflt32ByteGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vdpsp(input []float64, inputStride int, output []float32, outputStride int) {
+	vdpspGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vdpsp_byte(input []byte, inputStride int, output []float32, outputStride int) {
+	vdpspByteGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Ctoz(input []complex64, inputStride int, output DSPSplitComplex, outputStride int) {
+	ctozGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Ctoz_float(input []float32, inputStride int, output DSPSplitComplex, outputStride int) {
+	ctozFloatGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Ctoz_byte(input []byte, inputStride int, output DSPSplitComplex, outputStride int) {
+	ctozByteGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Ztoc(input DSPSplitComplex, inputStride int, output []complex64, outputStride int) {
+	ztocGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Ztoc_float(input DSPSplitComplex, inputStride int, output []float32, outputStride int) {
+	ztocFloatGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Ztoc_byte(input DSPSplitComplex, inputStride int, output []byte, outputStride int) {
+	ztocByteGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vclr(vec []float32, stride int) {
+	vclrGeneric(vec, stride)
+}
+
+func (genericBackend) Vfill(value float32, output []float32, stride int) {
+	vfillGeneric(value, output, stride)
+}
+
+func (genericBackend) Vclip(input []float32, inputStride int, low, high float32, output []float32, outputStride int) {
+	vclipGeneric(input, inputStride, low, high, output, outputStride)
+}
+
+func (genericBackend) Vthr(input []float32, inputStride int, low float32, output []float32, outputStride int) {
+	vthrGeneric(input, inputStride, low, output, outputStride)
+}
+
+func (genericBackend) Desamp(input []float32, desamplingFactor int, coeff []float32, output []float32) {
+	desampGeneric(input, desamplingFactor, coeff, output)
+}
+
+func (genericBackend) Zrdesamp(input DSPSplitComplex, decimationFactor int, coefficients []float32, output DSPSplitComplex) {
+	zrdesampGeneric(input, decimationFactor, coefficients, output)
+}
+
+func (genericBackend) Zvphas(input DSPSplitComplex, inputStride int, output []float32, outputStride int) {
+	zvphasGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Zidotpr(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex) {
+	zidotprGeneric(input1, stride1, input2, stride2, result)
+}
+
+func (genericBackend) Zvcmul(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex, resultStride int) {
+	zvcmulGeneric(input1, stride1, input2, stride2, result, resultStride)
+}
+
+func (genericBackend) Vfix16(input []float32, inputStride int, output []int16, outputStride int) {
+	vfix16Generic(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vfix16_byte(input []float32, inputStride int, output []byte, outputStride int) {
+	vfix16ByteGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vadd(input1 []float32, input1Stride int, input2 []float32, input2Stride int, output []float32, outputStride int) {
+	vaddGeneric(input1, input1Stride, input2, input2Stride, output, outputStride)
+}
+
+func (genericBackend) Vsmsa(input []float32, inputStride int, mult, add float32, output []float32, outputStride int) {
+	vsmsaGeneric(input, inputStride, mult, add, output, outputStride)
+}
+
+func (genericBackend) Vabs(input []float32, inputStride int, output []float32, outputStride int) {
+	vabsGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vsq(input []float32, inputStride int, output []float32, outputStride int) {
+	vsqGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Zvabs(input DSPSplitComplex, inputStride int, output []float32, outputStride int) {
+	zvabsGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) ZvabsD(input DSPDoubleSplitComplex, inputStride int, output []float64, outputStride int) {
+	zvabsDGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Maxv(input []float32, stride int) float32 {
+	return maxvGeneric(input, stride)
+}
+
+func (genericBackend) Minv(input []float32, stride int) float32 {
+	return minvGeneric(input, stride)
+}
+
+func (genericBackend) Sve(input []float32, inputStride int) float32 {
+	return sveGeneric(input, inputStride)
+}
+
+func (genericBackend) Vavlin(input []float32, inputStride int, count float32, output []float32, outputStride int) {
+	vavlinGeneric(input, inputStride, count, output, outputStride)
+}
+
+func (genericBackend) Vmul(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	vmulGeneric(input1, stride1, input2, stride2, output, outputStride)
+}
+
+func (genericBackend) Vsadd(input []float32, inputStride int, add float32, output []float32, outputStride int) {
+	vsaddGeneric(input, inputStride, add, output, outputStride)
+}
+
+func (genericBackend) Vsdiv(input []float32, inputStride int, divisor float32, output []float32, outputStride int) {
+	vsdivGeneric(input, inputStride, divisor, output, outputStride)
+}
+
+func (genericBackend) Vneg(input []float32, inputStride int, output []float32, outputStride int) {
+	vnegGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vswsum(input []float32, inputStride int, output []float32, outputStride, windowLen int) {
+	vswsumGeneric(input, inputStride, output, outputStride, windowLen)
+}
+
+func (genericBackend) Vdbcon(input []float32, inputStride int, zeroReference float32, output []float32, outputStride int, flag DBFlag) {
+	vdbconGeneric(input, inputStride, zeroReference, output, outputStride, flag)
+}
+
+func (genericBackend) Meanv(input []float32, stride int) float32 {
+	return meanvGeneric(input, stride)
+}
+
+func (genericBackend) HannWindow(output []float32, flag WindowFlag) {
+	hannWindowGeneric(output, flag)
+}
+
+func (genericBackend) HammWindow(output []float32, flag WindowFlag) {
+	hammWindowGeneric(output, flag)
+}
+
+func (genericBackend) BlkmanWindow(output []float32, flag WindowFlag) {
+	blkmanWindowGeneric(output, flag)
+}
+
+func (genericBackend) Vvlog10_float(input, output []float32) {
+	vvlog10Generic(input, output)
+}
+
+func (genericBackend) VImagePermuteChannels_ARGB8888(src, dst *VImageBuffer, permuteMap [4]uint8, flags VImageFlag) error {
+	return vImagePermuteChannelsARGB8888Generic(src, dst, permuteMap, flags)
+}
+
+func (genericBackend) VImageAlphaBlend_ARGB8888(srcTop, srcBottom, dst *VImageBuffer, flags VImageFlag) error {
+	return vImageAlphaBlendARGB8888Generic(srcTop, srcBottom, dst, flags)
+}
+
+func (genericBackend) VImagePremultipliedConstAlphaBlend_ARGB8888(srcTop *VImageBuffer, constAlpha uint8, srcBottom, dst *VImageBuffer, flags VImageFlag) error {
+	return vImagePremultipliedConstAlphaBlendARGB8888Generic(srcTop, constAlpha, srcBottom, dst, flags)
+}
+
+func (genericBackend) VImageRichardsonLucyDeConvolve_ARGBFFFF(src, dst *VImageBuffer, tempBuffer []byte, roiX, roiY int, kernel, kernel2 []float32, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2 int, backgroundColor [4]float32, iterationCount int, flags VImageFlag) error {
+	return vImageRichardsonLucyDeConvolveARGBFFFFGeneric(src, dst, roiX, roiY, kernel, kernel2, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, backgroundColor, iterationCount, flags)
+}
+
+func (genericBackend) VImageRichardsonLucyDeConvolve_ARGB8888(src, dst *VImageBuffer, tempBuffer []byte, roiX, roiY int, kernel, kernel2 []int16, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, divisor, divisor2 int, backgroundColor [4]uint8, iterationCount int, flags VImageFlag) error {
+	return vImageRichardsonLucyDeConvolveARGB8888Generic(src, dst, roiX, roiY, kernel, kernel2, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, divisor, divisor2, backgroundColor, iterationCount, flags)
+}
+
+func (genericBackend) VImageConvolve_ARGB8888(src, dst *VImageBuffer, tempBuffer []byte, roiX, roiY int, kernel []int16, kernelHeight, kernelWidth, divisor int, backgroundColor [4]uint8, flags VImageFlag) error {
+	return vImageConvolveARGB8888Generic(src, dst, roiX, roiY, kernel, kernelHeight, kernelWidth, divisor, backgroundColor, flags)
+}
+
+func (genericBackend) VImageHistogramCalculation_ARGB8888(src *VImageBuffer, flags VImageFlag) ([4][]int, error) {
+	return vImageHistogramCalculationARGB8888Generic(src, flags)
+}
+
+func (genericBackend) VImageHistogramCalculation_Planar8(src *VImageBuffer, flags VImageFlag) ([]int, error) {
+	return vImageHistogramCalculationPlanar8Generic(src, flags)
+}
+
+type genericFFTSetup struct {
+	plan *fftPlan
+}
+
+func (fs *genericFFTSetup) Destroy() {
+	fs.plan = nil
+}
+
+func (fs *genericFFTSetup) Zrip(ioData DSPSplitComplex, stride, log2n int, direction FFTDirection) {
+	fftZropGeneric(fs.plan, ioData.Real, ioData.Imag, stride, ioData.Real, ioData.Imag, stride, log2n, direction)
+}
+
+func (fs *genericFFTSetup) Zrop(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	fftZropGeneric(fs.plan, input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride, log2n, direction)
+}
+
+func (fs *genericFFTSetup) Zip(ioData DSPSplitComplex, stride, log2n int, direction FFTDirection) {
+	fftZipGeneric(fs.plan, ioData.Real, ioData.Imag, stride, log2n, direction)
+}
+
+func (fs *genericFFTSetup) Zop(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	fftZopGeneric(fs.plan, input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride, log2n, direction)
+}
+
+func (fs *genericFFTSetup) Zop3(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	fftZopRadixGeneric(fs.plan, input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride, log2n, 3, direction)
+}
+
+func (fs *genericFFTSetup) Zop5(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	fftZopRadixGeneric(fs.plan, input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride, log2n, 5, direction)
+}
+
+func (fs *genericFFTSetup) Zip2D(ioData DSPSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	fft2dZipGeneric(fs.plan, ioData.Real, ioData.Imag, rowStride, colStride, log2nCols, log2nRows, direction)
+}
+
+func (fs *genericFFTSetup) Zop2D(input DSPSplitComplex, inputRowStride, inputColStride int, output DSPSplitComplex, outputRowStride, outputColStride, log2nCols, log2nRows int, direction FFTDirection) {
+	fft2dZopGeneric(fs.plan, input.Real, input.Imag, inputRowStride, inputColStride, output.Real, output.Imag, outputRowStride, outputColStride, log2nCols, log2nRows, direction)
+}
+
+func (fs *genericFFTSetup) Zrip2D(ioData DSPSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	fft2dZripGeneric(fs.plan, ioData.Real, ioData.Imag, rowStride, colStride, log2nCols, log2nRows, direction)
+}
+
+func (fs *genericFFTSetup) ZripM(ioData DSPSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection) {
+	fftmZropGeneric(fs.plan, ioData.Real, ioData.Imag, stride, signalStride, ioData.Real, ioData.Imag, stride, signalStride, log2n, count, direction)
+}
+
+func (fs *genericFFTSetup) ZropM(input DSPSplitComplex, inputStride, inputSignalStride int, output DSPSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	fftmZropGeneric(fs.plan, input.Real, input.Imag, inputStride, inputSignalStride, output.Real, output.Imag, outputStride, outputSignalStride, log2n, count, direction)
+}
+
+func (fs *genericFFTSetup) ZipM(ioData DSPSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection) {
+	fftmZipGeneric(fs.plan, ioData.Real, ioData.Imag, stride, signalStride, log2n, count, direction)
+}
+
+func (fs *genericFFTSetup) ZopM(input DSPSplitComplex, inputStride, inputSignalStride int, output DSPSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	fftmZopGeneric(fs.plan, input.Real, input.Imag, inputStride, inputSignalStride, output.Real, output.Imag, outputStride, outputSignalStride, log2n, count, direction)
+}
+
+type genericFFTSetupD struct {
+	plan *fftPlan
+}
+
+func (fs *genericFFTSetupD) Destroy() {
+	fs.plan = nil
+}
+
+func (fs *genericFFTSetupD) Zrip(ioData DSPDoubleSplitComplex, stride, log2n int, direction FFTDirection) {
+	fftZropGeneric(fs.plan, ioData.Real, ioData.Imag, stride, ioData.Real, ioData.Imag, stride, log2n, direction)
+}
+
+func (fs *genericFFTSetupD) Zrop(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	fftZropGeneric(fs.plan, input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride, log2n, direction)
+}
+
+func (fs *genericFFTSetupD) Zip(ioData DSPDoubleSplitComplex, stride, log2n int, direction FFTDirection) {
+	fftZipGeneric(fs.plan, ioData.Real, ioData.Imag, stride, log2n, direction)
+}
+
+func (fs *genericFFTSetupD) Zop(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	fftZopGeneric(fs.plan, input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride, log2n, direction)
+}
+
+func (fs *genericFFTSetupD) Zop3(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	fftZopRadixGeneric(fs.plan, input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride, log2n, 3, direction)
+}
+
+func (fs *genericFFTSetupD) Zop5(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	fftZopRadixGeneric(fs.plan, input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride, log2n, 5, direction)
+}
+
+func (fs *genericFFTSetupD) Zip2D(ioData DSPDoubleSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	fft2dZipGeneric(fs.plan, ioData.Real, ioData.Imag, rowStride, colStride, log2nCols, log2nRows, direction)
+}
+
+func (fs *genericFFTSetupD) Zop2D(input DSPDoubleSplitComplex, inputRowStride, inputColStride int, output DSPDoubleSplitComplex, outputRowStride, outputColStride, log2nCols, log2nRows int, direction FFTDirection) {
+	fft2dZopGeneric(fs.plan, input.Real, input.Imag, inputRowStride, inputColStride, output.Real, output.Imag, outputRowStride, outputColStride, log2nCols, log2nRows, direction)
+}
+
+func (fs *genericFFTSetupD) Zrip2D(ioData DSPDoubleSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	fft2dZripGeneric(fs.plan, ioData.Real, ioData.Imag, rowStride, colStride, log2nCols, log2nRows, direction)
+}
+
+func (fs *genericFFTSetupD) ZripM(ioData DSPDoubleSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection) {
+	fftmZropGeneric(fs.plan, ioData.Real, ioData.Imag, stride, signalStride, ioData.Real, ioData.Imag, stride, signalStride, log2n, count, direction)
+}
+
+func (fs *genericFFTSetupD) ZropM(input DSPDoubleSplitComplex, inputStride, inputSignalStride int, output DSPDoubleSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	fftmZropGeneric(fs.plan, input.Real, input.Imag, inputStride, inputSignalStride, output.Real, output.Imag, outputStride, outputSignalStride, log2n, count, direction)
+}
+
+func (fs *genericFFTSetupD) ZipM(ioData DSPDoubleSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection) {
+	fftmZipGeneric(fs.plan, ioData.Real, ioData.Imag, stride, signalStride, log2n, count, direction)
+}
+
+func (fs *genericFFTSetupD) ZopM(input DSPDoubleSplitComplex, inputStride, inputSignalStride int, output DSPDoubleSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	fftmZopGeneric(fs.plan, input.Real, input.Imag, inputStride, inputSignalStride, output.Real, output.Imag, outputStride, outputSignalStride, log2n, count, direction)
+}
+
+func (genericBackend) VclipD(input []float64, inputStride int, low, high float64, output []float64, outputStride int) {
+	vclipGeneric(input, inputStride, low, high, output, outputStride)
+}
+
+func (genericBackend) VthrD(input []float64, inputStride int, low float64, output []float64, outputStride int) {
+	vthrGeneric(input, inputStride, low, output, outputStride)
+}
+
+func (genericBackend) VaddD(input1 []float64, input1Stride int, input2 []float64, input2Stride int, output []float64, outputStride int) {
+	vaddGeneric(input1, input1Stride, input2, input2Stride, output, outputStride)
+}
+
+func (genericBackend) VsmsaD(input []float64, inputStride int, mult, add float64, output []float64, outputStride int) {
+	vsmsaGeneric(input, inputStride, mult, add, output, outputStride)
+}
+
+func (genericBackend) VabsD(input []float64, inputStride int, output []float64, outputStride int) {
+	vabsGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) VsqD(input []float64, inputStride int, output []float64, outputStride int) {
+	vsqGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) MaxvD(input []float64, stride int) float64 {
+	return maxvGeneric(input, stride)
+}
+
+func (genericBackend) MinvD(input []float64, stride int) float64 {
+	return minvGeneric(input, stride)
+}
+
+func (genericBackend) SveD(input []float64, inputStride int) float64 {
+	return sveGeneric(input, inputStride)
+}
+
+func (genericBackend) VmulD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	vmulGeneric(input1, stride1, input2, stride2, output, outputStride)
+}
+
+func (genericBackend) VsaddD(input []float64, inputStride int, add float64, output []float64, outputStride int) {
+	vsaddGeneric(input, inputStride, add, output, outputStride)
+}
+
+func (genericBackend) VnegD(input []float64, inputStride int, output []float64, outputStride int) {
+	vnegGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) MeanvD(input []float64, stride int) float64 {
+	return meanvGeneric(input, stride)
+}
+
+func (genericBackend) Vflt8D(input []int8, inputStride int, output []float64, outputStride int) {
+	vflt8Generic(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vfltu8D(input []byte, inputStride int, output []float64, outputStride int) {
+	vfltu8Generic(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vflt16D(input []int16, inputStride int, output []float64, outputStride int) {
+	vflt16Generic(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vflt32D(input []int32, inputStride int, output []float64, outputStride int) {
+	vflt32Generic(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vspdp(input []float32, inputStride int, output []float64, outputStride int) {
+	vspdpGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vfix16D(input []float64, inputStride int, output []int16, outputStride int) {
+	vfix16Generic(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) CtozD(input []complex128, inputStride int, output DSPDoubleSplitComplex, outputStride int) {
+	ctozDGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) ZtocD(input DSPDoubleSplitComplex, inputStride int, output []complex128, outputStride int) {
+	ztocDGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) VclrD(vec []float64, stride int) {
+	vclrGeneric(vec, stride)
+}
+
+func (genericBackend) VfillD(value float64, output []float64, stride int) {
+	vfillGeneric(value, output, stride)
+}
+
+func (genericBackend) DesampD(input []float64, desamplingFactor int, coeff []float64, output []float64) {
+	desampGeneric(input, desamplingFactor, coeff, output)
+}
+
+func (genericBackend) ZrdesampD(input DSPDoubleSplitComplex, decimationFactor int, coefficients []float64, output DSPDoubleSplitComplex) {
+	zrdesampDGeneric(input, decimationFactor, coefficients, output)
+}
+
+func (genericBackend) ZvphasD(input DSPDoubleSplitComplex, inputStride int, output []float64, outputStride int) {
+	zvphasDGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) ZidotprD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex) {
+	zidotprDGeneric(input1, stride1, input2, stride2, result)
+}
+
+func (genericBackend) ZvcmulD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex, resultStride int) {
+	zvcmulDGeneric(input1, stride1, input2, stride2, result, resultStride)
+}
+
+func (genericBackend) VavlinD(input []float64, inputStride int, count float64, output []float64, outputStride int) {
+	vavlinGeneric(input, inputStride, count, output, outputStride)
+}
+
+func (genericBackend) VsdivD(input []float64, inputStride int, divisor float64, output []float64, outputStride int) {
+	vsdivGeneric(input, inputStride, divisor, output, outputStride)
+}
+
+func (genericBackend) VswsumD(input []float64, inputStride int, output []float64, outputStride, windowLen int) {
+	vswsumGeneric(input, inputStride, output, outputStride, windowLen)
+}
+
+func (genericBackend) VdbconD(input []float64, inputStride int, zeroReference float64, output []float64, outputStride int, flag DBFlag) {
+	vdbconGeneric(input, inputStride, zeroReference, output, outputStride, flag)
+}
+
+func (genericBackend) HannWindowD(output []float64, flag WindowFlag) {
+	hannWindowGeneric(output, flag)
+}
+
+func (genericBackend) HammWindowD(output []float64, flag WindowFlag) {
+	hammWindowGeneric(output, flag)
+}
+
+func (genericBackend) BlkmanWindowD(output []float64, flag WindowFlag) {
+	blkmanWindowGeneric(output, flag)
+}
+
+func (genericBackend) Conv(input []float32, inputStride int, filter []float32, filterStride int, output []float32, outputStride int) {
+	convGeneric(input, inputStride, filter, filterStride, output, outputStride)
+}
+
+func (genericBackend) Zconv(input DSPSplitComplex, inputStride int, filter DSPSplitComplex, filterStride int, output DSPSplitComplex, outputStride int) {
+	zconvGeneric(input, inputStride, filter, filterStride, output, outputStride)
+}
+
+func (genericBackend) ConvD(input []float64, inputStride int, filter []float64, filterStride int, output []float64, outputStride int) {
+	convGeneric(input, inputStride, filter, filterStride, output, outputStride)
+}
+
+func (genericBackend) ZconvD(input DSPDoubleSplitComplex, inputStride int, filter DSPDoubleSplitComplex, filterStride int, output DSPDoubleSplitComplex, outputStride int) {
+	zconvDGeneric(input, inputStride, filter, filterStride, output, outputStride)
+}
+
+type genericBiquadSetup struct {
+	sections []BiquadCoefficients
+}
+
+func (bs *genericBiquadSetup) Destroy() {
+	bs.sections = nil
+}
+
+func (bs *genericBiquadSetup) Biquad(delay []float32, input []float32, inputStride int, output []float32, outputStride int) {
+	biquadGeneric(bs.sections, delay, input, inputStride, output, outputStride)
+}
+
+type genericBiquadmSetup struct {
+	channels [][]BiquadCoefficients
+	delays   [][]float32
+}
+
+func (bs *genericBiquadmSetup) Destroy() {
+	bs.channels = nil
+	bs.delays = nil
+}
+
+func (bs *genericBiquadmSetup) ResetState() {
+	for _, d := range bs.delays {
+		clear(d)
+	}
+}
+
+func (bs *genericBiquadmSetup) Biquadm(input [][]float32, inputStride int, output [][]float32, outputStride int) {
+	biquadmGeneric(bs.channels, bs.delays, input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Zvmul(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex, resultStride int, conjugate bool) {
+	zvmulGeneric(input1, stride1, input2, stride2, result, resultStride, conjugate)
+}
+
+func (genericBackend) Zvconj(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int) {
+	zvconjGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) ZvmulD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex, resultStride int, conjugate bool) {
+	zvmulDGeneric(input1, stride1, input2, stride2, result, resultStride, conjugate)
+}
+
+func (genericBackend) ZvconjD(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int) {
+	zvconjDGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Rmsqv(input []float32, stride int) float32 {
+	return rmsqvGeneric(input, stride)
+}
+
+func (genericBackend) Measqv(input []float32, stride int) float32 {
+	return measqvGeneric(input, stride)
+}
+
+func (genericBackend) Svesq(input []float32, stride int) float32 {
+	return svesqGeneric(input, stride)
+}
+
+func (genericBackend) Svs(input []float32, stride int) float32 {
+	return svsGeneric(input, stride)
+}
+
+func (genericBackend) Maxmgv(input []float32, stride int) float32 {
+	return maxmgvGeneric(input, stride)
+}
+
+func (genericBackend) RmsqvD(input []float64, stride int) float64 {
+	return rmsqvGeneric(input, stride)
+}
+
+func (genericBackend) MeasqvD(input []float64, stride int) float64 {
+	return measqvGeneric(input, stride)
+}
+
+func (genericBackend) SvesqD(input []float64, stride int) float64 {
+	return svesqGeneric(input, stride)
+}
+
+func (genericBackend) SvsD(input []float64, stride int) float64 {
+	return svsGeneric(input, stride)
+}
+
+func (genericBackend) MaxmgvD(input []float64, stride int) float64 {
+	return maxmgvGeneric(input, stride)
+}
+
+func (genericBackend) Maxvi(input []float32, stride int) (float32, int) {
+	return maxviGeneric(input, stride)
+}
+
+func (genericBackend) Minvi(input []float32, stride int) (float32, int) {
+	return minviGeneric(input, stride)
+}
+
+func (genericBackend) Normalize(input []float32, inputStride int, output []float32, outputStride int) (mean, stdDev float32) {
+	return normalizeGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) MaxviD(input []float64, stride int) (float64, int) {
+	return maxviGeneric(input, stride)
+}
+
+func (genericBackend) MinviD(input []float64, stride int) (float64, int) {
+	return minviGeneric(input, stride)
+}
+
+func (genericBackend) NormalizeD(input []float64, inputStride int, output []float64, outputStride int) (mean, stdDev float64) {
+	return normalizeGeneric(input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vsub(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	vsubGeneric(input1, stride1, input2, stride2, output, outputStride)
+}
+
+func (genericBackend) Vdiv(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	vdivGeneric(input1, stride1, input2, stride2, output, outputStride)
+}
+
+func (genericBackend) Vsmul(input []float32, inputStride int, mult float32, output []float32, outputStride int) {
+	vsmulGeneric(input, inputStride, mult, output, outputStride)
+}
+
+func (genericBackend) Svdiv(numerator float32, input []float32, inputStride int, output []float32, outputStride int) {
+	svdivGeneric(numerator, input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vma(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	vmaGeneric(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+func (genericBackend) Vmsa(input1 []float32, stride1 int, input2 []float32, stride2 int, add float32, output []float32, outputStride int) {
+	vmsaGeneric(input1, stride1, input2, stride2, add, output, outputStride)
+}
+
+func (genericBackend) Vmsb(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	vmsbGeneric(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+func (genericBackend) Vam(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	vamGeneric(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+func (genericBackend) Vsbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	vsbmGeneric(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+func (genericBackend) Vasm(input1 []float32, stride1 int, input2 []float32, stride2 int, mult float32, output []float32, outputStride int) {
+	vasmGeneric(input1, stride1, input2, stride2, mult, output, outputStride)
+}
+
+func (genericBackend) Vsbsm(input1 []float32, stride1 int, input2 []float32, stride2 int, mult float32, output []float32, outputStride int) {
+	vsbsmGeneric(input1, stride1, input2, stride2, mult, output, outputStride)
+}
+
+func (genericBackend) Vsma(input1 []float32, stride1 int, mult float32, input2 []float32, stride2 int, output []float32, outputStride int) {
+	vsmaGeneric(input1, stride1, mult, input2, stride2, output, outputStride)
+}
+
+func (genericBackend) Vsmsb(input1 []float32, stride1 int, mult float32, input2 []float32, stride2 int, output []float32, outputStride int) {
+	vsmsbGeneric(input1, stride1, mult, input2, stride2, output, outputStride)
+}
+
+func (genericBackend) Vmma(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	vmmaGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (genericBackend) Vmmsb(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	vmmsbGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (genericBackend) Vaam(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	vaamGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (genericBackend) Vsbsbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	vsbsbmGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (genericBackend) Vasbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	vasbmGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (genericBackend) Vintb(input1 []float32, stride1 int, input2 []float32, stride2 int, fraction float32, output []float32, outputStride int) {
+	vintbGeneric(input1, stride1, input2, stride2, fraction, output, outputStride)
+}
+
+func (genericBackend) Vdist(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	vdistGeneric(input1, stride1, input2, stride2, output, outputStride)
+}
+
+func (genericBackend) Vpoly(coefficients []float32, coeffStride int, input []float32, inputStride int, output []float32, outputStride int) {
+	vpolyGeneric(coefficients, coeffStride, input, inputStride, output, outputStride)
+}
+
+func (genericBackend) Vlint(table []float32, indices []float32, indicesStride int, output []float32, outputStride int) {
+	vlintGeneric(table, indices, indicesStride, output, outputStride)
+}
+
+func (genericBackend) VsubD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	vsubGeneric(input1, stride1, input2, stride2, output, outputStride)
+}
+
+func (genericBackend) VdivD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	vdivGeneric(input1, stride1, input2, stride2, output, outputStride)
+}
+
+func (genericBackend) VsmulD(input []float64, inputStride int, mult float64, output []float64, outputStride int) {
+	vsmulGeneric(input, inputStride, mult, output, outputStride)
+}
+
+func (genericBackend) SvdivD(numerator float64, input []float64, inputStride int, output []float64, outputStride int) {
+	svdivGeneric(numerator, input, inputStride, output, outputStride)
+}
+
+func (genericBackend) VmaD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	vmaGeneric(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+func (genericBackend) VmsaD(input1 []float64, stride1 int, input2 []float64, stride2 int, add float64, output []float64, outputStride int) {
+	vmsaGeneric(input1, stride1, input2, stride2, add, output, outputStride)
+}
+
+func (genericBackend) VmsbD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	vmsbGeneric(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+func (genericBackend) VamD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	vamGeneric(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+func (genericBackend) VsbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	vsbmGeneric(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+}
+
+func (genericBackend) VasmD(input1 []float64, stride1 int, input2 []float64, stride2 int, mult float64, output []float64, outputStride int) {
+	vasmGeneric(input1, stride1, input2, stride2, mult, output, outputStride)
+}
+
+func (genericBackend) VsbsmD(input1 []float64, stride1 int, input2 []float64, stride2 int, mult float64, output []float64, outputStride int) {
+	vsbsmGeneric(input1, stride1, input2, stride2, mult, output, outputStride)
+}
+
+func (genericBackend) VsmaD(input1 []float64, stride1 int, mult float64, input2 []float64, stride2 int, output []float64, outputStride int) {
+	vsmaGeneric(input1, stride1, mult, input2, stride2, output, outputStride)
+}
+
+func (genericBackend) VsmsbD(input1 []float64, stride1 int, mult float64, input2 []float64, stride2 int, output []float64, outputStride int) {
+	vsmsbGeneric(input1, stride1, mult, input2, stride2, output, outputStride)
+}
+
+func (genericBackend) VmmaD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	vmmaGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (genericBackend) VmmsbD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	vmmsbGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (genericBackend) VaamD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	vaamGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (genericBackend) VsbsbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	vsbsbmGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (genericBackend) VasbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	vasbmGeneric(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+}
+
+func (genericBackend) VintbD(input1 []float64, stride1 int, input2 []float64, stride2 int, fraction float64, output []float64, outputStride int) {
+	vintbGeneric(input1, stride1, input2, stride2, fraction, output, outputStride)
+}
+
+func (genericBackend) VdistD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	vdistGeneric(input1, stride1, input2, stride2, output, outputStride)
+}
+
+func (genericBackend) VpolyD(coefficients []float64, coeffStride int, input []float64, inputStride int, output []float64, outputStride int) {
+	vpolyGeneric(coefficients, coeffStride, input, inputStride, output, outputStride)
+}
+
+func (genericBackend) VlintD(table []float64, indices []float64, indicesStride int, output []float64, outputStride int) {
+	vlintGeneric(table, indices, indicesStride, output, outputStride)
+}
+
+func (genericBackend) Vvexpf(input, output []float32) {
+	vvexpGeneric(input, output)
+}
+
+func (genericBackend) Vvlogf(input, output []float32) {
+	vvlogGeneric(input, output)
+}
+
+func (genericBackend) Vvlog2f(input, output []float32) {
+	vvlog2Generic(input, output)
+}
+
+func (genericBackend) Vvsqrtf(input, output []float32) {
+	vvsqrtGeneric(input, output)
+}
+
+func (genericBackend) Vvrsqrtf(input, output []float32) {
+	vvrsqrtGeneric(input, output)
+}
+
+func (genericBackend) Vvsinf(input, output []float32) {
+	vvsinGeneric(input, output)
+}
+
+func (genericBackend) Vvcosf(input, output []float32) {
+	vvcosGeneric(input, output)
+}
+
+func (genericBackend) Vvtanf(input, output []float32) {
+	vvtanGeneric(input, output)
+}
+
+func (genericBackend) Vvtanhf(input, output []float32) {
+	vvtanhGeneric(input, output)
+}
+
+func (genericBackend) Vvrecf(input, output []float32) {
+	vvrecGeneric(input, output)
+}
+
+func (genericBackend) Vvfloorf(input, output []float32) {
+	vvfloorGeneric(input, output)
+}
+
+func (genericBackend) Vvceilf(input, output []float32) {
+	vvceilGeneric(input, output)
+}
+
+func (genericBackend) Vvintf(input, output []float32) {
+	vvintGeneric(input, output)
+}
+
+func (genericBackend) Vvpowf(base, exponent, output []float32) {
+	vvpowGeneric(base, exponent, output)
+}
+
+func (genericBackend) Vvatan2f(y, x, output []float32) {
+	vvatan2Generic(y, x, output)
+}
+
+func (genericBackend) Vvsincosf(input, sin, cos []float32) {
+	vvsincosGeneric(input, sin, cos)
+}
+
+func (genericBackend) Vvexp(input, output []float64) {
+	vvexpGeneric(input, output)
+}
+
+func (genericBackend) Vvlog(input, output []float64) {
+	vvlogGeneric(input, output)
+}
+
+func (genericBackend) Vvlog2(input, output []float64) {
+	vvlog2Generic(input, output)
+}
+
+func (genericBackend) Vvsqrt(input, output []float64) {
+	vvsqrtGeneric(input, output)
+}
+
+func (genericBackend) Vvrsqrt(input, output []float64) {
+	vvrsqrtGeneric(input, output)
+}
+
+func (genericBackend) Vvsin(input, output []float64) {
+	vvsinGeneric(input, output)
+}
+
+func (genericBackend) Vvcos(input, output []float64) {
+	vvcosGeneric(input, output)
+}
+
+func (genericBackend) Vvtan(input, output []float64) {
+	vvtanGeneric(input, output)
+}
+
+func (genericBackend) Vvtanh(input, output []float64) {
+	vvtanhGeneric(input, output)
+}
+
+func (genericBackend) Vvrec(input, output []float64) {
+	vvrecGeneric(input, output)
+}
+
+func (genericBackend) Vvfloor(input, output []float64) {
+	vvfloorGeneric(input, output)
+}
+
+func (genericBackend) Vvceil(input, output []float64) {
+	vvceilGeneric(input, output)
+}
+
+func (genericBackend) Vvint(input, output []float64) {
+	vvintGeneric(input, output)
+}
+
+func (genericBackend) Vvpow(base, exponent, output []float64) {
+	vvpowGeneric(base, exponent, output)
+}
+
+func (genericBackend) Vvatan2(y, x, output []float64) {
+	vvatan2Generic(y, x, output)
+}
+
+func (genericBackend) Vvsincos(input, sin, cos []float64) {
+	vvsincosGeneric(input, sin, cos)
+}
+
+func (genericBackend) Vvlog10(input, output []float64) {
+	vvlog10Generic(input, output)
+}
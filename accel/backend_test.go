@@ -0,0 +1,140 @@
+package accel
+
+import (
+	"slices"
+	"testing"
+)
+
+// skewedBackend is a purego backend with a deliberately wrong Vadd.
+type skewedBackend struct {
+	genericBackend
+}
+
+func (skewedBackend) Name() string {
+	return "skewed"
+}
+
+func (skewedBackend) Vadd(input1 []float32, input1Stride int, input2 []float32, input2Stride int, output []float32, outputStride int) {
+	vaddGeneric(input1, input1Stride, input2, input2Stride, output, outputStride)
+	output[3] += 0.5
+}
+
+func TestLookupBackend(t *testing.T) {
+	if !slices.Contains(Backends(), "purego") {
+		t.Fatalf("purego missing from %v", Backends())
+	}
+	b, err := LookupBackend("purego")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Name() != "purego" {
+		t.Errorf("Name() = %q; want purego", b.Name())
+	}
+	if _, err := LookupBackend("no-such-backend"); err != ErrUnknownBackend {
+		t.Errorf("LookupBackend returned %v; want %v", err, ErrUnknownBackend)
+	}
+	if DefaultBackend() == nil {
+		t.Error("DefaultBackend returned nil")
+	}
+}
+
+// vectorOnlyBackend implements VectorBackend and no other capability.
+type vectorOnlyBackend struct {
+	VectorBackend
+}
+
+func TestBackendCapabilities(t *testing.T) {
+	if _, err := LookupBackendAs[VForceBackend]("purego"); err != nil {
+		t.Errorf("LookupBackendAs[VForceBackend](purego) returned %v", err)
+	}
+	if _, err := LookupBackendAs[VImageBackend]("no-such-backend"); err != ErrUnknownBackend {
+		t.Errorf("LookupBackendAs returned %v; want %v", err, ErrUnknownBackend)
+	}
+
+	RegisterBackend("vector-only", vectorOnlyBackend{genericBackend{}})
+	defer func() {
+		backendsMu.Lock()
+		delete(backends, "vector-only")
+		backendsMu.Unlock()
+	}()
+	if _, err := LookupBackendAs[VectorBackend]("vector-only"); err != nil {
+		t.Errorf("LookupBackendAs[VectorBackend](vector-only) returned %v", err)
+	}
+	if _, err := LookupBackendAs[FFTBackend]("vector-only"); err != ErrUnsupportedBackend {
+		t.Errorf("LookupBackendAs[FFTBackend](vector-only) returned %v; want %v", err, ErrUnsupportedBackend)
+	}
+	if !slices.Contains(BackendsWith[VectorBackend](), "vector-only") {
+		t.Errorf("vector-only missing from BackendsWith[VectorBackend]() = %v", BackendsWith[VectorBackend]())
+	}
+	if slices.Contains(BackendsWith[FFTBackend](), "vector-only") {
+		t.Errorf("vector-only listed by BackendsWith[FFTBackend]() = %v", BackendsWith[FFTBackend]())
+	}
+
+	v := NewVerifyBackend(genericBackend{}, vectorOnlyBackend{genericBackend{}}, 1e-6)
+	input := []float32{1, 2, 3}
+	v.Vadd(input, 1, input, 1, make([]float32, 3), 1)
+	defer func() {
+		if r := recover(); r != ErrUnsupportedBackend {
+			t.Errorf("recovered %v; want %v", r, ErrUnsupportedBackend)
+		}
+	}()
+	v.Vvexpf(input, make([]float32, 3))
+}
+
+func TestVerifyBackend(t *testing.T) {
+	var reported []*MismatchError
+	v := NewVerifyBackend(genericBackend{}, skewedBackend{}, 1e-6)
+	v.OnMismatch = func(m *MismatchError) { reported = append(reported, m) }
+
+	input := []float32{1, 2, 3, 4, 5, 6}
+	output := make([]float32, len(input))
+	v.Vmul(input, 1, input, 1, output, 1)
+	if err := v.Err(); err != nil {
+		t.Fatalf("unexpected mismatch for Vmul: %v", err)
+	}
+
+	v.Vadd(input, 1, input, 1, output, 1)
+	if output[3] != 8 {
+		t.Errorf("Vadd returned candidate output %f; want reference 8", output[3])
+	}
+	err, ok := v.Err().(*MismatchError)
+	if !ok {
+		t.Fatalf("Err() = %v; want *MismatchError", v.Err())
+	}
+	if err.Func != "Vadd" || err.Arg != "output" || err.Index != 3 || err.Want != 8 || err.Got != 8.5 {
+		t.Errorf("unexpected mismatch %+v", err)
+	}
+	if err.Reference != "purego" || err.Candidate != "skewed" {
+		t.Errorf("unexpected backend names %q and %q", err.Reference, err.Candidate)
+	}
+	if len(reported) != 1 {
+		t.Errorf("OnMismatch called %d times; want 1", len(reported))
+	}
+	v.Reset()
+	if v.Err() != nil {
+		t.Error("Err() not cleared by Reset")
+	}
+}
+
+func TestVerifyBackendFFT(t *testing.T) {
+	v := NewVerifyBackend(genericBackend{}, genericBackend{}, 1e-6)
+	fft, err := v.CreateFFTSetup(4, FFTRadix2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fft.Destroy()
+	data := DSPSplitComplex{Real: make([]float32, 16), Imag: make([]float32, 16)}
+	for i := range data.Real {
+		data.Real[i] = float32(i)
+	}
+	fft.Zip(data, 1, 4, FFTDirectionForward)
+	if err := v.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if data.Real[0] != 120 {
+		t.Errorf("DC = %f; want 120", data.Real[0])
+	}
+	if _, err := v.CreateFFTSetup(-1, FFTRadix2); err != ErrFailedToCreateFFTSetup {
+		t.Errorf("CreateFFTSetup(-1) returned %v; want %v", err, ErrFailedToCreateFFTSetup)
+	}
+}
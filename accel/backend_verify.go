@@ -0,0 +1,1865 @@
+package accel
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+)
+
+// MismatchError describes the first element for which the outputs of two
+// backends differ by more than the tolerance of a VerifyBackend.
+type MismatchError struct {
+	Reference string // name of the reference backend
+	Candidate string // name of the candidate backend
+	Func      string // function that was called
+	Arg       string // output argument that differs
+	Index     int    // index of the first differing element in Arg
+	Want, Got float64
+
+	// WantErr and GotErr are set instead of Arg, Index, Want and Got when
+	// the backends returned different errors.
+	WantErr, GotErr error
+}
+
+func (e *MismatchError) Error() string {
+	if e.WantErr != nil || e.GotErr != nil {
+		return fmt.Sprintf("accel: %s returned %v from %s but %v from %s", e.Func, e.WantErr, e.Reference, e.GotErr, e.Candidate)
+	}
+	return fmt.Sprintf("accel: %s %s[%d] is %g from %s but %g from %s", e.Func, e.Arg, e.Index, e.Want, e.Reference, e.Got, e.Candidate)
+}
+
+var (
+	_ FFTBackend     = (*VerifyBackend)(nil)
+	_ BiquadBackend  = (*VerifyBackend)(nil)
+	_ VectorBackend  = (*VerifyBackend)(nil)
+	_ VectorBackendD = (*VerifyBackend)(nil)
+	_ VForceBackend  = (*VerifyBackend)(nil)
+	_ VImageBackend  = (*VerifyBackend)(nil)
+)
+
+// VerifyBackend runs every call on two backends side by side and compares
+// their outputs. The caller always receives the results of the reference
+// backend while the candidate works on copies of the output arguments.
+//
+// VerifyBackend implements every capability interface. Calling a method
+// whose capability the reference or the candidate doesn't implement panics
+// with ErrUnsupportedBackend.
+type VerifyBackend struct {
+	Reference Backend
+	Candidate Backend
+	// Tolerance is the allowed difference between two elements relative
+	// to the larger of their magnitudes (or absolute below 1).
+	Tolerance float64
+	// OnMismatch, if not nil, is called for every call that produced a
+	// mismatch with the first differing element.
+	OnMismatch func(*MismatchError)
+
+	mu    sync.Mutex
+	first *MismatchError
+}
+
+// NewVerifyBackend returns a backend that checks candidate against reference.
+func NewVerifyBackend(reference, candidate Backend, tolerance float64) *VerifyBackend {
+	return &VerifyBackend{
+		Reference: reference,
+		Candidate: candidate,
+		Tolerance: tolerance,
+	}
+}
+
+func (v *VerifyBackend) Name() string {
+	return "verify"
+}
+
+// Err returns the first mismatch seen since the backend was created or
+// Reset was last called. The error is a *MismatchError.
+func (v *VerifyBackend) Err() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.first == nil {
+		return nil
+	}
+	return v.first
+}
+
+// Reset forgets any recorded mismatch.
+func (v *VerifyBackend) Reset() {
+	v.mu.Lock()
+	v.first = nil
+	v.mu.Unlock()
+}
+
+func (v *VerifyBackend) report(m *MismatchError) {
+	m.Reference = v.Reference.Name()
+	m.Candidate = v.Candidate.Name()
+	v.mu.Lock()
+	if v.first == nil {
+		v.first = m
+	}
+	v.mu.Unlock()
+	if v.OnMismatch != nil {
+		v.OnMismatch(m)
+	}
+}
+
+// check reports m, the result of mismatch, if it's not nil.
+func (v *VerifyBackend) check(fn, arg string, m *MismatchError) {
+	if m != nil {
+		m.Func = fn
+		m.Arg = arg
+		v.report(m)
+	}
+}
+
+func (v *VerifyBackend) checkSplit(fn, arg string, want, got DSPSplitComplex) {
+	v.check(fn, arg+".Real", mismatch(want.Real, got.Real, v.Tolerance))
+	v.check(fn, arg+".Imag", mismatch(want.Imag, got.Imag, v.Tolerance))
+}
+
+func (v *VerifyBackend) checkSplitD(fn, arg string, want, got DSPDoubleSplitComplex) {
+	v.check(fn, arg+".Real", mismatch(want.Real, got.Real, v.Tolerance))
+	v.check(fn, arg+".Imag", mismatch(want.Imag, got.Imag, v.Tolerance))
+}
+
+// checkErr reports differing errors and returns true if both are nil.
+func (v *VerifyBackend) checkErr(fn string, want, got error) bool {
+	if want != got {
+		v.report(&MismatchError{Func: fn, WantErr: want, GotErr: got})
+	}
+	return want == nil && got == nil
+}
+
+type number interface {
+	~int | ~int8 | ~uint8 | ~int16 | ~int32 | ~float32 | ~float64
+}
+
+// mismatch returns the first element of got that differs from want by
+// more than tolerance or nil if they match.
+func mismatch[T number](want, got []T, tolerance float64) *MismatchError {
+	for i := 0; i < len(want) && i < len(got); i++ {
+		w, g := float64(want[i]), float64(got[i])
+		if !withinTolerance(w, g, tolerance) {
+			return &MismatchError{Index: i, Want: w, Got: g}
+		}
+	}
+	return nil
+}
+
+func withinTolerance(want, got, tolerance float64) bool {
+	if want == got || (math.IsNaN(want) && math.IsNaN(got)) {
+		return true
+	}
+	scale := math.Max(1, math.Max(math.Abs(want), math.Abs(got)))
+	return math.Abs(want-got) <= tolerance*scale
+}
+
+func cloneSplit(s DSPSplitComplex) DSPSplitComplex {
+	return DSPSplitComplex{Real: slices.Clone(s.Real), Imag: slices.Clone(s.Imag)}
+}
+
+func cloneSplitD(s DSPDoubleSplitComplex) DSPDoubleSplitComplex {
+	return DSPDoubleSplitComplex{Real: slices.Clone(s.Real), Imag: slices.Clone(s.Imag)}
+}
+
+func cloneVImageBuffer(b *VImageBuffer) *VImageBuffer {
+	if b == nil {
+		return nil
+	}
+	c := *b
+	c.Data = slices.Clone(b.Data)
+	return &c
+}
+
+func (v *VerifyBackend) CreateFFTSetup(log2n int, radix FFTRadix) (BackendFFTSetup, error) {
+	got, gotErr := capability[FFTBackend](v.Candidate).CreateFFTSetup(log2n, radix)
+	want, err := capability[FFTBackend](v.Reference).CreateFFTSetup(log2n, radix)
+	if !v.checkErr("CreateFFTSetup", err, gotErr) {
+		if got != nil {
+			got.Destroy()
+		}
+		return want, err
+	}
+	return &verifyFFTSetup{v: v, reference: want, candidate: got}, nil
+}
+
+func (v *VerifyBackend) CreateFFTSetupD(log2n int, radix FFTRadix) (BackendFFTSetupD, error) {
+	got, gotErr := capability[FFTBackend](v.Candidate).CreateFFTSetupD(log2n, radix)
+	want, err := capability[FFTBackend](v.Reference).CreateFFTSetupD(log2n, radix)
+	if !v.checkErr("CreateFFTSetupD", err, gotErr) {
+		if got != nil {
+			got.Destroy()
+		}
+		return want, err
+	}
+	return &verifyFFTSetupD{v: v, reference: want, candidate: got}, nil
+}
+
+func (v *VerifyBackend) CreateBiquadSetup(sections []BiquadCoefficients) (BackendBiquadSetup, error) {
+	got, gotErr := capability[BiquadBackend](v.Candidate).CreateBiquadSetup(sections)
+	want, err := capability[BiquadBackend](v.Reference).CreateBiquadSetup(sections)
+	if !v.checkErr("CreateBiquadSetup", err, gotErr) {
+		if got != nil {
+			got.Destroy()
+		}
+		return want, err
+	}
+	return &verifyBiquadSetup{v: v, reference: want, candidate: got}, nil
+}
+
+func (v *VerifyBackend) CreateBiquadmSetup(channels [][]BiquadCoefficients) (BackendBiquadmSetup, error) {
+	got, gotErr := capability[BiquadBackend](v.Candidate).CreateBiquadmSetup(channels)
+	want, err := capability[BiquadBackend](v.Reference).CreateBiquadmSetup(channels)
+	if !v.checkErr("CreateBiquadmSetup", err, gotErr) {
+		if got != nil {
+			got.Destroy()
+		}
+		return want, err
+	}
+	return &verifyBiquadmSetup{v: v, reference: want, candidate: got}, nil
+}
+
+type verifyFFTSetup struct {
+	v                    *VerifyBackend
+	reference, candidate BackendFFTSetup
+}
+
+func (fs *verifyFFTSetup) Destroy() {
+	fs.reference.Destroy()
+	fs.candidate.Destroy()
+}
+
+func (fs *verifyFFTSetup) Zrip(ioData DSPSplitComplex, stride, log2n int, direction FFTDirection) {
+	ioDataC := cloneSplit(ioData)
+	fs.candidate.Zrip(ioDataC, stride, log2n, direction)
+	fs.reference.Zrip(ioData, stride, log2n, direction)
+	fs.v.checkSplit("FFTSetup.Zrip", "ioData", ioData, ioDataC)
+}
+
+func (fs *verifyFFTSetup) Zrop(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	outputC := cloneSplit(output)
+	fs.candidate.Zrop(input, inputStride, outputC, outputStride, log2n, direction)
+	fs.reference.Zrop(input, inputStride, output, outputStride, log2n, direction)
+	fs.v.checkSplit("FFTSetup.Zrop", "output", output, outputC)
+}
+
+func (fs *verifyFFTSetup) Zip(ioData DSPSplitComplex, stride, log2n int, direction FFTDirection) {
+	ioDataC := cloneSplit(ioData)
+	fs.candidate.Zip(ioDataC, stride, log2n, direction)
+	fs.reference.Zip(ioData, stride, log2n, direction)
+	fs.v.checkSplit("FFTSetup.Zip", "ioData", ioData, ioDataC)
+}
+
+func (fs *verifyFFTSetup) Zop(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	outputC := cloneSplit(output)
+	fs.candidate.Zop(input, inputStride, outputC, outputStride, log2n, direction)
+	fs.reference.Zop(input, inputStride, output, outputStride, log2n, direction)
+	fs.v.checkSplit("FFTSetup.Zop", "output", output, outputC)
+}
+
+func (fs *verifyFFTSetup) Zop3(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	outputC := cloneSplit(output)
+	fs.candidate.Zop3(input, inputStride, outputC, outputStride, log2n, direction)
+	fs.reference.Zop3(input, inputStride, output, outputStride, log2n, direction)
+	fs.v.checkSplit("FFTSetup.Zop3", "output", output, outputC)
+}
+
+func (fs *verifyFFTSetup) Zop5(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	outputC := cloneSplit(output)
+	fs.candidate.Zop5(input, inputStride, outputC, outputStride, log2n, direction)
+	fs.reference.Zop5(input, inputStride, output, outputStride, log2n, direction)
+	fs.v.checkSplit("FFTSetup.Zop5", "output", output, outputC)
+}
+
+func (fs *verifyFFTSetup) Zip2D(ioData DSPSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	ioDataC := cloneSplit(ioData)
+	fs.candidate.Zip2D(ioDataC, rowStride, colStride, log2nCols, log2nRows, direction)
+	fs.reference.Zip2D(ioData, rowStride, colStride, log2nCols, log2nRows, direction)
+	fs.v.checkSplit("FFTSetup.Zip2D", "ioData", ioData, ioDataC)
+}
+
+func (fs *verifyFFTSetup) Zop2D(input DSPSplitComplex, inputRowStride, inputColStride int, output DSPSplitComplex, outputRowStride, outputColStride, log2nCols, log2nRows int, direction FFTDirection) {
+	outputC := cloneSplit(output)
+	fs.candidate.Zop2D(input, inputRowStride, inputColStride, outputC, outputRowStride, outputColStride, log2nCols, log2nRows, direction)
+	fs.reference.Zop2D(input, inputRowStride, inputColStride, output, outputRowStride, outputColStride, log2nCols, log2nRows, direction)
+	fs.v.checkSplit("FFTSetup.Zop2D", "output", output, outputC)
+}
+
+func (fs *verifyFFTSetup) Zrip2D(ioData DSPSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	ioDataC := cloneSplit(ioData)
+	fs.candidate.Zrip2D(ioDataC, rowStride, colStride, log2nCols, log2nRows, direction)
+	fs.reference.Zrip2D(ioData, rowStride, colStride, log2nCols, log2nRows, direction)
+	fs.v.checkSplit("FFTSetup.Zrip2D", "ioData", ioData, ioDataC)
+}
+
+func (fs *verifyFFTSetup) ZripM(ioData DSPSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection) {
+	ioDataC := cloneSplit(ioData)
+	fs.candidate.ZripM(ioDataC, stride, signalStride, log2n, count, direction)
+	fs.reference.ZripM(ioData, stride, signalStride, log2n, count, direction)
+	fs.v.checkSplit("FFTSetup.ZripM", "ioData", ioData, ioDataC)
+}
+
+func (fs *verifyFFTSetup) ZropM(input DSPSplitComplex, inputStride, inputSignalStride int, output DSPSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	outputC := cloneSplit(output)
+	fs.candidate.ZropM(input, inputStride, inputSignalStride, outputC, outputStride, outputSignalStride, log2n, count, direction)
+	fs.reference.ZropM(input, inputStride, inputSignalStride, output, outputStride, outputSignalStride, log2n, count, direction)
+	fs.v.checkSplit("FFTSetup.ZropM", "output", output, outputC)
+}
+
+func (fs *verifyFFTSetup) ZipM(ioData DSPSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection) {
+	ioDataC := cloneSplit(ioData)
+	fs.candidate.ZipM(ioDataC, stride, signalStride, log2n, count, direction)
+	fs.reference.ZipM(ioData, stride, signalStride, log2n, count, direction)
+	fs.v.checkSplit("FFTSetup.ZipM", "ioData", ioData, ioDataC)
+}
+
+func (fs *verifyFFTSetup) ZopM(input DSPSplitComplex, inputStride, inputSignalStride int, output DSPSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	outputC := cloneSplit(output)
+	fs.candidate.ZopM(input, inputStride, inputSignalStride, outputC, outputStride, outputSignalStride, log2n, count, direction)
+	fs.reference.ZopM(input, inputStride, inputSignalStride, output, outputStride, outputSignalStride, log2n, count, direction)
+	fs.v.checkSplit("FFTSetup.ZopM", "output", output, outputC)
+}
+
+type verifyFFTSetupD struct {
+	v                    *VerifyBackend
+	reference, candidate BackendFFTSetupD
+}
+
+func (fs *verifyFFTSetupD) Destroy() {
+	fs.reference.Destroy()
+	fs.candidate.Destroy()
+}
+
+func (fs *verifyFFTSetupD) Zrip(ioData DSPDoubleSplitComplex, stride, log2n int, direction FFTDirection) {
+	ioDataC := cloneSplitD(ioData)
+	fs.candidate.Zrip(ioDataC, stride, log2n, direction)
+	fs.reference.Zrip(ioData, stride, log2n, direction)
+	fs.v.checkSplitD("FFTSetupD.Zrip", "ioData", ioData, ioDataC)
+}
+
+func (fs *verifyFFTSetupD) Zrop(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	outputC := cloneSplitD(output)
+	fs.candidate.Zrop(input, inputStride, outputC, outputStride, log2n, direction)
+	fs.reference.Zrop(input, inputStride, output, outputStride, log2n, direction)
+	fs.v.checkSplitD("FFTSetupD.Zrop", "output", output, outputC)
+}
+
+func (fs *verifyFFTSetupD) Zip(ioData DSPDoubleSplitComplex, stride, log2n int, direction FFTDirection) {
+	ioDataC := cloneSplitD(ioData)
+	fs.candidate.Zip(ioDataC, stride, log2n, direction)
+	fs.reference.Zip(ioData, stride, log2n, direction)
+	fs.v.checkSplitD("FFTSetupD.Zip", "ioData", ioData, ioDataC)
+}
+
+func (fs *verifyFFTSetupD) Zop(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	outputC := cloneSplitD(output)
+	fs.candidate.Zop(input, inputStride, outputC, outputStride, log2n, direction)
+	fs.reference.Zop(input, inputStride, output, outputStride, log2n, direction)
+	fs.v.checkSplitD("FFTSetupD.Zop", "output", output, outputC)
+}
+
+func (fs *verifyFFTSetupD) Zop3(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	outputC := cloneSplitD(output)
+	fs.candidate.Zop3(input, inputStride, outputC, outputStride, log2n, direction)
+	fs.reference.Zop3(input, inputStride, output, outputStride, log2n, direction)
+	fs.v.checkSplitD("FFTSetupD.Zop3", "output", output, outputC)
+}
+
+func (fs *verifyFFTSetupD) Zop5(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	outputC := cloneSplitD(output)
+	fs.candidate.Zop5(input, inputStride, outputC, outputStride, log2n, direction)
+	fs.reference.Zop5(input, inputStride, output, outputStride, log2n, direction)
+	fs.v.checkSplitD("FFTSetupD.Zop5", "output", output, outputC)
+}
+
+func (fs *verifyFFTSetupD) Zip2D(ioData DSPDoubleSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	ioDataC := cloneSplitD(ioData)
+	fs.candidate.Zip2D(ioDataC, rowStride, colStride, log2nCols, log2nRows, direction)
+	fs.reference.Zip2D(ioData, rowStride, colStride, log2nCols, log2nRows, direction)
+	fs.v.checkSplitD("FFTSetupD.Zip2D", "ioData", ioData, ioDataC)
+}
+
+func (fs *verifyFFTSetupD) Zop2D(input DSPDoubleSplitComplex, inputRowStride, inputColStride int, output DSPDoubleSplitComplex, outputRowStride, outputColStride, log2nCols, log2nRows int, direction FFTDirection) {
+	outputC := cloneSplitD(output)
+	fs.candidate.Zop2D(input, inputRowStride, inputColStride, outputC, outputRowStride, outputColStride, log2nCols, log2nRows, direction)
+	fs.reference.Zop2D(input, inputRowStride, inputColStride, output, outputRowStride, outputColStride, log2nCols, log2nRows, direction)
+	fs.v.checkSplitD("FFTSetupD.Zop2D", "output", output, outputC)
+}
+
+func (fs *verifyFFTSetupD) Zrip2D(ioData DSPDoubleSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	ioDataC := cloneSplitD(ioData)
+	fs.candidate.Zrip2D(ioDataC, rowStride, colStride, log2nCols, log2nRows, direction)
+	fs.reference.Zrip2D(ioData, rowStride, colStride, log2nCols, log2nRows, direction)
+	fs.v.checkSplitD("FFTSetupD.Zrip2D", "ioData", ioData, ioDataC)
+}
+
+func (fs *verifyFFTSetupD) ZripM(ioData DSPDoubleSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection) {
+	ioDataC := cloneSplitD(ioData)
+	fs.candidate.ZripM(ioDataC, stride, signalStride, log2n, count, direction)
+	fs.reference.ZripM(ioData, stride, signalStride, log2n, count, direction)
+	fs.v.checkSplitD("FFTSetupD.ZripM", "ioData", ioData, ioDataC)
+}
+
+func (fs *verifyFFTSetupD) ZropM(input DSPDoubleSplitComplex, inputStride, inputSignalStride int, output DSPDoubleSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	outputC := cloneSplitD(output)
+	fs.candidate.ZropM(input, inputStride, inputSignalStride, outputC, outputStride, outputSignalStride, log2n, count, direction)
+	fs.reference.ZropM(input, inputStride, inputSignalStride, output, outputStride, outputSignalStride, log2n, count, direction)
+	fs.v.checkSplitD("FFTSetupD.ZropM", "output", output, outputC)
+}
+
+func (fs *verifyFFTSetupD) ZipM(ioData DSPDoubleSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection) {
+	ioDataC := cloneSplitD(ioData)
+	fs.candidate.ZipM(ioDataC, stride, signalStride, log2n, count, direction)
+	fs.reference.ZipM(ioData, stride, signalStride, log2n, count, direction)
+	fs.v.checkSplitD("FFTSetupD.ZipM", "ioData", ioData, ioDataC)
+}
+
+func (fs *verifyFFTSetupD) ZopM(input DSPDoubleSplitComplex, inputStride, inputSignalStride int, output DSPDoubleSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	outputC := cloneSplitD(output)
+	fs.candidate.ZopM(input, inputStride, inputSignalStride, outputC, outputStride, outputSignalStride, log2n, count, direction)
+	fs.reference.ZopM(input, inputStride, inputSignalStride, output, outputStride, outputSignalStride, log2n, count, direction)
+	fs.v.checkSplitD("FFTSetupD.ZopM", "output", output, outputC)
+}
+
+func (v *VerifyBackend) Vflt8(input []int8, inputStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vflt8(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vflt8(input, inputStride, output, outputStride)
+	v.check("Vflt8", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vflt8_byte(input []byte, inputStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vflt8_byte(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vflt8_byte(input, inputStride, output, outputStride)
+	v.check("Vflt8_byte", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vfltu8(input []byte, inputStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vfltu8(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vfltu8(input, inputStride, output, outputStride)
+	v.check("Vfltu8", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vflt16(input []int16, inputStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vflt16(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vflt16(input, inputStride, output, outputStride)
+	v.check("Vflt16", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vflt16_byte(input []byte, inputStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vflt16_byte(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vflt16_byte(input, inputStride, output, outputStride)
+	v.check("Vflt16_byte", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vflt32(input []int32, inputStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vflt32(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vflt32(input, inputStride, output, outputStride)
+	v.check("Vflt32", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vflt32_byte(input []byte, inputStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vflt32_byte(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vflt32_byte(input, inputStride, output, outputStride)
+	v.check("Vflt32_byte", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vdpsp(input []float64, inputStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vdpsp(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vdpsp(input, inputStride, output, outputStride)
+	v.check("Vdpsp", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vdpsp_byte(input []byte, inputStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vdpsp_byte(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vdpsp_byte(input, inputStride, output, outputStride)
+	v.check("Vdpsp_byte", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Ctoz(input []complex64, inputStride int, output DSPSplitComplex, outputStride int) {
+	outputC := cloneSplit(output)
+	capability[VectorBackend](v.Candidate).Ctoz(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Ctoz(input, inputStride, output, outputStride)
+	v.checkSplit("Ctoz", "output", output, outputC)
+}
+
+func (v *VerifyBackend) Ctoz_float(input []float32, inputStride int, output DSPSplitComplex, outputStride int) {
+	outputC := cloneSplit(output)
+	capability[VectorBackend](v.Candidate).Ctoz_float(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Ctoz_float(input, inputStride, output, outputStride)
+	v.checkSplit("Ctoz_float", "output", output, outputC)
+}
+
+func (v *VerifyBackend) Ctoz_byte(input []byte, inputStride int, output DSPSplitComplex, outputStride int) {
+	outputC := cloneSplit(output)
+	capability[VectorBackend](v.Candidate).Ctoz_byte(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Ctoz_byte(input, inputStride, output, outputStride)
+	v.checkSplit("Ctoz_byte", "output", output, outputC)
+}
+
+func (v *VerifyBackend) Ztoc(input DSPSplitComplex, inputStride int, output []complex64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Ztoc(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Ztoc(input, inputStride, output, outputStride)
+	v.check("Ztoc", "output", mismatch(complex64AsFloat32(output), complex64AsFloat32(outputC), v.Tolerance))
+}
+
+func (v *VerifyBackend) Ztoc_float(input DSPSplitComplex, inputStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Ztoc_float(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Ztoc_float(input, inputStride, output, outputStride)
+	v.check("Ztoc_float", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Ztoc_byte(input DSPSplitComplex, inputStride int, output []byte, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Ztoc_byte(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Ztoc_byte(input, inputStride, output, outputStride)
+	v.check("Ztoc_byte", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vclr(vec []float32, stride int) {
+	vecC := slices.Clone(vec)
+	capability[VectorBackend](v.Candidate).Vclr(vecC, stride)
+	capability[VectorBackend](v.Reference).Vclr(vec, stride)
+	v.check("Vclr", "vec", mismatch(vec, vecC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vfill(value float32, output []float32, stride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vfill(value, outputC, stride)
+	capability[VectorBackend](v.Reference).Vfill(value, output, stride)
+	v.check("Vfill", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vclip(input []float32, inputStride int, low, high float32, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vclip(input, inputStride, low, high, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vclip(input, inputStride, low, high, output, outputStride)
+	v.check("Vclip", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vthr(input []float32, inputStride int, low float32, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vthr(input, inputStride, low, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vthr(input, inputStride, low, output, outputStride)
+	v.check("Vthr", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Desamp(input []float32, desamplingFactor int, coeff []float32, output []float32) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Desamp(input, desamplingFactor, coeff, outputC)
+	capability[VectorBackend](v.Reference).Desamp(input, desamplingFactor, coeff, output)
+	v.check("Desamp", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Zrdesamp(input DSPSplitComplex, decimationFactor int, coefficients []float32, output DSPSplitComplex) {
+	outputC := cloneSplit(output)
+	capability[VectorBackend](v.Candidate).Zrdesamp(input, decimationFactor, coefficients, outputC)
+	capability[VectorBackend](v.Reference).Zrdesamp(input, decimationFactor, coefficients, output)
+	v.checkSplit("Zrdesamp", "output", output, outputC)
+}
+
+func (v *VerifyBackend) Zvphas(input DSPSplitComplex, inputStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Zvphas(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Zvphas(input, inputStride, output, outputStride)
+	v.check("Zvphas", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Zidotpr(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex) {
+	resultC := cloneSplit(result)
+	capability[VectorBackend](v.Candidate).Zidotpr(input1, stride1, input2, stride2, resultC)
+	capability[VectorBackend](v.Reference).Zidotpr(input1, stride1, input2, stride2, result)
+	v.checkSplit("Zidotpr", "result", result, resultC)
+}
+
+func (v *VerifyBackend) Zvcmul(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex, resultStride int) {
+	resultC := cloneSplit(result)
+	capability[VectorBackend](v.Candidate).Zvcmul(input1, stride1, input2, stride2, resultC, resultStride)
+	capability[VectorBackend](v.Reference).Zvcmul(input1, stride1, input2, stride2, result, resultStride)
+	v.checkSplit("Zvcmul", "result", result, resultC)
+}
+
+func (v *VerifyBackend) Vfix16(input []float32, inputStride int, output []int16, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vfix16(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vfix16(input, inputStride, output, outputStride)
+	v.check("Vfix16", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vfix16_byte(input []float32, inputStride int, output []byte, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vfix16_byte(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vfix16_byte(input, inputStride, output, outputStride)
+	v.check("Vfix16_byte", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vadd(input1 []float32, input1Stride int, input2 []float32, input2Stride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vadd(input1, input1Stride, input2, input2Stride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vadd(input1, input1Stride, input2, input2Stride, output, outputStride)
+	v.check("Vadd", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vsmsa(input []float32, inputStride int, mult, add float32, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vsmsa(input, inputStride, mult, add, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vsmsa(input, inputStride, mult, add, output, outputStride)
+	v.check("Vsmsa", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vabs(input []float32, inputStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vabs(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vabs(input, inputStride, output, outputStride)
+	v.check("Vabs", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vsq(input []float32, inputStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vsq(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vsq(input, inputStride, output, outputStride)
+	v.check("Vsq", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Zvabs(input DSPSplitComplex, inputStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Zvabs(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Zvabs(input, inputStride, output, outputStride)
+	v.check("Zvabs", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) ZvabsD(input DSPDoubleSplitComplex, inputStride int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).ZvabsD(input, inputStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).ZvabsD(input, inputStride, output, outputStride)
+	v.check("ZvabsD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Maxv(input []float32, stride int) float32 {
+	got := capability[VectorBackend](v.Candidate).Maxv(input, stride)
+	want := capability[VectorBackend](v.Reference).Maxv(input, stride)
+	v.check("Maxv", "result", mismatch([]float32{want}, []float32{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) Minv(input []float32, stride int) float32 {
+	got := capability[VectorBackend](v.Candidate).Minv(input, stride)
+	want := capability[VectorBackend](v.Reference).Minv(input, stride)
+	v.check("Minv", "result", mismatch([]float32{want}, []float32{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) Sve(input []float32, inputStride int) float32 {
+	got := capability[VectorBackend](v.Candidate).Sve(input, inputStride)
+	want := capability[VectorBackend](v.Reference).Sve(input, inputStride)
+	v.check("Sve", "result", mismatch([]float32{want}, []float32{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) Vavlin(input []float32, inputStride int, count float32, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vavlin(input, inputStride, count, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vavlin(input, inputStride, count, output, outputStride)
+	v.check("Vavlin", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vmul(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vmul(input1, stride1, input2, stride2, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vmul(input1, stride1, input2, stride2, output, outputStride)
+	v.check("Vmul", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vsadd(input []float32, inputStride int, add float32, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vsadd(input, inputStride, add, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vsadd(input, inputStride, add, output, outputStride)
+	v.check("Vsadd", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vsdiv(input []float32, inputStride int, divisor float32, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vsdiv(input, inputStride, divisor, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vsdiv(input, inputStride, divisor, output, outputStride)
+	v.check("Vsdiv", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vneg(input []float32, inputStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vneg(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vneg(input, inputStride, output, outputStride)
+	v.check("Vneg", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vswsum(input []float32, inputStride int, output []float32, outputStride, windowLen int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vswsum(input, inputStride, outputC, outputStride, windowLen)
+	capability[VectorBackend](v.Reference).Vswsum(input, inputStride, output, outputStride, windowLen)
+	v.check("Vswsum", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vdbcon(input []float32, inputStride int, zeroReference float32, output []float32, outputStride int, flag DBFlag) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vdbcon(input, inputStride, zeroReference, outputC, outputStride, flag)
+	capability[VectorBackend](v.Reference).Vdbcon(input, inputStride, zeroReference, output, outputStride, flag)
+	v.check("Vdbcon", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Meanv(input []float32, stride int) float32 {
+	got := capability[VectorBackend](v.Candidate).Meanv(input, stride)
+	want := capability[VectorBackend](v.Reference).Meanv(input, stride)
+	v.check("Meanv", "result", mismatch([]float32{want}, []float32{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) HannWindow(output []float32, flag WindowFlag) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).HannWindow(outputC, flag)
+	capability[VectorBackend](v.Reference).HannWindow(output, flag)
+	v.check("HannWindow", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) HammWindow(output []float32, flag WindowFlag) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).HammWindow(outputC, flag)
+	capability[VectorBackend](v.Reference).HammWindow(output, flag)
+	v.check("HammWindow", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) BlkmanWindow(output []float32, flag WindowFlag) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).BlkmanWindow(outputC, flag)
+	capability[VectorBackend](v.Reference).BlkmanWindow(output, flag)
+	v.check("BlkmanWindow", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvlog10_float(input, output []float32) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvlog10_float(input, outputC)
+	capability[VForceBackend](v.Reference).Vvlog10_float(input, output)
+	v.check("Vvlog10_float", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VImagePermuteChannels_ARGB8888(src, dst *VImageBuffer, permuteMap [4]uint8, flags VImageFlag) error {
+	dstC := cloneVImageBuffer(dst)
+	gotErr := capability[VImageBackend](v.Candidate).VImagePermuteChannels_ARGB8888(src, dstC, permuteMap, flags)
+	err := capability[VImageBackend](v.Reference).VImagePermuteChannels_ARGB8888(src, dst, permuteMap, flags)
+	if !v.checkErr("VImagePermuteChannels_ARGB8888", err, gotErr) {
+		return err
+	}
+	v.check("VImagePermuteChannels_ARGB8888", "dst", mismatch(dst.Data, dstC.Data, v.Tolerance))
+	return nil
+}
+
+func (v *VerifyBackend) VImageAlphaBlend_ARGB8888(srcTop, srcBottom, dst *VImageBuffer, flags VImageFlag) error {
+	dstC := cloneVImageBuffer(dst)
+	gotErr := capability[VImageBackend](v.Candidate).VImageAlphaBlend_ARGB8888(srcTop, srcBottom, dstC, flags)
+	err := capability[VImageBackend](v.Reference).VImageAlphaBlend_ARGB8888(srcTop, srcBottom, dst, flags)
+	if !v.checkErr("VImageAlphaBlend_ARGB8888", err, gotErr) {
+		return err
+	}
+	v.check("VImageAlphaBlend_ARGB8888", "dst", mismatch(dst.Data, dstC.Data, v.Tolerance))
+	return nil
+}
+
+func (v *VerifyBackend) VImagePremultipliedConstAlphaBlend_ARGB8888(srcTop *VImageBuffer, constAlpha uint8, srcBottom, dst *VImageBuffer, flags VImageFlag) error {
+	dstC := cloneVImageBuffer(dst)
+	gotErr := capability[VImageBackend](v.Candidate).VImagePremultipliedConstAlphaBlend_ARGB8888(srcTop, constAlpha, srcBottom, dstC, flags)
+	err := capability[VImageBackend](v.Reference).VImagePremultipliedConstAlphaBlend_ARGB8888(srcTop, constAlpha, srcBottom, dst, flags)
+	if !v.checkErr("VImagePremultipliedConstAlphaBlend_ARGB8888", err, gotErr) {
+		return err
+	}
+	v.check("VImagePremultipliedConstAlphaBlend_ARGB8888", "dst", mismatch(dst.Data, dstC.Data, v.Tolerance))
+	return nil
+}
+
+func (v *VerifyBackend) VImageRichardsonLucyDeConvolve_ARGBFFFF(src, dst *VImageBuffer, tempBuffer []byte, roiX, roiY int, kernel, kernel2 []float32, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2 int, backgroundColor [4]float32, iterationCount int, flags VImageFlag) error {
+	dstC := cloneVImageBuffer(dst)
+	gotErr := capability[VImageBackend](v.Candidate).VImageRichardsonLucyDeConvolve_ARGBFFFF(src, dstC, tempBuffer, roiX, roiY, kernel, kernel2, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, backgroundColor, iterationCount, flags)
+	err := capability[VImageBackend](v.Reference).VImageRichardsonLucyDeConvolve_ARGBFFFF(src, dst, tempBuffer, roiX, roiY, kernel, kernel2, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, backgroundColor, iterationCount, flags)
+	if !v.checkErr("VImageRichardsonLucyDeConvolve_ARGBFFFF", err, gotErr) {
+		return err
+	}
+	v.check("VImageRichardsonLucyDeConvolve_ARGBFFFF", "dst", mismatch(bytesAsFloat32(dst.Data), bytesAsFloat32(dstC.Data), v.Tolerance))
+	return nil
+}
+
+func (v *VerifyBackend) VImageRichardsonLucyDeConvolve_ARGB8888(src, dst *VImageBuffer, tempBuffer []byte, roiX, roiY int, kernel, kernel2 []int16, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, divisor, divisor2 int, backgroundColor [4]uint8, iterationCount int, flags VImageFlag) error {
+	dstC := cloneVImageBuffer(dst)
+	gotErr := capability[VImageBackend](v.Candidate).VImageRichardsonLucyDeConvolve_ARGB8888(src, dstC, tempBuffer, roiX, roiY, kernel, kernel2, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, divisor, divisor2, backgroundColor, iterationCount, flags)
+	err := capability[VImageBackend](v.Reference).VImageRichardsonLucyDeConvolve_ARGB8888(src, dst, tempBuffer, roiX, roiY, kernel, kernel2, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, divisor, divisor2, backgroundColor, iterationCount, flags)
+	if !v.checkErr("VImageRichardsonLucyDeConvolve_ARGB8888", err, gotErr) {
+		return err
+	}
+	v.check("VImageRichardsonLucyDeConvolve_ARGB8888", "dst", mismatch(dst.Data, dstC.Data, v.Tolerance))
+	return nil
+}
+
+func (v *VerifyBackend) VImageConvolve_ARGB8888(src, dst *VImageBuffer, tempBuffer []byte, roiX, roiY int, kernel []int16, kernelHeight, kernelWidth, divisor int, backgroundColor [4]uint8, flags VImageFlag) error {
+	dstC := cloneVImageBuffer(dst)
+	gotErr := capability[VImageBackend](v.Candidate).VImageConvolve_ARGB8888(src, dstC, tempBuffer, roiX, roiY, kernel, kernelHeight, kernelWidth, divisor, backgroundColor, flags)
+	err := capability[VImageBackend](v.Reference).VImageConvolve_ARGB8888(src, dst, tempBuffer, roiX, roiY, kernel, kernelHeight, kernelWidth, divisor, backgroundColor, flags)
+	if !v.checkErr("VImageConvolve_ARGB8888", err, gotErr) {
+		return err
+	}
+	v.check("VImageConvolve_ARGB8888", "dst", mismatch(dst.Data, dstC.Data, v.Tolerance))
+	return nil
+}
+
+func (v *VerifyBackend) VImageHistogramCalculation_ARGB8888(src *VImageBuffer, flags VImageFlag) ([4][]int, error) {
+	got, gotErr := capability[VImageBackend](v.Candidate).VImageHistogramCalculation_ARGB8888(src, flags)
+	want, err := capability[VImageBackend](v.Reference).VImageHistogramCalculation_ARGB8888(src, flags)
+	if !v.checkErr("VImageHistogramCalculation_ARGB8888", err, gotErr) {
+		return want, err
+	}
+	for c := range want {
+		v.check("VImageHistogramCalculation_ARGB8888", fmt.Sprintf("histogram[%d]", c), mismatch(want[c], got[c], v.Tolerance))
+	}
+	return want, nil
+}
+
+func (v *VerifyBackend) VImageHistogramCalculation_Planar8(src *VImageBuffer, flags VImageFlag) ([]int, error) {
+	got, gotErr := capability[VImageBackend](v.Candidate).VImageHistogramCalculation_Planar8(src, flags)
+	want, err := capability[VImageBackend](v.Reference).VImageHistogramCalculation_Planar8(src, flags)
+	if !v.checkErr("VImageHistogramCalculation_Planar8", err, gotErr) {
+		return want, err
+	}
+	v.check("VImageHistogramCalculation_Planar8", "histogram", mismatch(want, got, v.Tolerance))
+	return want, nil
+}
+
+func (v *VerifyBackend) VclipD(input []float64, inputStride int, low, high float64, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VclipD(input, inputStride, low, high, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VclipD(input, inputStride, low, high, output, outputStride)
+	v.check("VclipD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VthrD(input []float64, inputStride int, low float64, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VthrD(input, inputStride, low, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VthrD(input, inputStride, low, output, outputStride)
+	v.check("VthrD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VaddD(input1 []float64, input1Stride int, input2 []float64, input2Stride int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VaddD(input1, input1Stride, input2, input2Stride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VaddD(input1, input1Stride, input2, input2Stride, output, outputStride)
+	v.check("VaddD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VsmsaD(input []float64, inputStride int, mult, add float64, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VsmsaD(input, inputStride, mult, add, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VsmsaD(input, inputStride, mult, add, output, outputStride)
+	v.check("VsmsaD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VabsD(input []float64, inputStride int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VabsD(input, inputStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VabsD(input, inputStride, output, outputStride)
+	v.check("VabsD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VsqD(input []float64, inputStride int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VsqD(input, inputStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VsqD(input, inputStride, output, outputStride)
+	v.check("VsqD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) MaxvD(input []float64, stride int) float64 {
+	got := capability[VectorBackendD](v.Candidate).MaxvD(input, stride)
+	want := capability[VectorBackendD](v.Reference).MaxvD(input, stride)
+	v.check("MaxvD", "result", mismatch([]float64{want}, []float64{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) MinvD(input []float64, stride int) float64 {
+	got := capability[VectorBackendD](v.Candidate).MinvD(input, stride)
+	want := capability[VectorBackendD](v.Reference).MinvD(input, stride)
+	v.check("MinvD", "result", mismatch([]float64{want}, []float64{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) SveD(input []float64, inputStride int) float64 {
+	got := capability[VectorBackendD](v.Candidate).SveD(input, inputStride)
+	want := capability[VectorBackendD](v.Reference).SveD(input, inputStride)
+	v.check("SveD", "result", mismatch([]float64{want}, []float64{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) VmulD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VmulD(input1, stride1, input2, stride2, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VmulD(input1, stride1, input2, stride2, output, outputStride)
+	v.check("VmulD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VsaddD(input []float64, inputStride int, add float64, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VsaddD(input, inputStride, add, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VsaddD(input, inputStride, add, output, outputStride)
+	v.check("VsaddD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VnegD(input []float64, inputStride int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VnegD(input, inputStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VnegD(input, inputStride, output, outputStride)
+	v.check("VnegD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) MeanvD(input []float64, stride int) float64 {
+	got := capability[VectorBackendD](v.Candidate).MeanvD(input, stride)
+	want := capability[VectorBackendD](v.Reference).MeanvD(input, stride)
+	v.check("MeanvD", "result", mismatch([]float64{want}, []float64{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) Vflt8D(input []int8, inputStride int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).Vflt8D(input, inputStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).Vflt8D(input, inputStride, output, outputStride)
+	v.check("Vflt8D", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vfltu8D(input []byte, inputStride int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).Vfltu8D(input, inputStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).Vfltu8D(input, inputStride, output, outputStride)
+	v.check("Vfltu8D", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vflt16D(input []int16, inputStride int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).Vflt16D(input, inputStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).Vflt16D(input, inputStride, output, outputStride)
+	v.check("Vflt16D", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vflt32D(input []int32, inputStride int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).Vflt32D(input, inputStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).Vflt32D(input, inputStride, output, outputStride)
+	v.check("Vflt32D", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vspdp(input []float32, inputStride int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).Vspdp(input, inputStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).Vspdp(input, inputStride, output, outputStride)
+	v.check("Vspdp", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vfix16D(input []float64, inputStride int, output []int16, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).Vfix16D(input, inputStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).Vfix16D(input, inputStride, output, outputStride)
+	v.check("Vfix16D", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) CtozD(input []complex128, inputStride int, output DSPDoubleSplitComplex, outputStride int) {
+	outputC := cloneSplitD(output)
+	capability[VectorBackendD](v.Candidate).CtozD(input, inputStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).CtozD(input, inputStride, output, outputStride)
+	v.checkSplitD("CtozD", "output", output, outputC)
+}
+
+func (v *VerifyBackend) ZtocD(input DSPDoubleSplitComplex, inputStride int, output []complex128, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).ZtocD(input, inputStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).ZtocD(input, inputStride, output, outputStride)
+	v.check("ZtocD", "output", mismatch(complex128AsFloat64(output), complex128AsFloat64(outputC), v.Tolerance))
+}
+
+func (v *VerifyBackend) VclrD(vec []float64, stride int) {
+	vecC := slices.Clone(vec)
+	capability[VectorBackendD](v.Candidate).VclrD(vecC, stride)
+	capability[VectorBackendD](v.Reference).VclrD(vec, stride)
+	v.check("VclrD", "vec", mismatch(vec, vecC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VfillD(value float64, output []float64, stride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VfillD(value, outputC, stride)
+	capability[VectorBackendD](v.Reference).VfillD(value, output, stride)
+	v.check("VfillD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) DesampD(input []float64, desamplingFactor int, coeff []float64, output []float64) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).DesampD(input, desamplingFactor, coeff, outputC)
+	capability[VectorBackendD](v.Reference).DesampD(input, desamplingFactor, coeff, output)
+	v.check("DesampD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) ZrdesampD(input DSPDoubleSplitComplex, decimationFactor int, coefficients []float64, output DSPDoubleSplitComplex) {
+	outputC := cloneSplitD(output)
+	capability[VectorBackendD](v.Candidate).ZrdesampD(input, decimationFactor, coefficients, outputC)
+	capability[VectorBackendD](v.Reference).ZrdesampD(input, decimationFactor, coefficients, output)
+	v.checkSplitD("ZrdesampD", "output", output, outputC)
+}
+
+func (v *VerifyBackend) ZvphasD(input DSPDoubleSplitComplex, inputStride int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).ZvphasD(input, inputStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).ZvphasD(input, inputStride, output, outputStride)
+	v.check("ZvphasD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) ZidotprD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex) {
+	resultC := cloneSplitD(result)
+	capability[VectorBackendD](v.Candidate).ZidotprD(input1, stride1, input2, stride2, resultC)
+	capability[VectorBackendD](v.Reference).ZidotprD(input1, stride1, input2, stride2, result)
+	v.checkSplitD("ZidotprD", "result", result, resultC)
+}
+
+func (v *VerifyBackend) ZvcmulD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex, resultStride int) {
+	resultC := cloneSplitD(result)
+	capability[VectorBackendD](v.Candidate).ZvcmulD(input1, stride1, input2, stride2, resultC, resultStride)
+	capability[VectorBackendD](v.Reference).ZvcmulD(input1, stride1, input2, stride2, result, resultStride)
+	v.checkSplitD("ZvcmulD", "result", result, resultC)
+}
+
+func (v *VerifyBackend) VavlinD(input []float64, inputStride int, count float64, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VavlinD(input, inputStride, count, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VavlinD(input, inputStride, count, output, outputStride)
+	v.check("VavlinD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VsdivD(input []float64, inputStride int, divisor float64, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VsdivD(input, inputStride, divisor, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VsdivD(input, inputStride, divisor, output, outputStride)
+	v.check("VsdivD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VswsumD(input []float64, inputStride int, output []float64, outputStride, windowLen int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VswsumD(input, inputStride, outputC, outputStride, windowLen)
+	capability[VectorBackendD](v.Reference).VswsumD(input, inputStride, output, outputStride, windowLen)
+	v.check("VswsumD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VdbconD(input []float64, inputStride int, zeroReference float64, output []float64, outputStride int, flag DBFlag) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VdbconD(input, inputStride, zeroReference, outputC, outputStride, flag)
+	capability[VectorBackendD](v.Reference).VdbconD(input, inputStride, zeroReference, output, outputStride, flag)
+	v.check("VdbconD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) HannWindowD(output []float64, flag WindowFlag) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).HannWindowD(outputC, flag)
+	capability[VectorBackendD](v.Reference).HannWindowD(output, flag)
+	v.check("HannWindowD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) HammWindowD(output []float64, flag WindowFlag) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).HammWindowD(outputC, flag)
+	capability[VectorBackendD](v.Reference).HammWindowD(output, flag)
+	v.check("HammWindowD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) BlkmanWindowD(output []float64, flag WindowFlag) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).BlkmanWindowD(outputC, flag)
+	capability[VectorBackendD](v.Reference).BlkmanWindowD(output, flag)
+	v.check("BlkmanWindowD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Conv(input []float32, inputStride int, filter []float32, filterStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Conv(input, inputStride, filter, filterStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Conv(input, inputStride, filter, filterStride, output, outputStride)
+	v.check("Conv", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Zconv(input DSPSplitComplex, inputStride int, filter DSPSplitComplex, filterStride int, output DSPSplitComplex, outputStride int) {
+	outputC := cloneSplit(output)
+	capability[VectorBackend](v.Candidate).Zconv(input, inputStride, filter, filterStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Zconv(input, inputStride, filter, filterStride, output, outputStride)
+	v.checkSplit("Zconv", "output", output, outputC)
+}
+
+func (v *VerifyBackend) ConvD(input []float64, inputStride int, filter []float64, filterStride int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).ConvD(input, inputStride, filter, filterStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).ConvD(input, inputStride, filter, filterStride, output, outputStride)
+	v.check("ConvD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) ZconvD(input DSPDoubleSplitComplex, inputStride int, filter DSPDoubleSplitComplex, filterStride int, output DSPDoubleSplitComplex, outputStride int) {
+	outputC := cloneSplitD(output)
+	capability[VectorBackendD](v.Candidate).ZconvD(input, inputStride, filter, filterStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).ZconvD(input, inputStride, filter, filterStride, output, outputStride)
+	v.checkSplitD("ZconvD", "output", output, outputC)
+}
+
+// verifyBiquadSetup compares outputs only: the backends may lay out the
+// delay vector differently, so the candidate keeps its own copy of each
+// delay vector it has seen.
+type verifyBiquadSetup struct {
+	v                    *VerifyBackend
+	reference, candidate BackendBiquadSetup
+	delays               map[*float32][]float32
+}
+
+func (bs *verifyBiquadSetup) Destroy() {
+	bs.reference.Destroy()
+	bs.candidate.Destroy()
+	bs.delays = nil
+}
+
+func (bs *verifyBiquadSetup) Biquad(delay []float32, input []float32, inputStride int, output []float32, outputStride int) {
+	var delayC []float32
+	if len(delay) > 0 {
+		if bs.delays == nil {
+			bs.delays = make(map[*float32][]float32)
+		}
+		delayC = bs.delays[&delay[0]]
+		if delayC == nil {
+			delayC = slices.Clone(delay)
+			bs.delays[&delay[0]] = delayC
+		}
+	}
+	outputC := slices.Clone(output)
+	bs.candidate.Biquad(delayC, input, inputStride, outputC, outputStride)
+	bs.reference.Biquad(delay, input, inputStride, output, outputStride)
+	bs.v.check("Biquad", "output", mismatch(output, outputC, bs.v.Tolerance))
+}
+
+type verifyBiquadmSetup struct {
+	v                    *VerifyBackend
+	reference, candidate BackendBiquadmSetup
+}
+
+func (bs *verifyBiquadmSetup) Destroy() {
+	bs.reference.Destroy()
+	bs.candidate.Destroy()
+}
+
+func (bs *verifyBiquadmSetup) ResetState() {
+	bs.reference.ResetState()
+	bs.candidate.ResetState()
+}
+
+func (bs *verifyBiquadmSetup) Biquadm(input [][]float32, inputStride int, output [][]float32, outputStride int) {
+	outputC := make([][]float32, len(output))
+	for i, o := range output {
+		outputC[i] = slices.Clone(o)
+	}
+	bs.candidate.Biquadm(input, inputStride, outputC, outputStride)
+	bs.reference.Biquadm(input, inputStride, output, outputStride)
+	for i := range output {
+		bs.v.check("Biquadm", fmt.Sprintf("output[%d]", i), mismatch(output[i], outputC[i], bs.v.Tolerance))
+	}
+}
+
+func (v *VerifyBackend) Zvmul(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex, resultStride int, conjugate bool) {
+	resultC := cloneSplit(result)
+	capability[VectorBackend](v.Candidate).Zvmul(input1, stride1, input2, stride2, resultC, resultStride, conjugate)
+	capability[VectorBackend](v.Reference).Zvmul(input1, stride1, input2, stride2, result, resultStride, conjugate)
+	v.checkSplit("Zvmul", "result", result, resultC)
+}
+
+func (v *VerifyBackend) Zvconj(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int) {
+	outputC := cloneSplit(output)
+	capability[VectorBackend](v.Candidate).Zvconj(input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Zvconj(input, inputStride, output, outputStride)
+	v.checkSplit("Zvconj", "output", output, outputC)
+}
+
+func (v *VerifyBackend) ZvmulD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex, resultStride int, conjugate bool) {
+	resultC := cloneSplitD(result)
+	capability[VectorBackendD](v.Candidate).ZvmulD(input1, stride1, input2, stride2, resultC, resultStride, conjugate)
+	capability[VectorBackendD](v.Reference).ZvmulD(input1, stride1, input2, stride2, result, resultStride, conjugate)
+	v.checkSplitD("ZvmulD", "result", result, resultC)
+}
+
+func (v *VerifyBackend) ZvconjD(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int) {
+	outputC := cloneSplitD(output)
+	capability[VectorBackendD](v.Candidate).ZvconjD(input, inputStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).ZvconjD(input, inputStride, output, outputStride)
+	v.checkSplitD("ZvconjD", "output", output, outputC)
+}
+
+func (v *VerifyBackend) Rmsqv(input []float32, stride int) float32 {
+	got := capability[VectorBackend](v.Candidate).Rmsqv(input, stride)
+	want := capability[VectorBackend](v.Reference).Rmsqv(input, stride)
+	v.check("Rmsqv", "result", mismatch([]float32{want}, []float32{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) Measqv(input []float32, stride int) float32 {
+	got := capability[VectorBackend](v.Candidate).Measqv(input, stride)
+	want := capability[VectorBackend](v.Reference).Measqv(input, stride)
+	v.check("Measqv", "result", mismatch([]float32{want}, []float32{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) Svesq(input []float32, stride int) float32 {
+	got := capability[VectorBackend](v.Candidate).Svesq(input, stride)
+	want := capability[VectorBackend](v.Reference).Svesq(input, stride)
+	v.check("Svesq", "result", mismatch([]float32{want}, []float32{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) Svs(input []float32, stride int) float32 {
+	got := capability[VectorBackend](v.Candidate).Svs(input, stride)
+	want := capability[VectorBackend](v.Reference).Svs(input, stride)
+	v.check("Svs", "result", mismatch([]float32{want}, []float32{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) Maxmgv(input []float32, stride int) float32 {
+	got := capability[VectorBackend](v.Candidate).Maxmgv(input, stride)
+	want := capability[VectorBackend](v.Reference).Maxmgv(input, stride)
+	v.check("Maxmgv", "result", mismatch([]float32{want}, []float32{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) RmsqvD(input []float64, stride int) float64 {
+	got := capability[VectorBackendD](v.Candidate).RmsqvD(input, stride)
+	want := capability[VectorBackendD](v.Reference).RmsqvD(input, stride)
+	v.check("RmsqvD", "result", mismatch([]float64{want}, []float64{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) MeasqvD(input []float64, stride int) float64 {
+	got := capability[VectorBackendD](v.Candidate).MeasqvD(input, stride)
+	want := capability[VectorBackendD](v.Reference).MeasqvD(input, stride)
+	v.check("MeasqvD", "result", mismatch([]float64{want}, []float64{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) SvesqD(input []float64, stride int) float64 {
+	got := capability[VectorBackendD](v.Candidate).SvesqD(input, stride)
+	want := capability[VectorBackendD](v.Reference).SvesqD(input, stride)
+	v.check("SvesqD", "result", mismatch([]float64{want}, []float64{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) SvsD(input []float64, stride int) float64 {
+	got := capability[VectorBackendD](v.Candidate).SvsD(input, stride)
+	want := capability[VectorBackendD](v.Reference).SvsD(input, stride)
+	v.check("SvsD", "result", mismatch([]float64{want}, []float64{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) MaxmgvD(input []float64, stride int) float64 {
+	got := capability[VectorBackendD](v.Candidate).MaxmgvD(input, stride)
+	want := capability[VectorBackendD](v.Reference).MaxmgvD(input, stride)
+	v.check("MaxmgvD", "result", mismatch([]float64{want}, []float64{got}, v.Tolerance))
+	return want
+}
+
+func (v *VerifyBackend) Maxvi(input []float32, stride int) (float32, int) {
+	got, gotIndex := capability[VectorBackend](v.Candidate).Maxvi(input, stride)
+	want, wantIndex := capability[VectorBackend](v.Reference).Maxvi(input, stride)
+	v.check("Maxvi", "result", mismatch([]float32{want}, []float32{got}, v.Tolerance))
+	v.check("Maxvi", "index", mismatch([]int{wantIndex}, []int{gotIndex}, 0))
+	return want, wantIndex
+}
+
+func (v *VerifyBackend) Minvi(input []float32, stride int) (float32, int) {
+	got, gotIndex := capability[VectorBackend](v.Candidate).Minvi(input, stride)
+	want, wantIndex := capability[VectorBackend](v.Reference).Minvi(input, stride)
+	v.check("Minvi", "result", mismatch([]float32{want}, []float32{got}, v.Tolerance))
+	v.check("Minvi", "index", mismatch([]int{wantIndex}, []int{gotIndex}, 0))
+	return want, wantIndex
+}
+
+func (v *VerifyBackend) Normalize(input []float32, inputStride int, output []float32, outputStride int) (mean, stdDev float32) {
+	outputC := slices.Clone(output)
+	gotMean, gotStdDev := capability[VectorBackend](v.Candidate).Normalize(input, inputStride, outputC, outputStride)
+	mean, stdDev = capability[VectorBackend](v.Reference).Normalize(input, inputStride, output, outputStride)
+	v.check("Normalize", "mean", mismatch([]float32{mean}, []float32{gotMean}, v.Tolerance))
+	v.check("Normalize", "stdDev", mismatch([]float32{stdDev}, []float32{gotStdDev}, v.Tolerance))
+	v.check("Normalize", "output", mismatch(output, outputC, v.Tolerance))
+	return mean, stdDev
+}
+
+func (v *VerifyBackend) MaxviD(input []float64, stride int) (float64, int) {
+	got, gotIndex := capability[VectorBackendD](v.Candidate).MaxviD(input, stride)
+	want, wantIndex := capability[VectorBackendD](v.Reference).MaxviD(input, stride)
+	v.check("MaxviD", "result", mismatch([]float64{want}, []float64{got}, v.Tolerance))
+	v.check("MaxviD", "index", mismatch([]int{wantIndex}, []int{gotIndex}, 0))
+	return want, wantIndex
+}
+
+func (v *VerifyBackend) MinviD(input []float64, stride int) (float64, int) {
+	got, gotIndex := capability[VectorBackendD](v.Candidate).MinviD(input, stride)
+	want, wantIndex := capability[VectorBackendD](v.Reference).MinviD(input, stride)
+	v.check("MinviD", "result", mismatch([]float64{want}, []float64{got}, v.Tolerance))
+	v.check("MinviD", "index", mismatch([]int{wantIndex}, []int{gotIndex}, 0))
+	return want, wantIndex
+}
+
+func (v *VerifyBackend) NormalizeD(input []float64, inputStride int, output []float64, outputStride int) (mean, stdDev float64) {
+	outputC := slices.Clone(output)
+	gotMean, gotStdDev := capability[VectorBackendD](v.Candidate).NormalizeD(input, inputStride, outputC, outputStride)
+	mean, stdDev = capability[VectorBackendD](v.Reference).NormalizeD(input, inputStride, output, outputStride)
+	v.check("NormalizeD", "mean", mismatch([]float64{mean}, []float64{gotMean}, v.Tolerance))
+	v.check("NormalizeD", "stdDev", mismatch([]float64{stdDev}, []float64{gotStdDev}, v.Tolerance))
+	v.check("NormalizeD", "output", mismatch(output, outputC, v.Tolerance))
+	return mean, stdDev
+}
+
+func (v *VerifyBackend) Vsub(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vsub(input1, stride1, input2, stride2, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vsub(input1, stride1, input2, stride2, output, outputStride)
+	v.check("Vsub", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vdiv(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vdiv(input1, stride1, input2, stride2, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vdiv(input1, stride1, input2, stride2, output, outputStride)
+	v.check("Vdiv", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vsmul(input []float32, inputStride int, mult float32, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vsmul(input, inputStride, mult, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vsmul(input, inputStride, mult, output, outputStride)
+	v.check("Vsmul", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Svdiv(numerator float32, input []float32, inputStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Svdiv(numerator, input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Svdiv(numerator, input, inputStride, output, outputStride)
+	v.check("Svdiv", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vma(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vma(input1, stride1, input2, stride2, input3, stride3, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vma(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+	v.check("Vma", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vmsa(input1 []float32, stride1 int, input2 []float32, stride2 int, add float32, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vmsa(input1, stride1, input2, stride2, add, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vmsa(input1, stride1, input2, stride2, add, output, outputStride)
+	v.check("Vmsa", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vmsb(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vmsb(input1, stride1, input2, stride2, input3, stride3, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vmsb(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+	v.check("Vmsb", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vam(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vam(input1, stride1, input2, stride2, input3, stride3, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vam(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+	v.check("Vam", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vsbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vsbm(input1, stride1, input2, stride2, input3, stride3, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vsbm(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+	v.check("Vsbm", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vasm(input1 []float32, stride1 int, input2 []float32, stride2 int, mult float32, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vasm(input1, stride1, input2, stride2, mult, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vasm(input1, stride1, input2, stride2, mult, output, outputStride)
+	v.check("Vasm", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vsbsm(input1 []float32, stride1 int, input2 []float32, stride2 int, mult float32, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vsbsm(input1, stride1, input2, stride2, mult, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vsbsm(input1, stride1, input2, stride2, mult, output, outputStride)
+	v.check("Vsbsm", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vsma(input1 []float32, stride1 int, mult float32, input2 []float32, stride2 int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vsma(input1, stride1, mult, input2, stride2, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vsma(input1, stride1, mult, input2, stride2, output, outputStride)
+	v.check("Vsma", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vsmsb(input1 []float32, stride1 int, mult float32, input2 []float32, stride2 int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vsmsb(input1, stride1, mult, input2, stride2, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vsmsb(input1, stride1, mult, input2, stride2, output, outputStride)
+	v.check("Vsmsb", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vmma(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vmma(input1, stride1, input2, stride2, input3, stride3, input4, stride4, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vmma(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+	v.check("Vmma", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vmmsb(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vmmsb(input1, stride1, input2, stride2, input3, stride3, input4, stride4, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vmmsb(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+	v.check("Vmmsb", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vaam(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vaam(input1, stride1, input2, stride2, input3, stride3, input4, stride4, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vaam(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+	v.check("Vaam", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vsbsbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vsbsbm(input1, stride1, input2, stride2, input3, stride3, input4, stride4, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vsbsbm(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+	v.check("Vsbsbm", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vasbm(input1 []float32, stride1 int, input2 []float32, stride2 int, input3 []float32, stride3 int, input4 []float32, stride4 int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vasbm(input1, stride1, input2, stride2, input3, stride3, input4, stride4, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vasbm(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+	v.check("Vasbm", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vintb(input1 []float32, stride1 int, input2 []float32, stride2 int, fraction float32, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vintb(input1, stride1, input2, stride2, fraction, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vintb(input1, stride1, input2, stride2, fraction, output, outputStride)
+	v.check("Vintb", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vdist(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vdist(input1, stride1, input2, stride2, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vdist(input1, stride1, input2, stride2, output, outputStride)
+	v.check("Vdist", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vpoly(coefficients []float32, coeffStride int, input []float32, inputStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vpoly(coefficients, coeffStride, input, inputStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vpoly(coefficients, coeffStride, input, inputStride, output, outputStride)
+	v.check("Vpoly", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vlint(table []float32, indices []float32, indicesStride int, output []float32, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackend](v.Candidate).Vlint(table, indices, indicesStride, outputC, outputStride)
+	capability[VectorBackend](v.Reference).Vlint(table, indices, indicesStride, output, outputStride)
+	v.check("Vlint", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VsubD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VsubD(input1, stride1, input2, stride2, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VsubD(input1, stride1, input2, stride2, output, outputStride)
+	v.check("VsubD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VdivD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VdivD(input1, stride1, input2, stride2, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VdivD(input1, stride1, input2, stride2, output, outputStride)
+	v.check("VdivD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VsmulD(input []float64, inputStride int, mult float64, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VsmulD(input, inputStride, mult, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VsmulD(input, inputStride, mult, output, outputStride)
+	v.check("VsmulD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) SvdivD(numerator float64, input []float64, inputStride int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).SvdivD(numerator, input, inputStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).SvdivD(numerator, input, inputStride, output, outputStride)
+	v.check("SvdivD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VmaD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VmaD(input1, stride1, input2, stride2, input3, stride3, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VmaD(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+	v.check("VmaD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VmsaD(input1 []float64, stride1 int, input2 []float64, stride2 int, add float64, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VmsaD(input1, stride1, input2, stride2, add, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VmsaD(input1, stride1, input2, stride2, add, output, outputStride)
+	v.check("VmsaD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VmsbD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VmsbD(input1, stride1, input2, stride2, input3, stride3, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VmsbD(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+	v.check("VmsbD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VamD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VamD(input1, stride1, input2, stride2, input3, stride3, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VamD(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+	v.check("VamD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VsbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VsbmD(input1, stride1, input2, stride2, input3, stride3, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VsbmD(input1, stride1, input2, stride2, input3, stride3, output, outputStride)
+	v.check("VsbmD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VasmD(input1 []float64, stride1 int, input2 []float64, stride2 int, mult float64, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VasmD(input1, stride1, input2, stride2, mult, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VasmD(input1, stride1, input2, stride2, mult, output, outputStride)
+	v.check("VasmD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VsbsmD(input1 []float64, stride1 int, input2 []float64, stride2 int, mult float64, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VsbsmD(input1, stride1, input2, stride2, mult, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VsbsmD(input1, stride1, input2, stride2, mult, output, outputStride)
+	v.check("VsbsmD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VsmaD(input1 []float64, stride1 int, mult float64, input2 []float64, stride2 int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VsmaD(input1, stride1, mult, input2, stride2, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VsmaD(input1, stride1, mult, input2, stride2, output, outputStride)
+	v.check("VsmaD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VsmsbD(input1 []float64, stride1 int, mult float64, input2 []float64, stride2 int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VsmsbD(input1, stride1, mult, input2, stride2, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VsmsbD(input1, stride1, mult, input2, stride2, output, outputStride)
+	v.check("VsmsbD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VmmaD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VmmaD(input1, stride1, input2, stride2, input3, stride3, input4, stride4, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VmmaD(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+	v.check("VmmaD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VmmsbD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VmmsbD(input1, stride1, input2, stride2, input3, stride3, input4, stride4, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VmmsbD(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+	v.check("VmmsbD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VaamD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VaamD(input1, stride1, input2, stride2, input3, stride3, input4, stride4, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VaamD(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+	v.check("VaamD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VsbsbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VsbsbmD(input1, stride1, input2, stride2, input3, stride3, input4, stride4, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VsbsbmD(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+	v.check("VsbsbmD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VasbmD(input1 []float64, stride1 int, input2 []float64, stride2 int, input3 []float64, stride3 int, input4 []float64, stride4 int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VasbmD(input1, stride1, input2, stride2, input3, stride3, input4, stride4, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VasbmD(input1, stride1, input2, stride2, input3, stride3, input4, stride4, output, outputStride)
+	v.check("VasbmD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VintbD(input1 []float64, stride1 int, input2 []float64, stride2 int, fraction float64, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VintbD(input1, stride1, input2, stride2, fraction, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VintbD(input1, stride1, input2, stride2, fraction, output, outputStride)
+	v.check("VintbD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VdistD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VdistD(input1, stride1, input2, stride2, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VdistD(input1, stride1, input2, stride2, output, outputStride)
+	v.check("VdistD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VpolyD(coefficients []float64, coeffStride int, input []float64, inputStride int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VpolyD(coefficients, coeffStride, input, inputStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VpolyD(coefficients, coeffStride, input, inputStride, output, outputStride)
+	v.check("VpolyD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) VlintD(table []float64, indices []float64, indicesStride int, output []float64, outputStride int) {
+	outputC := slices.Clone(output)
+	capability[VectorBackendD](v.Candidate).VlintD(table, indices, indicesStride, outputC, outputStride)
+	capability[VectorBackendD](v.Reference).VlintD(table, indices, indicesStride, output, outputStride)
+	v.check("VlintD", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvexpf(input, output []float32) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvexpf(input, outputC)
+	capability[VForceBackend](v.Reference).Vvexpf(input, output)
+	v.check("Vvexpf", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvlogf(input, output []float32) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvlogf(input, outputC)
+	capability[VForceBackend](v.Reference).Vvlogf(input, output)
+	v.check("Vvlogf", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvlog2f(input, output []float32) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvlog2f(input, outputC)
+	capability[VForceBackend](v.Reference).Vvlog2f(input, output)
+	v.check("Vvlog2f", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvsqrtf(input, output []float32) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvsqrtf(input, outputC)
+	capability[VForceBackend](v.Reference).Vvsqrtf(input, output)
+	v.check("Vvsqrtf", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvrsqrtf(input, output []float32) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvrsqrtf(input, outputC)
+	capability[VForceBackend](v.Reference).Vvrsqrtf(input, output)
+	v.check("Vvrsqrtf", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvsinf(input, output []float32) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvsinf(input, outputC)
+	capability[VForceBackend](v.Reference).Vvsinf(input, output)
+	v.check("Vvsinf", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvcosf(input, output []float32) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvcosf(input, outputC)
+	capability[VForceBackend](v.Reference).Vvcosf(input, output)
+	v.check("Vvcosf", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvtanf(input, output []float32) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvtanf(input, outputC)
+	capability[VForceBackend](v.Reference).Vvtanf(input, output)
+	v.check("Vvtanf", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvtanhf(input, output []float32) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvtanhf(input, outputC)
+	capability[VForceBackend](v.Reference).Vvtanhf(input, output)
+	v.check("Vvtanhf", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvrecf(input, output []float32) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvrecf(input, outputC)
+	capability[VForceBackend](v.Reference).Vvrecf(input, output)
+	v.check("Vvrecf", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvfloorf(input, output []float32) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvfloorf(input, outputC)
+	capability[VForceBackend](v.Reference).Vvfloorf(input, output)
+	v.check("Vvfloorf", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvceilf(input, output []float32) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvceilf(input, outputC)
+	capability[VForceBackend](v.Reference).Vvceilf(input, output)
+	v.check("Vvceilf", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvintf(input, output []float32) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvintf(input, outputC)
+	capability[VForceBackend](v.Reference).Vvintf(input, output)
+	v.check("Vvintf", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvpowf(base, exponent, output []float32) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvpowf(base, exponent, outputC)
+	capability[VForceBackend](v.Reference).Vvpowf(base, exponent, output)
+	v.check("Vvpowf", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvatan2f(y, x, output []float32) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvatan2f(y, x, outputC)
+	capability[VForceBackend](v.Reference).Vvatan2f(y, x, output)
+	v.check("Vvatan2f", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvsincosf(input, sin, cos []float32) {
+	sinC := slices.Clone(sin)
+	cosC := slices.Clone(cos)
+	capability[VForceBackend](v.Candidate).Vvsincosf(input, sinC, cosC)
+	capability[VForceBackend](v.Reference).Vvsincosf(input, sin, cos)
+	v.check("Vvsincosf", "sin", mismatch(sin, sinC, v.Tolerance))
+	v.check("Vvsincosf", "cos", mismatch(cos, cosC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvexp(input, output []float64) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvexp(input, outputC)
+	capability[VForceBackend](v.Reference).Vvexp(input, output)
+	v.check("Vvexp", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvlog(input, output []float64) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvlog(input, outputC)
+	capability[VForceBackend](v.Reference).Vvlog(input, output)
+	v.check("Vvlog", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvlog2(input, output []float64) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvlog2(input, outputC)
+	capability[VForceBackend](v.Reference).Vvlog2(input, output)
+	v.check("Vvlog2", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvsqrt(input, output []float64) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvsqrt(input, outputC)
+	capability[VForceBackend](v.Reference).Vvsqrt(input, output)
+	v.check("Vvsqrt", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvrsqrt(input, output []float64) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvrsqrt(input, outputC)
+	capability[VForceBackend](v.Reference).Vvrsqrt(input, output)
+	v.check("Vvrsqrt", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvsin(input, output []float64) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvsin(input, outputC)
+	capability[VForceBackend](v.Reference).Vvsin(input, output)
+	v.check("Vvsin", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvcos(input, output []float64) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvcos(input, outputC)
+	capability[VForceBackend](v.Reference).Vvcos(input, output)
+	v.check("Vvcos", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvtan(input, output []float64) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvtan(input, outputC)
+	capability[VForceBackend](v.Reference).Vvtan(input, output)
+	v.check("Vvtan", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvtanh(input, output []float64) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvtanh(input, outputC)
+	capability[VForceBackend](v.Reference).Vvtanh(input, output)
+	v.check("Vvtanh", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvrec(input, output []float64) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvrec(input, outputC)
+	capability[VForceBackend](v.Reference).Vvrec(input, output)
+	v.check("Vvrec", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvfloor(input, output []float64) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvfloor(input, outputC)
+	capability[VForceBackend](v.Reference).Vvfloor(input, output)
+	v.check("Vvfloor", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvceil(input, output []float64) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvceil(input, outputC)
+	capability[VForceBackend](v.Reference).Vvceil(input, output)
+	v.check("Vvceil", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvint(input, output []float64) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvint(input, outputC)
+	capability[VForceBackend](v.Reference).Vvint(input, output)
+	v.check("Vvint", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvpow(base, exponent, output []float64) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvpow(base, exponent, outputC)
+	capability[VForceBackend](v.Reference).Vvpow(base, exponent, output)
+	v.check("Vvpow", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvatan2(y, x, output []float64) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvatan2(y, x, outputC)
+	capability[VForceBackend](v.Reference).Vvatan2(y, x, output)
+	v.check("Vvatan2", "output", mismatch(output, outputC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvsincos(input, sin, cos []float64) {
+	sinC := slices.Clone(sin)
+	cosC := slices.Clone(cos)
+	capability[VForceBackend](v.Candidate).Vvsincos(input, sinC, cosC)
+	capability[VForceBackend](v.Reference).Vvsincos(input, sin, cos)
+	v.check("Vvsincos", "sin", mismatch(sin, sinC, v.Tolerance))
+	v.check("Vvsincos", "cos", mismatch(cos, cosC, v.Tolerance))
+}
+
+func (v *VerifyBackend) Vvlog10(input, output []float64) {
+	outputC := slices.Clone(output)
+	capability[VForceBackend](v.Candidate).Vvlog10(input, outputC)
+	capability[VForceBackend](v.Reference).Vvlog10(input, output)
+	v.check("Vvlog10", "output", mismatch(output, outputC, v.Tolerance))
+}
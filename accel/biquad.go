@@ -0,0 +1,120 @@
+//go:build darwin && cgo && !purego
+
+package accel
+
+// #include <Accelerate/Accelerate.h>
+import "C"
+
+import "runtime"
+
+// BiquadSetup is a cascade of biquad sections for single-channel
+// filtering. The filter state lives in a delay vector owned by the caller,
+// so one setup can filter any number of channels, each with its own delay.
+type BiquadSetup struct {
+	cSetup   C.vDSP_biquad_Setup
+	sections int
+}
+
+// CreateBiquadSetup returns a setup for the cascade of sections.
+func CreateBiquadSetup(sections []BiquadCoefficients) (*BiquadSetup, error) {
+	if len(sections) == 0 {
+		return nil, ErrFailedToCreateBiquadSetup
+	}
+	coeffs := biquadCoefficientArray(sections)
+	cSetup := C.vDSP_biquad_CreateSetup((*C.double)(&coeffs[0]), C.vDSP_Length(len(sections)))
+	if cSetup == nil {
+		return nil, ErrFailedToCreateBiquadSetup
+	}
+	setup := &BiquadSetup{cSetup: cSetup, sections: len(sections)}
+	runtime.SetFinalizer(setup, destroyBiquadSetup)
+	return setup, nil
+}
+
+func (bs *BiquadSetup) Destroy() {
+	destroyBiquadSetup(bs)
+}
+
+func destroyBiquadSetup(setup *BiquadSetup) {
+	if setup != nil && setup.cSetup != nil {
+		C.vDSP_biquad_DestroySetup(setup.cSetup)
+		setup.cSetup = nil
+	}
+}
+
+// Biquad filters input into output, which may be the same vector. delay
+// holds the filter state between calls and must have at least
+// BiquadDelayLen(sections) elements; start a stream with zeros. Its
+// layout is private to the implementation.
+func (bs *BiquadSetup) Biquad(delay []float32, input []float32, inputStride int, output []float32, outputStride int) {
+	if len(delay) < BiquadDelayLen(bs.sections) {
+		panic(ErrLengthMismatch)
+	}
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	if n <= 0 {
+		return
+	}
+	C.vDSP_biquad(bs.cSetup, (*C.float)(&delay[0]), (*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), C.vDSP_Length(n))
+}
+
+// BiquadmSetup filters several channels at once, each through its own
+// cascade of the same number of sections. Unlike BiquadSetup the setup
+// holds the filter state of every channel.
+type BiquadmSetup struct {
+	cSetup   C.vDSP_biquadm_Setup
+	channels int
+}
+
+// CreateBiquadmSetup returns a setup for the cascades channels[i] of
+// every channel, which must all have the same number of sections.
+func CreateBiquadmSetup(channels [][]BiquadCoefficients) (*BiquadmSetup, error) {
+	coeffs, sections, ok := biquadmCoefficientArray(channels)
+	if !ok {
+		return nil, ErrFailedToCreateBiquadSetup
+	}
+	cSetup := C.vDSP_biquadm_CreateSetup((*C.double)(&coeffs[0]), C.vDSP_Length(sections), C.vDSP_Length(len(channels)))
+	if cSetup == nil {
+		return nil, ErrFailedToCreateBiquadSetup
+	}
+	setup := &BiquadmSetup{cSetup: cSetup, channels: len(channels)}
+	runtime.SetFinalizer(setup, destroyBiquadmSetup)
+	return setup, nil
+}
+
+func (bs *BiquadmSetup) Destroy() {
+	destroyBiquadmSetup(bs)
+}
+
+func destroyBiquadmSetup(setup *BiquadmSetup) {
+	if setup != nil && setup.cSetup != nil {
+		C.vDSP_biquadm_DestroySetup(setup.cSetup)
+		setup.cSetup = nil
+	}
+}
+
+// ResetState clears the filter state of every channel.
+func (bs *BiquadmSetup) ResetState() {
+	C.vDSP_biquadm_ResetState(bs.cSetup)
+}
+
+// Biquadm filters input[i] into output[i] for every channel i. The
+// vectors of a channel may be the same. It panics if the number of input
+// or output vectors isn't the number of channels.
+func (bs *BiquadmSetup) Biquadm(input [][]float32, inputStride int, output [][]float32, outputStride int) {
+	n := biquadmLen(bs.channels, input, inputStride, output, outputStride)
+	if n <= 0 {
+		return
+	}
+	// The pointer arrays are Go memory holding Go pointers, which cgo
+	// only allows if the pointers are pinned.
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+	in := make([]*C.float, bs.channels)
+	out := make([]*C.float, bs.channels)
+	for i := range in {
+		in[i] = (*C.float)(&input[i][0])
+		out[i] = (*C.float)(&output[i][0])
+		pinner.Pin(in[i])
+		pinner.Pin(out[i])
+	}
+	C.vDSP_biquadm(bs.cSetup, &in[0], C.vDSP_Stride(inputStride), &out[0], C.vDSP_Stride(outputStride), C.vDSP_Length(n))
+}
@@ -0,0 +1,124 @@
+package accel
+
+import (
+	"math"
+)
+
+// BiquadCoefficients holds one second-order IIR section normalised so
+// that a0 is 1:
+//
+//	y[n] = B0·x[n] + B1·x[n-1] + B2·x[n-2] - A1·y[n-1] - A2·y[n-2]
+//
+// A cascade of sections runs each one on the output of the previous one.
+type BiquadCoefficients struct {
+	B0, B1, B2, A1, A2 float64
+}
+
+// Response returns the complex frequency response of the section at freq
+// for the given sample rate.
+func (c BiquadCoefficients) Response(sampleRate, freq float64) complex128 {
+	b, a := c.Polynomials()
+	return FreqzAt(b, a, []float64{freq / sampleRate})[0]
+}
+
+// Polynomials returns the numerator and denominator of the section's
+// transfer function for Freqz and GroupDelay.
+func (c BiquadCoefficients) Polynomials() (b, a []float64) {
+	return []float64{c.B0, c.B1, c.B2}, []float64{1, c.A1, c.A2}
+}
+
+// BiquadDelayLen returns the length of the delay vector that Biquad needs
+// for a setup of the given number of sections (2·sections+2).
+func BiquadDelayLen(sections int) int {
+	return 2*sections + 2
+}
+
+// The designers below implement the filters of Robert Bristow-Johnson's
+// "Cookbook formulae for audio EQ biquad filter coefficients". freq is the
+// centre, corner or shelf midpoint frequency in the same unit as
+// sampleRate, q the quality factor (1/√2 gives a Butterworth response and,
+// for the shelves, the steepest slope without overshoot) and gainDB the
+// boost or cut.
+
+// BiquadLowPass designs a second-order low-pass filter.
+func BiquadLowPass(sampleRate, freq, q float64) BiquadCoefficients {
+	cos, alpha := rbjParams(sampleRate, freq, q)
+	return rbjNormalize(
+		(1-cos)/2, 1-cos, (1-cos)/2,
+		1+alpha, -2*cos, 1-alpha)
+}
+
+// BiquadHighPass designs a second-order high-pass filter. A low corner
+// frequency makes a DC blocker.
+func BiquadHighPass(sampleRate, freq, q float64) BiquadCoefficients {
+	cos, alpha := rbjParams(sampleRate, freq, q)
+	return rbjNormalize(
+		(1+cos)/2, -(1 + cos), (1+cos)/2,
+		1+alpha, -2*cos, 1-alpha)
+}
+
+// BiquadBandPass designs a band-pass filter with unity gain at freq.
+func BiquadBandPass(sampleRate, freq, q float64) BiquadCoefficients {
+	cos, alpha := rbjParams(sampleRate, freq, q)
+	return rbjNormalize(
+		alpha, 0, -alpha,
+		1+alpha, -2*cos, 1-alpha)
+}
+
+// BiquadNotch designs a notch filter rejecting freq.
+func BiquadNotch(sampleRate, freq, q float64) BiquadCoefficients {
+	cos, alpha := rbjParams(sampleRate, freq, q)
+	return rbjNormalize(
+		1, -2*cos, 1,
+		1+alpha, -2*cos, 1-alpha)
+}
+
+// BiquadAllPass designs an all-pass filter whose phase shift passes
+// through -180° at freq.
+func BiquadAllPass(sampleRate, freq, q float64) BiquadCoefficients {
+	cos, alpha := rbjParams(sampleRate, freq, q)
+	return rbjNormalize(
+		1-alpha, -2*cos, 1+alpha,
+		1+alpha, -2*cos, 1-alpha)
+}
+
+// BiquadPeaking designs a peaking equaliser that boosts or cuts by gainDB
+// around freq.
+func BiquadPeaking(sampleRate, freq, q, gainDB float64) BiquadCoefficients {
+	cos, alpha := rbjParams(sampleRate, freq, q)
+	a := math.Pow(10, gainDB/40)
+	return rbjNormalize(
+		1+alpha*a, -2*cos, 1-alpha*a,
+		1+alpha/a, -2*cos, 1-alpha/a)
+}
+
+// BiquadLowShelf designs a shelving filter that boosts or cuts by gainDB
+// below freq.
+func BiquadLowShelf(sampleRate, freq, q, gainDB float64) BiquadCoefficients {
+	cos, alpha := rbjParams(sampleRate, freq, q)
+	a := math.Pow(10, gainDB/40)
+	s := 2 * math.Sqrt(a) * alpha
+	return rbjNormalize(
+		a*((a+1)-(a-1)*cos+s), 2*a*((a-1)-(a+1)*cos), a*((a+1)-(a-1)*cos-s),
+		(a+1)+(a-1)*cos+s, -2*((a-1)+(a+1)*cos), (a+1)+(a-1)*cos-s)
+}
+
+// BiquadHighShelf designs a shelving filter that boosts or cuts by gainDB
+// above freq.
+func BiquadHighShelf(sampleRate, freq, q, gainDB float64) BiquadCoefficients {
+	cos, alpha := rbjParams(sampleRate, freq, q)
+	a := math.Pow(10, gainDB/40)
+	s := 2 * math.Sqrt(a) * alpha
+	return rbjNormalize(
+		a*((a+1)+(a-1)*cos+s), -2*a*((a-1)+(a+1)*cos), a*((a+1)+(a-1)*cos-s),
+		(a+1)-(a-1)*cos+s, 2*((a-1)-(a+1)*cos), (a+1)-(a-1)*cos-s)
+}
+
+func rbjParams(sampleRate, freq, q float64) (cos, alpha float64) {
+	sin, cos := math.Sincos(2 * math.Pi * freq / sampleRate)
+	return cos, sin / (2 * q)
+}
+
+func rbjNormalize(b0, b1, b2, a0, a1, a2 float64) BiquadCoefficients {
+	return BiquadCoefficients{B0: b0 / a0, B1: b1 / a0, B2: b2 / a0, A1: a1 / a0, A2: a2 / a0}
+}
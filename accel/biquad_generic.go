@@ -0,0 +1,97 @@
+package accel
+
+// biquadCoefficientArray flattens sections into the B0, B1, B2, A1, A2
+// order of vDSP_biquad_CreateSetup.
+func biquadCoefficientArray(sections []BiquadCoefficients) []float64 {
+	coeffs := make([]float64, 0, 5*len(sections))
+	for _, s := range sections {
+		coeffs = append(coeffs, s.B0, s.B1, s.B2, s.A1, s.A2)
+	}
+	return coeffs
+}
+
+// biquadmCoefficientArray flattens the sections of every channel in the
+// order of vDSP_biquadm_CreateSetup, section by section and within a
+// section channel by channel. It reports false if there are no sections or
+// the channels differ in their number of sections.
+func biquadmCoefficientArray(channels [][]BiquadCoefficients) (coeffs []float64, sections int, ok bool) {
+	if len(channels) == 0 || len(channels[0]) == 0 {
+		return nil, 0, false
+	}
+	sections = len(channels[0])
+	for _, c := range channels {
+		if len(c) != sections {
+			return nil, 0, false
+		}
+	}
+	coeffs = make([]float64, 0, 5*sections*len(channels))
+	for m := 0; m < sections; m++ {
+		for _, c := range channels {
+			s := c[m]
+			coeffs = append(coeffs, s.B0, s.B1, s.B2, s.A1, s.A2)
+		}
+	}
+	return coeffs, sections, true
+}
+
+// biquadmLen returns the number of samples processed per channel, the
+// smallest of the input and output lengths divided by their strides. It
+// panics if the number of input or output vectors isn't channels.
+func biquadmLen(channels int, input [][]float32, inputStride int, output [][]float32, outputStride int) int {
+	if len(input) != channels || len(output) != channels {
+		panic(ErrLengthMismatch)
+	}
+	n := -1
+	for i := range input {
+		m := minLenGeneric(len(input[i])/inputStride, len(output[i])/outputStride)
+		if n < 0 || m < n {
+			n = m
+		}
+	}
+	return n
+}
+
+// biquadGeneric runs the cascade of sections over input. delay holds the
+// last two inputs of every section followed by the last two outputs of
+// the final section, most recent first, and is updated for the next call.
+func biquadGeneric(sections []BiquadCoefficients, delay []float32, input []float32, inputStride int, output []float32, outputStride int) {
+	if len(delay) < BiquadDelayLen(len(sections)) {
+		panic(ErrLengthMismatch)
+	}
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		x := input[i*inputStride]
+		for m, s := range sections {
+			d := delay[2*m : 2*m+4]
+			y := float32(s.B0*float64(x) + s.B1*float64(d[0]) + s.B2*float64(d[1]) - s.A1*float64(d[2]) - s.A2*float64(d[3]))
+			d[0], d[1] = x, d[0]
+			x = y
+		}
+		last := delay[2*len(sections):]
+		last[0], last[1] = x, last[0]
+		output[i*outputStride] = x
+	}
+}
+
+// newBiquadmState copies the cascades of every channel and allocates
+// their delays.
+func newBiquadmState(channels [][]BiquadCoefficients) ([][]BiquadCoefficients, [][]float32, error) {
+	_, sections, ok := biquadmCoefficientArray(channels)
+	if !ok {
+		return nil, nil, ErrFailedToCreateBiquadSetup
+	}
+	copied := make([][]BiquadCoefficients, len(channels))
+	delays := make([][]float32, len(channels))
+	for i, c := range channels {
+		copied[i] = append([]BiquadCoefficients(nil), c...)
+		delays[i] = make([]float32, BiquadDelayLen(sections))
+	}
+	return copied, delays, nil
+}
+
+func biquadmGeneric(channels [][]BiquadCoefficients, delays [][]float32, input [][]float32, inputStride int, output [][]float32, outputStride int) {
+	n := biquadmLen(len(channels), input, inputStride, output, outputStride)
+	for i, c := range channels {
+		biquadGeneric(c, delays[i], input[i][:n*inputStride], inputStride, output[i], outputStride)
+	}
+}
@@ -0,0 +1,67 @@
+//go:build !darwin || !cgo || purego
+
+package accel
+
+// BiquadSetup is a cascade of biquad sections for single-channel
+// filtering. The filter state lives in a delay vector owned by the caller,
+// so one setup can filter any number of channels, each with its own delay.
+type BiquadSetup struct {
+	sections []BiquadCoefficients
+}
+
+// CreateBiquadSetup returns a setup for the cascade of sections.
+func CreateBiquadSetup(sections []BiquadCoefficients) (*BiquadSetup, error) {
+	if len(sections) == 0 {
+		return nil, ErrFailedToCreateBiquadSetup
+	}
+	return &BiquadSetup{append([]BiquadCoefficients(nil), sections...)}, nil
+}
+
+func (bs *BiquadSetup) Destroy() {
+	bs.sections = nil
+}
+
+// Biquad filters input into output, which may be the same vector. delay
+// holds the filter state between calls and must have at least
+// BiquadDelayLen(sections) elements; start a stream with zeros. Its
+// layout is private to the implementation.
+func (bs *BiquadSetup) Biquad(delay []float32, input []float32, inputStride int, output []float32, outputStride int) {
+	biquadGeneric(bs.sections, delay, input, inputStride, output, outputStride)
+}
+
+// BiquadmSetup filters several channels at once, each through its own
+// cascade of the same number of sections. Unlike BiquadSetup the setup
+// holds the filter state of every channel.
+type BiquadmSetup struct {
+	channels [][]BiquadCoefficients
+	delays   [][]float32
+}
+
+// CreateBiquadmSetup returns a setup for the cascades channels[i] of
+// every channel, which must all have the same number of sections.
+func CreateBiquadmSetup(channels [][]BiquadCoefficients) (*BiquadmSetup, error) {
+	channels, delays, err := newBiquadmState(channels)
+	if err != nil {
+		return nil, err
+	}
+	return &BiquadmSetup{channels, delays}, nil
+}
+
+func (bs *BiquadmSetup) Destroy() {
+	bs.channels = nil
+	bs.delays = nil
+}
+
+// ResetState clears the filter state of every channel.
+func (bs *BiquadmSetup) ResetState() {
+	for _, d := range bs.delays {
+		clear(d)
+	}
+}
+
+// Biquadm filters input[i] into output[i] for every channel i. The
+// vectors of a channel may be the same. It panics if the number of input
+// or output vectors isn't the number of channels.
+func (bs *BiquadmSetup) Biquadm(input [][]float32, inputStride int, output [][]float32, outputStride int) {
+	biquadmGeneric(bs.channels, bs.delays, input, inputStride, output, outputStride)
+}
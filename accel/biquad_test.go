@@ -0,0 +1,209 @@
+package accel
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/samuel/go-accelerate/accel/internal/testsignal"
+)
+
+func TestBiquadDesigns(t *testing.T) {
+	const fs = 48000
+	gain := func(c BiquadCoefficients, f float64) float64 {
+		return 20 * math.Log10(cmplx.Abs(c.Response(fs, f)))
+	}
+	cases := []struct {
+		name string
+		c    BiquadCoefficients
+		f    float64
+		want float64 // dB
+	}{
+		{"low-pass DC", BiquadLowPass(fs, 1000, math.Sqrt2/2), 0, 0},
+		{"low-pass corner", BiquadLowPass(fs, 1000, math.Sqrt2/2), 1000, -3.0103},
+		{"high-pass Nyquist", BiquadHighPass(fs, 1000, math.Sqrt2/2), fs / 2, 0},
+		{"high-pass corner", BiquadHighPass(fs, 1000, math.Sqrt2/2), 1000, -3.0103},
+		{"band-pass centre", BiquadBandPass(fs, 2000, 4), 2000, 0},
+		{"notch DC", BiquadNotch(fs, 2000, 4), 0, 0},
+		{"all-pass", BiquadAllPass(fs, 2000, 0.5), 5000, 0},
+		{"peaking centre", BiquadPeaking(fs, 1000, 1, 6), 1000, 6},
+		{"peaking DC", BiquadPeaking(fs, 1000, 1, 6), 0, 0},
+		{"low shelf DC", BiquadLowShelf(fs, 200, math.Sqrt2/2, -9), 0, -9},
+		{"low shelf midpoint", BiquadLowShelf(fs, 200, math.Sqrt2/2, -9), 200, -4.5},
+		{"low shelf Nyquist", BiquadLowShelf(fs, 200, math.Sqrt2/2, -9), fs / 2, 0},
+		{"high shelf Nyquist", BiquadHighShelf(fs, 8000, math.Sqrt2/2, 4), fs / 2, 4},
+		{"high shelf DC", BiquadHighShelf(fs, 8000, math.Sqrt2/2, 4), 0, 0},
+	}
+	for _, c := range cases {
+		if g := gain(c.c, c.f); math.Abs(g-c.want) > 1e-3 {
+			t.Errorf("%s: gain %f dB; want %f", c.name, g, c.want)
+		}
+	}
+	if g := cmplx.Abs(BiquadNotch(fs, 2000, 4).Response(fs, 2000)); g > 1e-9 {
+		t.Errorf("notch gain at centre %g; want 0", g)
+	}
+	if p := cmplx.Phase(BiquadAllPass(fs, 2000, 0.5).Response(fs, 2000)); math.Abs(math.Abs(p)-math.Pi) > 1e-9 {
+		t.Errorf("all-pass phase at centre %f; want ±π", p)
+	}
+}
+
+// biquadReference filters x through the cascade in float64.
+func biquadReference(sections []BiquadCoefficients, x []float32) []float64 {
+	s := make([]float64, len(x))
+	for i, v := range x {
+		s[i] = float64(v)
+	}
+	for _, c := range sections {
+		var x1, x2, y1, y2 float64
+		for i, v := range s {
+			y := c.B0*v + c.B1*x1 + c.B2*x2 - c.A1*y1 - c.A2*y2
+			x2, x1 = x1, v
+			y2, y1 = y1, y
+			s[i] = y
+		}
+	}
+	return s
+}
+
+func TestBiquad(t *testing.T) {
+	sections := []BiquadCoefficients{
+		BiquadLowPass(48000, 3000, 0.54),
+		BiquadLowPass(48000, 3000, 1.31),
+		BiquadHighPass(48000, 200, math.Sqrt2/2),
+	}
+	setup, err := CreateBiquadSetup(sections)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer setup.Destroy()
+
+	x := testsignal.Make(600, 0.05)
+	want := biquadReference(sections, x)
+
+	whole := make([]float32, len(x))
+	setup.Biquad(make([]float32, BiquadDelayLen(len(sections))), x, 1, whole, 1)
+	for i := range want {
+		if math.Abs(float64(whole[i])-want[i]) > 1e-4 {
+			t.Fatalf("output [%d] = %f; want %f", i, whole[i], want[i])
+		}
+	}
+
+	// Two channels share the setup, each with its own delay, and are
+	// processed in blocks.
+	other := testsignal.Make(600, 0.2)
+	wantOther := make([]float32, len(other))
+	setup.Biquad(make([]float32, BiquadDelayLen(len(sections))), other, 1, wantOther, 1)
+	delays := [][]float32{
+		make([]float32, BiquadDelayLen(len(sections))),
+		make([]float32, BiquadDelayLen(len(sections))),
+	}
+	chunked := [][]float32{append([]float32(nil), x...), append([]float32(nil), other...)}
+	for start := 0; start < len(x); start += 77 {
+		end := min(start+77, len(x))
+		for ch, d := range delays {
+			block := chunked[ch][start:end]
+			setup.Biquad(d, block, 1, block, 1)
+		}
+	}
+	for i := range x {
+		if chunked[0][i] != whole[i] || chunked[1][i] != wantOther[i] {
+			t.Fatalf("chunked output [%d] = %f, %f; want %f, %f", i, chunked[0][i], chunked[1][i], whole[i], wantOther[i])
+		}
+	}
+
+	// Strided input and output.
+	strided := make([]float32, 2*len(x)+1)
+	for i, v := range x {
+		strided[2*i] = v
+	}
+	setup.Biquad(make([]float32, BiquadDelayLen(len(sections))), strided, 2, strided[1:], 2)
+	for i := range x {
+		if strided[2*i+1] != whole[i] {
+			t.Fatalf("strided output [%d] = %f; want %f", i, strided[2*i+1], whole[i])
+		}
+	}
+}
+
+func TestBiquadm(t *testing.T) {
+	channels := [][]BiquadCoefficients{
+		{BiquadPeaking(44100, 1000, 2, 6), BiquadHighShelf(44100, 6000, 0.7, -3)},
+		{BiquadNotch(44100, 60, 10), BiquadHighPass(44100, 30, 0.7)},
+		{BiquadLowShelf(44100, 100, 0.7, 3), BiquadAllPass(44100, 500, 1)},
+	}
+	setup, err := CreateBiquadmSetup(channels)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer setup.Destroy()
+
+	input := make([][]float32, len(channels))
+	output := make([][]float32, len(channels))
+	for i := range input {
+		input[i] = testsignal.Make(500, 0.03*float64(i+1))
+		output[i] = make([]float32, len(input[i]))
+	}
+	for pass := 0; pass < 2; pass++ {
+		for start := 0; start < 500; start += 128 {
+			end := min(start+128, 500)
+			in := make([][]float32, len(channels))
+			out := make([][]float32, len(channels))
+			for i := range in {
+				in[i] = input[i][start:end]
+				out[i] = output[i][start:end]
+			}
+			setup.Biquadm(in, 1, out, 1)
+		}
+		for ch, c := range channels {
+			want := biquadReference(c, input[ch])
+			for i := range want {
+				if math.Abs(float64(output[ch][i])-want[i]) > 1e-4 {
+					t.Fatalf("pass %d channel %d output [%d] = %f; want %f", pass, ch, i, output[ch][i], want[i])
+				}
+			}
+		}
+		// The second pass must start from silence again.
+		setup.ResetState()
+	}
+}
+
+func TestBiquadSetupErrors(t *testing.T) {
+	if _, err := CreateBiquadSetup(nil); err != ErrFailedToCreateBiquadSetup {
+		t.Errorf("CreateBiquadSetup(nil) returned %v; want %v", err, ErrFailedToCreateBiquadSetup)
+	}
+	ragged := [][]BiquadCoefficients{{{B0: 1}}, {{B0: 1}, {B0: 1}}}
+	if _, err := CreateBiquadmSetup(ragged); err != ErrFailedToCreateBiquadSetup {
+		t.Errorf("CreateBiquadmSetup with ragged channels returned %v; want %v", err, ErrFailedToCreateBiquadSetup)
+	}
+	if _, err := CreateBiquadmSetup(nil); err != ErrFailedToCreateBiquadSetup {
+		t.Errorf("CreateBiquadmSetup(nil) returned %v; want %v", err, ErrFailedToCreateBiquadSetup)
+	}
+}
+
+func TestVerifyBackendBiquad(t *testing.T) {
+	v := NewVerifyBackend(genericBackend{}, genericBackend{}, 1e-6)
+	sections := []BiquadCoefficients{BiquadLowPass(8000, 500, 0.7)}
+	bs, err := v.CreateBiquadSetup(sections)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bs.Destroy()
+	x := testsignal.Make(100, 0.1)
+	delay := make([]float32, BiquadDelayLen(1))
+	for start := 0; start < len(x); start += 30 {
+		block := x[start:min(start+30, len(x))]
+		bs.Biquad(delay, block, 1, block, 1)
+	}
+	bm, err := v.CreateBiquadmSetup([][]BiquadCoefficients{sections, sections})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bm.Destroy()
+	y := testsignal.Make(100, 0.1)
+	bm.Biquadm([][]float32{y, y}, 1, [][]float32{make([]float32, 100), make([]float32, 100)}, 1)
+	if err := v.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.CreateBiquadSetup(nil); err != ErrFailedToCreateBiquadSetup {
+		t.Errorf("CreateBiquadSetup(nil) returned %v; want %v", err, ErrFailedToCreateBiquadSetup)
+	}
+}
@@ -0,0 +1,146 @@
+// Package checked provides variants of the accel vector functions that
+// validate their arguments and return an error instead of reading or
+// writing past the end of a slice.
+//
+// A vector of n elements with stride s must have a length of at least n*s
+// (for the _byte variants n*s times the size of an element in bytes). The
+// number of elements processed is taken from the output and every input
+// must hold at least that many. Outputs may only overlap an input when both
+// start at the same address with the same stride (operating in place) and
+// only for functions where vDSP allows it.
+//
+// The unchecked functions in accel remain the better choice for hot paths
+// where the arguments are known to be valid.
+package checked
+
+import (
+	"unsafe"
+
+	"github.com/samuel/go-accelerate/accel"
+)
+
+// Error describes an invalid argument passed to a checked function.
+type Error struct {
+	Func string // name of the function, e.g. "Vadd"
+	Arg  string // name of the offending argument
+	Err  error  // accel.ErrStrideInvalid, accel.ErrLengthMismatch or accel.ErrOverlap
+}
+
+func (e *Error) Error() string {
+	return "checked: " + e.Func + " " + e.Arg + ": " + e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// operand describes a strided vector argument.
+type operand struct {
+	arg    string
+	stride int
+	count  int     // number of strided elements in the slice
+	start  uintptr // address of the first byte
+	end    uintptr // address past the last byte
+	step   uintptr // distance between elements in bytes
+}
+
+// newOperand describes s as a vector with the given stride where each
+// element spans width items of s.
+func newOperand[T any](arg string, s []T, stride, width int) operand {
+	op := operand{arg: arg, stride: stride}
+	if stride > 0 {
+		op.count = len(s) / (width * stride)
+	}
+	if len(s) > 0 {
+		size := unsafe.Sizeof(s[0])
+		op.start = uintptr(unsafe.Pointer(&s[0]))
+		op.end = op.start + uintptr(len(s))*size
+		if stride > 0 {
+			op.step = uintptr(stride*width) * size
+		}
+	}
+	return op
+}
+
+func (op operand) overlaps(other operand) bool {
+	return op.start < other.end && other.start < op.end
+}
+
+// check validates that all operands have a positive stride and hold at
+// least n elements, and that no output overlaps another output or (unless
+// inPlace is set and they share start and stride) an input.
+func check(fn string, n int, outputs, inputs []operand, inPlace bool) error {
+	for _, ops := range [][]operand{outputs, inputs} {
+		for _, op := range ops {
+			if op.stride <= 0 {
+				return &Error{Func: fn, Arg: op.arg, Err: accel.ErrStrideInvalid}
+			}
+		}
+	}
+	for _, ops := range [][]operand{outputs, inputs} {
+		for _, op := range ops {
+			if op.count < n {
+				return &Error{Func: fn, Arg: op.arg, Err: accel.ErrLengthMismatch}
+			}
+		}
+	}
+	for i, out := range outputs {
+		for _, other := range outputs[i+1:] {
+			if out.overlaps(other) {
+				return &Error{Func: fn, Arg: other.arg, Err: accel.ErrOverlap}
+			}
+		}
+		for _, in := range inputs {
+			if out.overlaps(in) && !(inPlace && out.start == in.start && out.step == in.step) {
+				return &Error{Func: fn, Arg: out.arg, Err: accel.ErrOverlap}
+			}
+		}
+	}
+	return nil
+}
+
+// unary validates a function with a single input and output vector.
+func unary(fn string, in, out operand, inPlace bool) (int, error) {
+	n := out.count
+	return n, check(fn, n, []operand{out}, []operand{in}, inPlace)
+}
+
+func split(arg string, s accel.DSPSplitComplex, stride int) []operand {
+	return []operand{
+		newOperand(arg+".Real", s.Real, stride, 1),
+		newOperand(arg+".Imag", s.Imag, stride, 1),
+	}
+}
+
+func splitD(arg string, s accel.DSPDoubleSplitComplex, stride int) []operand {
+	return []operand{
+		newOperand(arg+".Real", s.Real, stride, 1),
+		newOperand(arg+".Imag", s.Imag, stride, 1),
+	}
+}
+
+// interleaved describes an interleaved complex vector stored as floats
+// where the stride is counted in floats and each element spans two.
+func interleaved(arg string, s []float32, stride int) operand {
+	op := newOperand(arg, s, stride, 1)
+	if stride > 0 && len(s) >= 2 {
+		op.count = (len(s)-2)/stride + 1
+	} else {
+		op.count = 0
+	}
+	return op
+}
+
+func bytesAsFloats(b []byte) []float32 {
+	if len(b) < 4 {
+		return nil
+	}
+	return unsafe.Slice((*float32)(unsafe.Pointer(&b[0])), len(b)/4)
+}
+
+func complexAsFloats(c []complex64) []float32 {
+	if len(c) == 0 {
+		return nil
+	}
+	return unsafe.Slice((*float32)(unsafe.Pointer(&c[0])), 2*len(c))
+}
@@ -0,0 +1,124 @@
+package checked
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+
+	"github.com/samuel/go-accelerate/accel"
+)
+
+func TestCheckedErrors(t *testing.T) {
+	buf := make([]float32, 8)
+	cases := []struct {
+		name string
+		err  error
+		want error
+		arg  string
+	}{
+		{"zero stride", Vadd(make([]float32, 4), 0, make([]float32, 4), 1, make([]float32, 4), 1), accel.ErrStrideInvalid, "input1"},
+		{"negative stride", Vneg(make([]float32, 4), 1, make([]float32, 4), -1), accel.ErrStrideInvalid, "output"},
+		{"short input", Vadd(make([]float32, 4), 1, make([]float32, 3), 1, make([]float32, 4), 1), accel.ErrLengthMismatch, "input2"},
+		{"short strided input", Vsq(make([]float32, 7), 2, make([]float32, 4), 1), accel.ErrLengthMismatch, "input"},
+		{"partial overlap", Vabs(buf[:4], 1, buf[2:6], 1), accel.ErrOverlap, "output"},
+		{"overlap with different stride", Vabs(buf, 2, buf[:4], 1), accel.ErrOverlap, "output"},
+		{"conversion in place", Vflt8_byte(bytesOf(buf), 1, buf, 1), accel.ErrOverlap, "output"},
+		{"short window input", Vswsum(make([]float32, 5), 1, make([]float32, 4), 1, 3), accel.ErrLengthMismatch, "input"},
+		{"short desamp input", Desamp(make([]float32, 8), 2, make([]float32, 3), make([]float32, 4)), accel.ErrLengthMismatch, "input"},
+		{"zero factor", Desamp(make([]float32, 8), 0, make([]float32, 3), make([]float32, 4)), accel.ErrStrideInvalid, "factor"},
+		{"short split imag", Ctoz(make([]complex64, 4), 2, accel.DSPSplitComplex{Real: make([]float32, 4), Imag: make([]float32, 3)}, 1), accel.ErrLengthMismatch, "output.Imag"},
+		{"odd interleaved stride", Ctoz_float(make([]float32, 4), 1, accel.DSPSplitComplex{Real: make([]float32, 4), Imag: make([]float32, 4)}, 1), accel.ErrLengthMismatch, "input"},
+		{"shared split", Zvcmul(splitOf(8), 1, splitOf(8), 1, accel.DSPSplitComplex{Real: buf, Imag: buf}, 1), accel.ErrOverlap, "result.Imag"},
+		{"short log10 input", Vvlog10f(make([]float32, 4), make([]float32, 2)), accel.ErrLengthMismatch, "input"},
+		{"short log10_float input", Vvlog10_float(make([]float32, 2), make([]float32, 4)), accel.ErrLengthMismatch, "input"},
+	}
+	for _, c := range cases {
+		if !errors.Is(c.err, c.want) {
+			t.Errorf("%s: got error %v; want %v", c.name, c.err, c.want)
+			continue
+		}
+		var e *Error
+		if !errors.As(c.err, &e) || e.Arg != c.arg {
+			t.Errorf("%s: got error %v; want argument %s", c.name, c.err, c.arg)
+		}
+	}
+}
+
+func TestCheckedEmpty(t *testing.T) {
+	if err := Vadd(nil, 1, nil, 1, nil, 1); err != nil {
+		t.Errorf("Vadd with empty vectors returned %v", err)
+	}
+	if err := Zvabs(accel.DSPSplitComplex{}, 1, nil, 1); err != nil {
+		t.Errorf("Zvabs with empty vectors returned %v", err)
+	}
+	if v, err := Sve(nil, 1); err != nil || v != 0 {
+		t.Errorf("Sve(nil) = %f, %v; want 0, nil", v, err)
+	}
+	if _, err := Maxv(nil, 0); !errors.Is(err, accel.ErrStrideInvalid) {
+		t.Errorf("Maxv with stride 0 returned %v; want %v", err, accel.ErrStrideInvalid)
+	}
+}
+
+func TestCheckedInPlace(t *testing.T) {
+	v := []float32{-1, -2, -3, -4, -5, -6}
+	if err := Vabs(v, 2, v, 2); err != nil {
+		t.Fatal(err)
+	}
+	want := []float32{1, -2, 3, -4, 5, -6}
+	for i := range v {
+		if v[i] != want[i] {
+			t.Errorf("Vabs in place: v[%d] = %f; want %f", i, v[i], want[i])
+		}
+	}
+	if err := Vneg(v, 1, v, 1); err != nil {
+		t.Fatal(err)
+	}
+	if v[1] != 2 {
+		t.Errorf("Vneg in place: v[1] = %f; want 2", v[1])
+	}
+}
+
+func TestCheckedStrided(t *testing.T) {
+	input := []float32{1, 0, 2, 0, 3, 0}
+	output := make([]float32, 3)
+	if err := Vsadd(input, 2, 10, output, 1); err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []float32{11, 12, 13} {
+		if output[i] != want {
+			t.Errorf("output[%d] = %f; want %f", i, output[i], want)
+		}
+	}
+	if sum, err := Sve(input, 2); err != nil || sum != 6 {
+		t.Errorf("Sve = %f, %v; want 6, nil", sum, err)
+	}
+}
+
+func TestCheckedFFT(t *testing.T) {
+	fs, err := CreateFFTSetup(4, accel.FFTRadix2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Destroy()
+	if err := fs.Zip(splitOf(16), 1, 5, accel.FFTDirectionForward); !errors.Is(err, accel.ErrLengthMismatch) {
+		t.Errorf("Zip larger than setup returned %v; want %v", err, accel.ErrLengthMismatch)
+	}
+	if err := fs.Zip(splitOf(15), 1, 4, accel.FFTDirectionForward); !errors.Is(err, accel.ErrLengthMismatch) {
+		t.Errorf("Zip on short data returned %v; want %v", err, accel.ErrLengthMismatch)
+	}
+	if err := fs.Zrip(splitOf(8), 1, 4, accel.FFTDirectionForward); err != nil {
+		t.Errorf("Zrip returned %v", err)
+	}
+	data := splitOf(16)
+	if err := fs.Zop(data, 1, data, 1, 4, accel.FFTDirectionForward); !errors.Is(err, accel.ErrOverlap) {
+		t.Errorf("Zop with shared input and output returned %v; want %v", err, accel.ErrOverlap)
+	}
+}
+
+func splitOf(n int) accel.DSPSplitComplex {
+	return accel.DSPSplitComplex{Real: make([]float32, n), Imag: make([]float32, n)}
+}
+
+func bytesOf(f []float32) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(&f[0])), 4*len(f))
+}
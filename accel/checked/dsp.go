@@ -0,0 +1,533 @@
+package checked
+
+import (
+	"math"
+
+	"github.com/samuel/go-accelerate/accel"
+)
+
+// Vflt8 is the checked form of accel.Vflt8.
+func Vflt8(input []int8, inputStride int, output []float32, outputStride int) error {
+	n, err := unary("Vflt8", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 1), false)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vflt8(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Vflt8_byte is the checked form of accel.Vflt8_byte.
+func Vflt8_byte(input []byte, inputStride int, output []float32, outputStride int) error {
+	n, err := unary("Vflt8_byte", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 1), false)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vflt8_byte(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Vfltu8 is the checked form of accel.Vfltu8.
+func Vfltu8(input []byte, inputStride int, output []float32, outputStride int) error {
+	n, err := unary("Vfltu8", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 1), false)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vfltu8(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Vflt16 is the checked form of accel.Vflt16.
+func Vflt16(input []int16, inputStride int, output []float32, outputStride int) error {
+	n, err := unary("Vflt16", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 1), false)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vflt16(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Vflt16_byte is the checked form of accel.Vflt16_byte.
+func Vflt16_byte(input []byte, inputStride int, output []float32, outputStride int) error {
+	n, err := unary("Vflt16_byte", newOperand("input", input, inputStride, 2), newOperand("output", output, outputStride, 1), false)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vflt16_byte(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Vflt32 is the checked form of accel.Vflt32.
+func Vflt32(input []int32, inputStride int, output []float32, outputStride int) error {
+	n, err := unary("Vflt32", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 1), false)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vflt32(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Vflt32_byte is the checked form of accel.Vflt32_byte.
+func Vflt32_byte(input []byte, inputStride int, output []float32, outputStride int) error {
+	n, err := unary("Vflt32_byte", newOperand("input", input, inputStride, 4), newOperand("output", output, outputStride, 1), false)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vflt32_byte(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Vdpsp is the checked form of accel.Vdpsp.
+func Vdpsp(input []float64, inputStride int, output []float32, outputStride int) error {
+	n, err := unary("Vdpsp", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 1), false)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vdpsp(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Vdpsp_byte is the checked form of accel.Vdpsp_byte.
+func Vdpsp_byte(input []byte, inputStride int, output []float32, outputStride int) error {
+	n, err := unary("Vdpsp_byte", newOperand("input", input, inputStride, 8), newOperand("output", output, outputStride, 1), false)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vdpsp_byte(input, inputStride, output, outputStride)
+	return nil
+}
+
+func checkCtoz(fn string, input operand, output accel.DSPSplitComplex, outputStride int) (int, error) {
+	outs := split("output", output, outputStride)
+	n := outs[0].count
+	return n, check(fn, n, outs, []operand{input}, false)
+}
+
+// Ctoz is the checked form of accel.Ctoz. The input stride is counted in
+// float32 units, so 2 addresses consecutive complex values.
+func Ctoz(input []complex64, inputStride int, output accel.DSPSplitComplex, outputStride int) error {
+	n, err := checkCtoz("Ctoz", interleaved("input", complexAsFloats(input), inputStride), output, outputStride)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Ctoz(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Ctoz_float is the checked form of accel.Ctoz_float.
+func Ctoz_float(input []float32, inputStride int, output accel.DSPSplitComplex, outputStride int) error {
+	n, err := checkCtoz("Ctoz_float", interleaved("input", input, inputStride), output, outputStride)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Ctoz_float(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Ctoz_byte is the checked form of accel.Ctoz_byte.
+func Ctoz_byte(input []byte, inputStride int, output accel.DSPSplitComplex, outputStride int) error {
+	n, err := checkCtoz("Ctoz_byte", interleaved("input", bytesAsFloats(input), inputStride), output, outputStride)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Ctoz_byte(input, inputStride, output, outputStride)
+	return nil
+}
+
+func checkZtoc(fn string, input accel.DSPSplitComplex, inputStride int, output operand) (int, error) {
+	n := output.count
+	return n, check(fn, n, []operand{output}, split("input", input, inputStride), false)
+}
+
+// Ztoc is the checked form of accel.Ztoc. The output stride is counted in
+// float32 units, so 2 addresses consecutive complex values.
+func Ztoc(input accel.DSPSplitComplex, inputStride int, output []complex64, outputStride int) error {
+	n, err := checkZtoc("Ztoc", input, inputStride, interleaved("output", complexAsFloats(output), outputStride))
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Ztoc(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Ztoc_float is the checked form of accel.Ztoc_float.
+func Ztoc_float(input accel.DSPSplitComplex, inputStride int, output []float32, outputStride int) error {
+	n, err := checkZtoc("Ztoc_float", input, inputStride, interleaved("output", output, outputStride))
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Ztoc_float(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Ztoc_byte is the checked form of accel.Ztoc_byte.
+func Ztoc_byte(input accel.DSPSplitComplex, inputStride int, output []byte, outputStride int) error {
+	n, err := checkZtoc("Ztoc_byte", input, inputStride, interleaved("output", bytesAsFloats(output), outputStride))
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Ztoc_byte(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Vclr is the checked form of accel.Vclr.
+func Vclr(vec []float32, stride int) error {
+	op := newOperand("vec", vec, stride, 1)
+	if err := check("Vclr", op.count, []operand{op}, nil, false); err != nil || op.count == 0 {
+		return err
+	}
+	accel.Vclr(vec, stride)
+	return nil
+}
+
+// Vfill is the checked form of accel.Vfill.
+func Vfill(value float32, output []float32, stride int) error {
+	op := newOperand("output", output, stride, 1)
+	if err := check("Vfill", op.count, []operand{op}, nil, false); err != nil || op.count == 0 {
+		return err
+	}
+	accel.Vfill(value, output, stride)
+	return nil
+}
+
+// Vclip is the checked form of accel.Vclip.
+func Vclip(input []float32, inputStride int, low, high float32, output []float32, outputStride int) error {
+	n, err := unary("Vclip", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 1), true)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vclip(input, inputStride, low, high, output, outputStride)
+	return nil
+}
+
+// Vthr is the checked form of accel.Vthr.
+func Vthr(input []float32, inputStride int, low float32, output []float32, outputStride int) error {
+	n, err := unary("Vthr", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 1), true)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vthr(input, inputStride, low, output, outputStride)
+	return nil
+}
+
+// checkDesamp validates a decimating filter. The output length sets the
+// number of results and the input must hold (n-1)*factor+len(coeff)
+// elements. No overlap is allowed between any of the arguments.
+func checkDesamp(fn string, inputs []operand, factor, coeffLen int, coeff operand, outputs []operand) (int, error) {
+	if factor <= 0 {
+		return 0, &Error{Func: fn, Arg: "factor", Err: accel.ErrStrideInvalid}
+	}
+	n := outputs[0].count
+	if err := check(fn, n, outputs, inputs, false); err != nil || n == 0 {
+		return n, err
+	}
+	if err := check(fn, 0, outputs, []operand{coeff}, false); err != nil {
+		return n, err
+	}
+	if coeffLen == 0 {
+		return n, &Error{Func: fn, Arg: coeff.arg, Err: accel.ErrLengthMismatch}
+	}
+	for _, in := range inputs {
+		if in.count < (n-1)*factor+coeffLen {
+			return n, &Error{Func: fn, Arg: in.arg, Err: accel.ErrLengthMismatch}
+		}
+	}
+	return n, nil
+}
+
+// Desamp is the checked form of accel.Desamp.
+func Desamp(input []float32, desamplingFactor int, coeff []float32, output []float32) error {
+	n, err := checkDesamp("Desamp",
+		[]operand{newOperand("input", input, 1, 1)}, desamplingFactor,
+		len(coeff), newOperand("coeff", coeff, 1, 1),
+		[]operand{newOperand("output", output, 1, 1)})
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Desamp(input, desamplingFactor, coeff, output)
+	return nil
+}
+
+// Zrdesamp is the checked form of accel.Zrdesamp.
+func Zrdesamp(input accel.DSPSplitComplex, decimationFactor int, coefficients []float32, output accel.DSPSplitComplex) error {
+	n, err := checkDesamp("Zrdesamp",
+		split("input", input, 1), decimationFactor,
+		len(coefficients), newOperand("coefficients", coefficients, 1, 1),
+		split("output", output, 1))
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Zrdesamp(input, decimationFactor, coefficients, output)
+	return nil
+}
+
+// Zvphas is the checked form of accel.Zvphas.
+func Zvphas(input accel.DSPSplitComplex, inputStride int, output []float32, outputStride int) error {
+	out := newOperand("output", output, outputStride, 1)
+	if err := check("Zvphas", out.count, []operand{out}, split("input", input, inputStride), false); err != nil || out.count == 0 {
+		return err
+	}
+	accel.Zvphas(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Zidotpr is the checked form of accel.Zidotpr. The number of elements is
+// taken from input1 and result must hold at least one element.
+func Zidotpr(input1 accel.DSPSplitComplex, stride1 int, input2 accel.DSPSplitComplex, stride2 int, result accel.DSPSplitComplex) error {
+	ins := append(split("input1", input1, stride1), split("input2", input2, stride2)...)
+	outs := split("result", result, 1)
+	n := ins[0].count
+	if err := check("Zidotpr", n, nil, ins, false); err != nil {
+		return err
+	}
+	if err := check("Zidotpr", 1, outs, ins, false); err != nil {
+		return err
+	}
+	accel.Zidotpr(input1, stride1, input2, stride2, result)
+	return nil
+}
+
+// Zvcmul is the checked form of accel.Zvcmul.
+func Zvcmul(input1 accel.DSPSplitComplex, stride1 int, input2 accel.DSPSplitComplex, stride2 int, result accel.DSPSplitComplex, resultStride int) error {
+	outs := split("result", result, resultStride)
+	n := outs[0].count
+	ins := append(split("input1", input1, stride1), split("input2", input2, stride2)...)
+	if err := check("Zvcmul", n, outs, ins, true); err != nil || n == 0 {
+		return err
+	}
+	accel.Zvcmul(input1, stride1, input2, stride2, result, resultStride)
+	return nil
+}
+
+// Vfix16 is the checked form of accel.Vfix16.
+func Vfix16(input []float32, inputStride int, output []int16, outputStride int) error {
+	n, err := unary("Vfix16", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 1), false)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vfix16(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Vfix16_byte is the checked form of accel.Vfix16_byte.
+func Vfix16_byte(input []float32, inputStride int, output []byte, outputStride int) error {
+	n, err := unary("Vfix16_byte", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 2), false)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vfix16_byte(input, inputStride, output, outputStride)
+	return nil
+}
+
+func binary(fn string, in1, in2, out operand) (int, error) {
+	n := out.count
+	return n, check(fn, n, []operand{out}, []operand{in1, in2}, true)
+}
+
+// Vadd is the checked form of accel.Vadd.
+func Vadd(input1 []float32, input1Stride int, input2 []float32, input2Stride int, output []float32, outputStride int) error {
+	n, err := binary("Vadd", newOperand("input1", input1, input1Stride, 1), newOperand("input2", input2, input2Stride, 1), newOperand("output", output, outputStride, 1))
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vadd(input1, input1Stride, input2, input2Stride, output, outputStride)
+	return nil
+}
+
+// Vsmsa is the checked form of accel.Vsmsa.
+func Vsmsa(input []float32, inputStride int, mult, add float32, output []float32, outputStride int) error {
+	n, err := unary("Vsmsa", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 1), true)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vsmsa(input, inputStride, mult, add, output, outputStride)
+	return nil
+}
+
+// Vabs is the checked form of accel.Vabs.
+func Vabs(input []float32, inputStride int, output []float32, outputStride int) error {
+	n, err := unary("Vabs", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 1), true)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vabs(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Vsq is the checked form of accel.Vsq.
+func Vsq(input []float32, inputStride int, output []float32, outputStride int) error {
+	n, err := unary("Vsq", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 1), true)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vsq(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Zvabs is the checked form of accel.Zvabs.
+func Zvabs(input accel.DSPSplitComplex, inputStride int, output []float32, outputStride int) error {
+	out := newOperand("output", output, outputStride, 1)
+	if err := check("Zvabs", out.count, []operand{out}, split("input", input, inputStride), false); err != nil || out.count == 0 {
+		return err
+	}
+	accel.Zvabs(input, inputStride, output, outputStride)
+	return nil
+}
+
+// ZvabsD is the checked form of accel.ZvabsD.
+func ZvabsD(input accel.DSPDoubleSplitComplex, inputStride int, output []float64, outputStride int) error {
+	out := newOperand("output", output, outputStride, 1)
+	if err := check("ZvabsD", out.count, []operand{out}, splitD("input", input, inputStride), false); err != nil || out.count == 0 {
+		return err
+	}
+	accel.ZvabsD(input, inputStride, output, outputStride)
+	return nil
+}
+
+// reduce validates the input of a function that reduces a vector to a scalar.
+func reduce(fn string, input []float32, stride int) (int, error) {
+	op := newOperand("input", input, stride, 1)
+	return op.count, check(fn, op.count, nil, []operand{op}, false)
+}
+
+// Maxv is the checked form of accel.Maxv. It returns -Inf for an empty vector.
+func Maxv(input []float32, stride int) (float32, error) {
+	n, err := reduce("Maxv", input, stride)
+	if err != nil {
+		return 0, err
+	} else if n == 0 {
+		return float32(math.Inf(-1)), nil
+	}
+	return accel.Maxv(input, stride), nil
+}
+
+// Minv is the checked form of accel.Minv. It returns +Inf for an empty vector.
+func Minv(input []float32, stride int) (float32, error) {
+	n, err := reduce("Minv", input, stride)
+	if err != nil {
+		return 0, err
+	} else if n == 0 {
+		return float32(math.Inf(1)), nil
+	}
+	return accel.Minv(input, stride), nil
+}
+
+// Sve is the checked form of accel.Sve.
+func Sve(input []float32, inputStride int) (float32, error) {
+	n, err := reduce("Sve", input, inputStride)
+	if err != nil || n == 0 {
+		return 0, err
+	}
+	return accel.Sve(input, inputStride), nil
+}
+
+// Meanv is the checked form of accel.Meanv. It returns 0 for an empty vector.
+func Meanv(input []float32, stride int) (float32, error) {
+	n, err := reduce("Meanv", input, stride)
+	if err != nil || n == 0 {
+		return 0, err
+	}
+	return accel.Meanv(input, stride), nil
+}
+
+// Vavlin is the checked form of accel.Vavlin.
+func Vavlin(input []float32, inputStride int, count float32, output []float32, outputStride int) error {
+	n, err := unary("Vavlin", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 1), true)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vavlin(input, inputStride, count, output, outputStride)
+	return nil
+}
+
+// Vmul is the checked form of accel.Vmul.
+func Vmul(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) error {
+	n, err := binary("Vmul", newOperand("input1", input1, stride1, 1), newOperand("input2", input2, stride2, 1), newOperand("output", output, outputStride, 1))
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vmul(input1, stride1, input2, stride2, output, outputStride)
+	return nil
+}
+
+// Vsadd is the checked form of accel.Vsadd.
+func Vsadd(input []float32, inputStride int, add float32, output []float32, outputStride int) error {
+	n, err := unary("Vsadd", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 1), true)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vsadd(input, inputStride, add, output, outputStride)
+	return nil
+}
+
+// Vsdiv is the checked form of accel.Vsdiv.
+func Vsdiv(input []float32, inputStride int, divisor float32, output []float32, outputStride int) error {
+	n, err := unary("Vsdiv", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 1), true)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vsdiv(input, inputStride, divisor, output, outputStride)
+	return nil
+}
+
+// Vneg is the checked form of accel.Vneg.
+func Vneg(input []float32, inputStride int, output []float32, outputStride int) error {
+	n, err := unary("Vneg", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 1), true)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vneg(input, inputStride, output, outputStride)
+	return nil
+}
+
+// Vswsum is the checked form of accel.Vswsum. The input must hold
+// n+windowLen-1 elements where n is the number of outputs.
+func Vswsum(input []float32, inputStride int, output []float32, outputStride, windowLen int) error {
+	in := newOperand("input", input, inputStride, 1)
+	out := newOperand("output", output, outputStride, 1)
+	n := out.count
+	if err := check("Vswsum", n, []operand{out}, []operand{in}, false); err != nil || n == 0 {
+		return err
+	}
+	if windowLen <= 0 {
+		return &Error{Func: "Vswsum", Arg: "windowLen", Err: accel.ErrLengthMismatch}
+	}
+	if in.count < n+windowLen-1 {
+		return &Error{Func: "Vswsum", Arg: "input", Err: accel.ErrLengthMismatch}
+	}
+	accel.Vswsum(input, inputStride, output, outputStride, windowLen)
+	return nil
+}
+
+// Vdbcon is the checked form of accel.Vdbcon.
+func Vdbcon(input []float32, inputStride int, zeroReference float32, output []float32, outputStride int, flag accel.DBFlag) error {
+	n, err := unary("Vdbcon", newOperand("input", input, inputStride, 1), newOperand("output", output, outputStride, 1), true)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vdbcon(input, inputStride, zeroReference, output, outputStride, flag)
+	return nil
+}
+
+// Vvlog10f is the checked form of accel.Vvlog10f. The input must be at
+// least as long as the output.
+//
+// Deprecated: Vvlog10f takes its output first. Use Vvlog10_float.
+func Vvlog10f(output, input []float32) error {
+	n, err := unary("Vvlog10f", newOperand("input", input, 1, 1), newOperand("output", output, 1, 1), true)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vvlog10f(output, input)
+	return nil
+}
+
+// Vvlog10_float is the checked form of accel.Vvlog10_float. The input must
+// be at least as long as the output.
+func Vvlog10_float(input, output []float32) error {
+	n, err := unary("Vvlog10_float", newOperand("input", input, 1, 1), newOperand("output", output, 1, 1), true)
+	if err != nil || n == 0 {
+		return err
+	}
+	accel.Vvlog10_float(input, output)
+	return nil
+}
@@ -0,0 +1,163 @@
+package checked
+
+import "github.com/samuel/go-accelerate/accel"
+
+// FFTSetup wraps an accel.FFTSetup and remembers its size so transforms can
+// be validated before they are run.
+type FFTSetup struct {
+	*accel.FFTSetup
+	log2n int
+}
+
+// FFTSetupD wraps an accel.FFTSetupD and remembers its size so transforms
+// can be validated before they are run.
+type FFTSetupD struct {
+	*accel.FFTSetupD
+	log2n int
+}
+
+// CreateFFTSetup returns a checked setup for transforms of up to 1<<log2n
+// elements.
+func CreateFFTSetup(log2n int, radix accel.FFTRadix) (*FFTSetup, error) {
+	fs, err := accel.CreateFFTSetup(log2n, radix)
+	if err != nil {
+		return nil, err
+	}
+	return &FFTSetup{FFTSetup: fs, log2n: log2n}, nil
+}
+
+// CreateFFTSetupD returns a checked double-precision setup for transforms of
+// up to 1<<log2n elements.
+func CreateFFTSetupD(log2n int, radix accel.FFTRadix) (*FFTSetupD, error) {
+	fs, err := accel.CreateFFTSetupD(log2n, radix)
+	if err != nil {
+		return nil, err
+	}
+	return &FFTSetupD{FFTSetupD: fs, log2n: log2n}, nil
+}
+
+// checkFFT validates the size of a transform against the setup and the
+// lengths of its operands. Real transforms work on n/2 packed elements.
+func checkFFT(fn string, setupLog2n, log2n int, real bool, outputs, inputs []operand) error {
+	n := 1 << uint(log2n)
+	if real {
+		n /= 2
+	}
+	return checkFFTLen(fn, setupLog2n, log2n, n, outputs, inputs)
+}
+
+// checkFFTLen is checkFFT for transforms of n elements.
+func checkFFTLen(fn string, setupLog2n, log2n, n int, outputs, inputs []operand) error {
+	if log2n < 0 || log2n > setupLog2n {
+		return &Error{Func: fn, Arg: "log2n", Err: accel.ErrLengthMismatch}
+	}
+	return check(fn, n, outputs, inputs, false)
+}
+
+// Zrip is the checked form of accel.FFTSetup.Zrip.
+func (fs *FFTSetup) Zrip(ioData accel.DSPSplitComplex, stride, log2n int, direction accel.FFTDirection) error {
+	if err := checkFFT("FFTSetup.Zrip", fs.log2n, log2n, true, split("ioData", ioData, stride), nil); err != nil {
+		return err
+	}
+	fs.FFTSetup.Zrip(ioData, stride, log2n, direction)
+	return nil
+}
+
+// Zrop is the checked form of accel.FFTSetup.Zrop.
+func (fs *FFTSetup) Zrop(input accel.DSPSplitComplex, inputStride int, output accel.DSPSplitComplex, outputStride int, log2n int, direction accel.FFTDirection) error {
+	if err := checkFFT("FFTSetup.Zrop", fs.log2n, log2n, true, split("output", output, outputStride), split("input", input, inputStride)); err != nil {
+		return err
+	}
+	fs.FFTSetup.Zrop(input, inputStride, output, outputStride, log2n, direction)
+	return nil
+}
+
+// Zip is the checked form of accel.FFTSetup.Zip.
+func (fs *FFTSetup) Zip(ioData accel.DSPSplitComplex, stride, log2n int, direction accel.FFTDirection) error {
+	if err := checkFFT("FFTSetup.Zip", fs.log2n, log2n, false, split("ioData", ioData, stride), nil); err != nil {
+		return err
+	}
+	fs.FFTSetup.Zip(ioData, stride, log2n, direction)
+	return nil
+}
+
+// Zop is the checked form of accel.FFTSetup.Zop.
+func (fs *FFTSetup) Zop(input accel.DSPSplitComplex, inputStride int, output accel.DSPSplitComplex, outputStride int, log2n int, direction accel.FFTDirection) error {
+	if err := checkFFT("FFTSetup.Zop", fs.log2n, log2n, false, split("output", output, outputStride), split("input", input, inputStride)); err != nil {
+		return err
+	}
+	fs.FFTSetup.Zop(input, inputStride, output, outputStride, log2n, direction)
+	return nil
+}
+
+// Zrip is the checked form of accel.FFTSetupD.Zrip.
+func (fs *FFTSetupD) Zrip(ioData accel.DSPDoubleSplitComplex, stride, log2n int, direction accel.FFTDirection) error {
+	if err := checkFFT("FFTSetupD.Zrip", fs.log2n, log2n, true, splitD("ioData", ioData, stride), nil); err != nil {
+		return err
+	}
+	fs.FFTSetupD.Zrip(ioData, stride, log2n, direction)
+	return nil
+}
+
+// Zrop is the checked form of accel.FFTSetupD.Zrop.
+func (fs *FFTSetupD) Zrop(input accel.DSPDoubleSplitComplex, inputStride int, output accel.DSPDoubleSplitComplex, outputStride int, log2n int, direction accel.FFTDirection) error {
+	if err := checkFFT("FFTSetupD.Zrop", fs.log2n, log2n, true, splitD("output", output, outputStride), splitD("input", input, inputStride)); err != nil {
+		return err
+	}
+	fs.FFTSetupD.Zrop(input, inputStride, output, outputStride, log2n, direction)
+	return nil
+}
+
+// Zip is the checked form of accel.FFTSetupD.Zip.
+func (fs *FFTSetupD) Zip(ioData accel.DSPDoubleSplitComplex, stride, log2n int, direction accel.FFTDirection) error {
+	if err := checkFFT("FFTSetupD.Zip", fs.log2n, log2n, false, splitD("ioData", ioData, stride), nil); err != nil {
+		return err
+	}
+	fs.FFTSetupD.Zip(ioData, stride, log2n, direction)
+	return nil
+}
+
+// Zop is the checked form of accel.FFTSetupD.Zop.
+func (fs *FFTSetupD) Zop(input accel.DSPDoubleSplitComplex, inputStride int, output accel.DSPDoubleSplitComplex, outputStride int, log2n int, direction accel.FFTDirection) error {
+	if err := checkFFT("FFTSetupD.Zop", fs.log2n, log2n, false, splitD("output", output, outputStride), splitD("input", input, inputStride)); err != nil {
+		return err
+	}
+	fs.FFTSetupD.Zop(input, inputStride, output, outputStride, log2n, direction)
+	return nil
+}
+
+// Zop3 is the checked form of accel.FFTSetup.Zop3.
+func (fs *FFTSetup) Zop3(input accel.DSPSplitComplex, inputStride int, output accel.DSPSplitComplex, outputStride int, log2n int, direction accel.FFTDirection) error {
+	if err := checkFFTLen("FFTSetup.Zop3", fs.log2n, log2n, 3<<uint(log2n), split("output", output, outputStride), split("input", input, inputStride)); err != nil {
+		return err
+	}
+	fs.FFTSetup.Zop3(input, inputStride, output, outputStride, log2n, direction)
+	return nil
+}
+
+// Zop5 is the checked form of accel.FFTSetup.Zop5.
+func (fs *FFTSetup) Zop5(input accel.DSPSplitComplex, inputStride int, output accel.DSPSplitComplex, outputStride int, log2n int, direction accel.FFTDirection) error {
+	if err := checkFFTLen("FFTSetup.Zop5", fs.log2n, log2n, 5<<uint(log2n), split("output", output, outputStride), split("input", input, inputStride)); err != nil {
+		return err
+	}
+	fs.FFTSetup.Zop5(input, inputStride, output, outputStride, log2n, direction)
+	return nil
+}
+
+// Zop3 is the checked form of accel.FFTSetupD.Zop3.
+func (fs *FFTSetupD) Zop3(input accel.DSPDoubleSplitComplex, inputStride int, output accel.DSPDoubleSplitComplex, outputStride int, log2n int, direction accel.FFTDirection) error {
+	if err := checkFFTLen("FFTSetupD.Zop3", fs.log2n, log2n, 3<<uint(log2n), splitD("output", output, outputStride), splitD("input", input, inputStride)); err != nil {
+		return err
+	}
+	fs.FFTSetupD.Zop3(input, inputStride, output, outputStride, log2n, direction)
+	return nil
+}
+
+// Zop5 is the checked form of accel.FFTSetupD.Zop5.
+func (fs *FFTSetupD) Zop5(input accel.DSPDoubleSplitComplex, inputStride int, output accel.DSPDoubleSplitComplex, outputStride int, log2n int, direction accel.FFTDirection) error {
+	if err := checkFFTLen("FFTSetupD.Zop5", fs.log2n, log2n, 5<<uint(log2n), splitD("output", output, outputStride), splitD("input", input, inputStride)); err != nil {
+		return err
+	}
+	fs.FFTSetupD.Zop5(input, inputStride, output, outputStride, log2n, direction)
+	return nil
+}
@@ -1,3 +1,5 @@
+//go:build darwin && cgo && !purego
+
 package accel
 
 // #include <Accelerate/Accelerate.h>
@@ -0,0 +1,70 @@
+package accel
+
+import "testing"
+
+func TestVImageConvolve_ARGB8888(t *testing.T) {
+	src := CreateVImageBuffer(3, 3, 4, 3*4)
+	for i := range src.Data {
+		src.Data[i] = uint8(i * 7)
+	}
+	dst := CreateVImageBuffer(3, 3, 4, 3*4)
+	kernel := []int16{0, 0, 0, 0, 2, 0, 0, 0, 0}
+	if err := VImageConvolve_ARGB8888(src, dst, nil, 0, 0, kernel, 3, 3, 2, [4]uint8{}, VImageFlagEdgeExtend); err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range dst.Data {
+		if v != src.Data[i] {
+			t.Fatalf("identity kernel changed byte %d from %d to %d", i, src.Data[i], v)
+		}
+	}
+	// Box blur of a single bright pixel with a zero background
+	for i := range src.Data {
+		src.Data[i] = 0
+	}
+	src.Data[4*4+1] = 90
+	box := []int16{1, 1, 1, 1, 1, 1, 1, 1, 1}
+	if err := VImageConvolve_ARGB8888(src, dst, nil, 0, 0, box, 3, 3, 9, [4]uint8{}, VImageFlagBackgroundColorFill); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 9; i++ {
+		if v := dst.Data[i*4+1]; v != 10 {
+			t.Errorf("pixel %d red = %d; want 10", i, v)
+		}
+	}
+	if err := VImageConvolve_ARGB8888(src, dst, nil, 0, 0, box[:4], 2, 2, 4, [4]uint8{}, VImageFlagEdgeExtend); err != ErrImageInvalidKernelSize {
+		t.Errorf("even kernel returned %v; want %v", err, ErrImageInvalidKernelSize)
+	}
+	if err := VImageConvolve_ARGB8888(src, dst, nil, 0, 0, box, 3, 3, 9, [4]uint8{}, VImageFlagNoFlags); err != ErrImageInvalidEdgeStyle {
+		t.Errorf("missing edge style returned %v; want %v", err, ErrImageInvalidEdgeStyle)
+	}
+}
+
+func TestVImageHistogramCalculation_Planar8(t *testing.T) {
+	src := &VImageBuffer{Width: 3, Height: 2, RowBytes: 4, Data: []byte{1, 2, 2, 99, 7, 1, 1, 99}}
+	hist, err := VImageHistogramCalculation_Planar8(src, VImageFlagNoFlags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hist[1] != 3 || hist[2] != 2 || hist[7] != 1 || hist[99] != 0 {
+		t.Errorf("unexpected histogram counts %d %d %d %d", hist[1], hist[2], hist[7], hist[99])
+	}
+}
+
+func TestCreateVImageBuffer(t *testing.T) {
+	// A zero rowBytes packs the rows: one row is width*channels bytes.
+	b := CreateVImageBuffer(5, 3, 4, 0)
+	if b.RowBytes != 5*4 || len(b.Data) != 5*4*3 {
+		t.Errorf("CreateVImageBuffer(5, 3, 4, 0) has RowBytes %d and %d bytes; want %d and %d", b.RowBytes, len(b.Data), 5*4, 5*4*3)
+	}
+	// Padded rows are fine as long as a row still fits.
+	b = CreateVImageBuffer(5, 3, 4, 24)
+	if b.RowBytes != 24 || len(b.Data) != 24*3 {
+		t.Errorf("CreateVImageBuffer(5, 3, 4, 24) has RowBytes %d and %d bytes; want 24 and %d", b.RowBytes, len(b.Data), 24*3)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("CreateVImageBuffer accepted a rowBytes shorter than a row")
+		}
+	}()
+	CreateVImageBuffer(5, 3, 4, 19)
+}
@@ -0,0 +1,198 @@
+package accel
+
+import "math/bits"
+
+// ConvMode selects which part of a linear convolution or correlation is
+// returned, following NumPy's numpy.convolve.
+type ConvMode int
+
+const (
+	// ConvFull returns every output where the inputs overlap at all:
+	// len(a)+len(b)-1 values.
+	ConvFull ConvMode = iota
+	// ConvSame returns max(len(a), len(b)) values centred on the full
+	// output.
+	ConvSame
+	// ConvValid returns only the outputs where the inputs overlap
+	// completely: max(len(a), len(b))-min(len(a), len(b))+1 values.
+	ConvValid
+)
+
+// convDirectMaxTaps is the longest kernel that Convolve and Correlate
+// apply directly with Conv. Longer kernels use FFT overlap-add.
+const convDirectMaxTaps = 64
+
+// Convolve computes the linear convolution of signal and kernel and stores
+// the part selected by mode in dst, which is allocated if it is too
+// small. It returns dst resliced to the output length. The inputs are
+// interchangeable; the shorter one is used as the kernel.
+//
+// Short kernels are applied directly with Conv. Long ones use overlap-add
+// FFT convolution with a shared setup from FFTPlanFor, whose results
+// differ from the direct method by rounding only.
+func Convolve(dst, signal, kernel []float32, mode ConvMode) []float32 {
+	if len(kernel) > len(signal) {
+		signal, kernel = kernel, signal
+	}
+	m := len(kernel)
+	if m == 0 {
+		return dst[:0]
+	}
+	full := make([]float32, len(signal)+m-1)
+	if m <= convDirectMaxTaps {
+		padded := make([]float32, len(signal)+2*(m-1))
+		copy(padded[m-1:], signal)
+		Conv(padded, 1, kernel, -1, full, 1)
+	} else {
+		fftConvolve(full, signal, kernel)
+	}
+	start, n := convWindow(len(signal), m, mode)
+	dst = growFloat32(dst, n)
+	copy(dst, full[start:])
+	return dst
+}
+
+// Correlate computes the cross-correlation c[k] = Σ a[n+k]·b[n] of a and
+// b, with the lag k running from -(len(b)-1) to len(a)-1 in full mode, and
+// stores the part selected by mode in dst like Convolve. It equals
+// Convolve of a with b reversed.
+func Correlate(dst, a, b []float32, mode ConvMode) []float32 {
+	reversed := make([]float32, len(b))
+	for i, v := range b {
+		reversed[len(b)-1-i] = v
+	}
+	return Convolve(dst, a, reversed, mode)
+}
+
+// ConvolveComplex is the complex counterpart of Convolve.
+func ConvolveComplex(dst, signal, kernel []complex64, mode ConvMode) []complex64 {
+	if len(kernel) > len(signal) {
+		signal, kernel = kernel, signal
+	}
+	m := len(kernel)
+	if m == 0 {
+		return dst[:0]
+	}
+	full := makeSplit(len(signal) + m - 1)
+	if m <= convDirectMaxTaps {
+		padded := makeSplit(len(signal) + 2*(m-1))
+		Ctoz(signal, 2, DSPSplitComplex{Real: padded.Real[m-1:], Imag: padded.Imag[m-1:]}, 1)
+		k := makeSplit(m)
+		Ctoz(kernel, 2, k, 1)
+		Zconv(padded, 1, k, -1, full, 1)
+	} else {
+		fftConvolveComplex(full, signal, kernel)
+	}
+	start, n := convWindow(len(signal), m, mode)
+	dst = growComplex64(dst, n)
+	Ztoc(DSPSplitComplex{Real: full.Real[start:], Imag: full.Imag[start:]}, 1, dst, 2)
+	return dst
+}
+
+// CorrelateComplex computes c[k] = Σ a[n+k]·conj(b[n]) like NumPy's
+// numpy.correlate. It equals ConvolveComplex of a with b reversed and
+// conjugated.
+func CorrelateComplex(dst, a, b []complex64, mode ConvMode) []complex64 {
+	reversed := make([]complex64, len(b))
+	for i, v := range b {
+		reversed[len(b)-1-i] = conj64(v)
+	}
+	return ConvolveComplex(dst, a, reversed, mode)
+}
+
+// convWindow returns the start and length of the part of a full
+// convolution of n and m (m <= n) values selected by mode.
+func convWindow(n, m int, mode ConvMode) (start, length int) {
+	switch mode {
+	case ConvSame:
+		return (m - 1) / 2, n
+	case ConvValid:
+		return m - 1, n - m + 1
+	default:
+		return 0, n + m - 1
+	}
+}
+
+// convFFTLog2n returns the FFT size used to convolve with a kernel of m
+// taps: at least twice the kernel so each block carries as many new
+// samples as the kernel is long.
+func convFFTLog2n(m int) int {
+	return max(bits.Len(uint(2*m-1)), 8)
+}
+
+// fftConvolve adds the full convolution of x and h into out using
+// overlap-add with real FFTs.
+func fftConvolve(out, x, h []float32) {
+	m := len(h)
+	log2n := convFFTLog2n(m)
+	size := 1 << uint(log2n)
+	block := size - m + 1
+	setup, err := FFTPlanFor(log2n, FFTRadix2)
+	if err != nil {
+		panic(err)
+	}
+	tmp := make([]float32, size)
+	hs := makeSplit(size / 2)
+	copy(tmp, h)
+	Ctoz_float(tmp, 2, hs, 1)
+	setup.Zrip(hs, 1, log2n, FFTDirectionForward)
+	// Both spectra carry vDSP's factor of 2 and the inverse multiplies by
+	// the size. Fold the correction into the kernel and conjugate it for
+	// Zvcmul; the packed DC and Nyquist terms are real.
+	scale := 1 / float32(4*size)
+	dc, nyquist := hs.Real[0]*scale, hs.Imag[0]*scale
+	Vsmsa(hs.Real, 1, scale, 0, hs.Real, 1)
+	Vsmsa(hs.Imag, 1, -scale, 0, hs.Imag, 1)
+	hs.Real[0], hs.Imag[0] = dc, nyquist
+
+	buf := makeSplit(size / 2)
+	for start := 0; start < len(x); start += block {
+		seg := x[start:min(start+block, len(x))]
+		clear(tmp)
+		copy(tmp, seg)
+		Ctoz_float(tmp, 2, buf, 1)
+		setup.Zrip(buf, 1, log2n, FFTDirectionForward)
+		dc, nyquist := buf.Real[0]*hs.Real[0], buf.Imag[0]*hs.Imag[0]
+		Zvcmul(hs, 1, buf, 1, buf, 1)
+		buf.Real[0], buf.Imag[0] = dc, nyquist
+		setup.Zrip(buf, 1, log2n, FFTDirectionInverse)
+		Ztoc_float(buf, 1, tmp, 2)
+		n := min(len(seg)+m-1, len(out)-start)
+		Vadd(out[start:start+n], 1, tmp, 1, out[start:start+n], 1)
+	}
+}
+
+// fftConvolveComplex adds the full convolution of x and h into out using
+// overlap-add with complex FFTs.
+func fftConvolveComplex(out DSPSplitComplex, x, h []complex64) {
+	m := len(h)
+	log2n := convFFTLog2n(m)
+	size := 1 << uint(log2n)
+	block := size - m + 1
+	setup, err := FFTPlanFor(log2n, FFTRadix2)
+	if err != nil {
+		panic(err)
+	}
+	hs := makeSplit(size)
+	Ctoz(h, 2, hs, 1)
+	setup.Zip(hs, 1, log2n, FFTDirectionForward)
+	// Conjugate for Zvcmul and undo the scaling of the inverse.
+	scale := 1 / float32(size)
+	Vsmsa(hs.Real, 1, scale, 0, hs.Real, 1)
+	Vsmsa(hs.Imag, 1, -scale, 0, hs.Imag, 1)
+
+	buf := makeSplit(size)
+	for start := 0; start < len(x); start += block {
+		seg := x[start:min(start+block, len(x))]
+		clear(buf.Real)
+		clear(buf.Imag)
+		Ctoz(seg, 2, buf, 1)
+		setup.Zip(buf, 1, log2n, FFTDirectionForward)
+		Zvcmul(hs, 1, buf, 1, buf, 1)
+		setup.Zip(buf, 1, log2n, FFTDirectionInverse)
+		n := min(len(seg)+m-1, len(out.Real)-start)
+		re, im := out.Real[start:start+n], out.Imag[start:start+n]
+		Vadd(re, 1, buf.Real, 1, re, 1)
+		Vadd(im, 1, buf.Imag, 1, im, 1)
+	}
+}
@@ -0,0 +1,146 @@
+package accel
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func naiveConvolve(a, b []complex128) []complex128 {
+	out := make([]complex128, len(a)+len(b)-1)
+	for i, x := range a {
+		for j, y := range b {
+			out[i+j] += x * y
+		}
+	}
+	return out
+}
+
+func TestConvolveNumPy(t *testing.T) {
+	// Examples from the numpy.convolve and numpy.correlate documentation.
+	cases := []struct {
+		name string
+		got  []float32
+		want []float32
+	}{
+		{"convolve full", Convolve(nil, []float32{1, 2, 3}, []float32{0, 1, 0.5}, ConvFull), []float32{0, 1, 2.5, 4, 1.5}},
+		{"convolve same", Convolve(nil, []float32{1, 2, 3}, []float32{0, 1, 0.5}, ConvSame), []float32{1, 2.5, 4}},
+		{"convolve valid", Convolve(nil, []float32{1, 2, 3}, []float32{0, 1, 0.5}, ConvValid), []float32{2.5}},
+		{"convolve same even", Convolve(nil, []float32{1, 2, 3, 4, 5}, []float32{1, 1, 1, 1}, ConvSame), []float32{3, 6, 10, 14, 12}},
+		{"convolve swapped", Convolve(nil, []float32{0, 1, 0.5}, []float32{1, 2, 3, 4}, ConvValid), []float32{2.5, 4}},
+		{"correlate valid", Correlate(nil, []float32{1, 2, 3}, []float32{0, 1, 0.5}, ConvValid), []float32{3.5}},
+		{"correlate full", Correlate(nil, []float32{1, 2, 3}, []float32{0, 1, 0.5}, ConvFull), []float32{0.5, 2, 3.5, 3, 0}},
+		{"empty", Convolve(nil, []float32{1, 2}, nil, ConvFull), []float32{}},
+	}
+	for _, c := range cases {
+		if len(c.got) != len(c.want) {
+			t.Errorf("%s = %v; want %v", c.name, c.got, c.want)
+			continue
+		}
+		for i := range c.want {
+			if !almostEqual32(c.got[i], c.want[i], 1e-6) {
+				t.Errorf("%s = %v; want %v", c.name, c.got, c.want)
+				break
+			}
+		}
+	}
+
+	got := CorrelateComplex(nil, []complex64{1 + 1i, 2, 3 - 1i}, []complex64{0, 1, 0.5i}, ConvFull)
+	want := []complex64{0.5 - 0.5i, 1, 1.5 - 1.5i, 3 - 1i, 0}
+	for i := range want {
+		if i >= len(got) || got[i] != want[i] {
+			t.Fatalf("CorrelateComplex = %v; want %v", got, want)
+		}
+	}
+}
+
+// TestConvolveMethods checks both the direct and FFT paths against a
+// naive convolution for every mode.
+func TestConvolveMethods(t *testing.T) {
+	for _, size := range [][2]int{{50, 7}, {300, 64}, {1000, 65}, {2000, 300}, {129, 129}} {
+		n, m := size[0], size[1]
+		a := make([]complex128, n)
+		b := make([]complex128, m)
+		sig := make([]float32, n)
+		ker := make([]float32, m)
+		csig := make([]complex64, n)
+		cker := make([]complex64, m)
+		for i := range a {
+			sig[i] = float32(math.Sin(float64(i) * 0.37))
+			csig[i] = complex(sig[i], float32(math.Cos(float64(i)*0.21)))
+			a[i] = complex128(csig[i])
+		}
+		for i := range b {
+			ker[i] = float32(math.Exp(-float64(i)/float64(m))) - 0.3
+			cker[i] = complex(ker[i], float32(i%3)-1)
+			b[i] = complex128(cker[i])
+		}
+		ra := make([]complex128, n)
+		rb := make([]complex128, m)
+		for i, v := range sig {
+			ra[i] = complex(float64(v), 0)
+		}
+		for i, v := range ker {
+			rb[i] = complex(float64(v), 0)
+		}
+		realFull := naiveConvolve(ra, rb)
+		complexFull := naiveConvolve(a, b)
+		for _, mode := range []ConvMode{ConvFull, ConvSame, ConvValid} {
+			start, length := convWindow(n, m, mode)
+			name := fmt.Sprintf("%dx%d mode %d", n, m, mode)
+			got := Convolve(nil, sig, ker, mode)
+			if len(got) != length {
+				t.Fatalf("%s: Convolve returned %d values; want %d", name, len(got), length)
+			}
+			for i, v := range got {
+				if !almostEqual64(float64(v), real(realFull[start+i]), 1e-3) {
+					t.Errorf("%s: Convolve [%d] = %f; want %f", name, i, v, real(realFull[start+i]))
+					break
+				}
+			}
+			cgot := ConvolveComplex(nil, csig, cker, mode)
+			if len(cgot) != length {
+				t.Fatalf("%s: ConvolveComplex returned %d values; want %d", name, len(cgot), length)
+			}
+			for i, v := range cgot {
+				w := complexFull[start+i]
+				if !almostEqual64(float64(real(v)), real(w), 1e-3) || !almostEqual64(float64(imag(v)), imag(w), 1e-3) {
+					t.Errorf("%s: ConvolveComplex [%d] = %v; want %v", name, i, v, w)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestConv(t *testing.T) {
+	input := []float32{1, 2, 3, 4, 5}
+	filter := []float32{1, 0, -1}
+	output := make([]float32, 3)
+	Conv(input, 1, filter, 1, output, 1)
+	if output[0] != -2 || output[1] != -2 || output[2] != -2 {
+		t.Errorf("correlation = %v; want [-2 -2 -2]", output)
+	}
+	Conv(input, 1, filter, -1, output, 1)
+	if output[0] != 2 || output[1] != 2 || output[2] != 2 {
+		t.Errorf("convolution = %v; want [2 2 2]", output)
+	}
+	outputD := make([]float64, 2)
+	ConvD([]float64{1, 2, 3, 4, 5, 6}, 2, []float64{1, 1}, 1, outputD, 1)
+	if outputD[0] != 4 || outputD[1] != 8 {
+		t.Errorf("strided ConvD = %v; want [4 8]", outputD)
+	}
+}
+
+func BenchmarkConvolveFFT(b *testing.B) {
+	signal := make([]float32, 1<<16)
+	kernel := make([]float32, 1024)
+	for i := range kernel {
+		kernel[i] = float32(i)
+	}
+	var dst []float32
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = Convolve(dst, signal, kernel, ConvSame)
+	}
+}
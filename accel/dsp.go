@@ -1,3 +1,5 @@
+//go:build darwin && cgo && !purego
+
 package accel
 
 // #include <Accelerate/Accelerate.h>
@@ -5,31 +7,6 @@ import "C"
 
 import "unsafe"
 
-type DSPSplitComplex struct {
-	Real []float32
-	Imag []float32
-}
-
-type DSPDoubleSplitComplex struct {
-	Real []float64
-	Imag []float64
-}
-
-type WindowFlag int
-
-const (
-	WindowFlagHannDenorm WindowFlag = C.vDSP_HANN_DENORM // creates a denormalized window.
-	WindowFlagHannNorm   WindowFlag = C.vDSP_HANN_NORM   // creates a normalized window.
-	WindowFlagHalfWindow WindowFlag = C.vDSP_HALF_WINDOW // creates only the first (N+1)/2 points.
-)
-
-type DBFlag int
-
-const (
-	DBFlagPower     DBFlag = 0
-	DBFlagAmplitude DBFlag = 1
-)
-
 func minLen(size ...int) C.vDSP_Length {
 	min := size[0]
 	for i := 1; i < len(size); i++ {
@@ -91,7 +68,7 @@ func Ctoz(input []complex64, inputStride int, output DSPSplitComplex, outputStri
 	var splitComplex C.DSPSplitComplex
 	splitComplex.realp = (*C.float)(&output.Real[0])
 	splitComplex.imagp = (*C.float)(&output.Imag[0])
-	n := 2 * len(output.Real) / outputStride
+	n := len(output.Real) / outputStride
 	if n2 := 2 * len(input) / inputStride; n2 < n {
 		n = n2
 	}
@@ -102,7 +79,7 @@ func Ctoz_float(input []float32, inputStride int, output DSPSplitComplex, output
 	var splitComplex C.DSPSplitComplex
 	splitComplex.realp = (*C.float)(&output.Real[0])
 	splitComplex.imagp = (*C.float)(&output.Imag[0])
-	n := 2 * len(output.Real) / outputStride
+	n := len(output.Real) / outputStride
 	if n2 := len(input) / inputStride; n2 < n {
 		n = n2
 	}
@@ -114,7 +91,7 @@ func Ctoz_byte(input []byte, inputStride int, output DSPSplitComplex, outputStri
 	var splitComplex C.DSPSplitComplex
 	splitComplex.realp = (*C.float)(&output.Real[0])
 	splitComplex.imagp = (*C.float)(&output.Imag[0])
-	n := 2 * len(output.Real) / outputStride
+	n := len(output.Real) / outputStride
 	if n2 := len(input) / (4 * inputStride); n2 < n {
 		n = n2
 	}
@@ -126,7 +103,7 @@ func Ztoc(input DSPSplitComplex, inputStride int, output []complex64, outputStri
 	var splitComplex C.DSPSplitComplex
 	splitComplex.realp = (*C.float)(&input.Real[0])
 	splitComplex.imagp = (*C.float)(&input.Imag[0])
-	C.vDSP_ztoc(&splitComplex, C.vDSP_Stride(inputStride), (*C.DSPComplex)(unsafe.Pointer(&output[0])), C.vDSP_Stride(outputStride), C.vDSP_Length(2*len(output)/outputStride))
+	C.vDSP_ztoc(&splitComplex, C.vDSP_Stride(inputStride), (*C.DSPComplex)(unsafe.Pointer(&output[0])), C.vDSP_Stride(outputStride), minLen(len(input.Real)/inputStride, 2*len(output)/outputStride))
 }
 
 // Ztoc_float copies the contents of a split complex vector Z to an interleaved complex vector C; single precision.
@@ -134,7 +111,7 @@ func Ztoc_float(input DSPSplitComplex, inputStride int, output []float32, output
 	var splitComplex C.DSPSplitComplex
 	splitComplex.realp = (*C.float)(&input.Real[0])
 	splitComplex.imagp = (*C.float)(&input.Imag[0])
-	C.vDSP_ztoc(&splitComplex, C.vDSP_Stride(inputStride), (*C.DSPComplex)(unsafe.Pointer(&output[0])), C.vDSP_Stride(outputStride), C.vDSP_Length(len(output)/outputStride))
+	C.vDSP_ztoc(&splitComplex, C.vDSP_Stride(inputStride), (*C.DSPComplex)(unsafe.Pointer(&output[0])), C.vDSP_Stride(outputStride), minLen(len(input.Real)/inputStride, len(output)/outputStride))
 }
 
 // Ztoc_byte copies the contents of a split complex vector Z to an interleaved complex vector C; single precision. Operate on a byte buffer which contains complex64
@@ -142,7 +119,7 @@ func Ztoc_byte(input DSPSplitComplex, inputStride int, output []byte, outputStri
 	var splitComplex C.DSPSplitComplex
 	splitComplex.realp = (*C.float)(&input.Real[0])
 	splitComplex.imagp = (*C.float)(&input.Imag[0])
-	C.vDSP_ztoc(&splitComplex, C.vDSP_Stride(inputStride), (*C.DSPComplex)(unsafe.Pointer(&output[0])), C.vDSP_Stride(outputStride), C.vDSP_Length(len(output)/4/outputStride))
+	C.vDSP_ztoc(&splitComplex, C.vDSP_Stride(inputStride), (*C.DSPComplex)(unsafe.Pointer(&output[0])), C.vDSP_Stride(outputStride), minLen(len(input.Real)/inputStride, len(output)/4/outputStride))
 }
 
 // Vclr clears the provided vector
@@ -168,18 +145,26 @@ func Vthr(input []float32, inputStride int, low float32, output []float32, outpu
 
 // Desamp performs convolution with decimation.
 func Desamp(input []float32, desamplingFactor int, coeff []float32, output []float32) {
-	C.vDSP_desamp((*C.float)(&input[0]), C.vDSP_Stride(desamplingFactor), (*C.float)(&coeff[0]), (*C.float)(&output[0]), C.vDSP_Length(len(output)), C.vDSP_Length(len(coeff)))
+	n := desampLen(len(input), desamplingFactor, len(coeff), len(output))
+	if n <= 0 {
+		return
+	}
+	C.vDSP_desamp((*C.float)(&input[0]), C.vDSP_Stride(desamplingFactor), (*C.float)(&coeff[0]), (*C.float)(&output[0]), C.vDSP_Length(n), C.vDSP_Length(len(coeff)))
 }
 
 // Zrdesamp performs a complex-real downsample with anti-aliasing.
 func Zrdesamp(input DSPSplitComplex, decimationFactor int, coefficients []float32, output DSPSplitComplex) {
+	n := desampLen(len(input.Real), decimationFactor, len(coefficients), len(output.Real))
+	if n <= 0 {
+		return
+	}
 	var srcC C.DSPSplitComplex
 	srcC.realp = (*C.float)(&input.Real[0])
 	srcC.imagp = (*C.float)(&input.Imag[0])
 	var dstC C.DSPSplitComplex
 	dstC.realp = (*C.float)(&output.Real[0])
 	dstC.imagp = (*C.float)(&output.Imag[0])
-	C.vDSP_zrdesamp(&srcC, C.vDSP_Stride(decimationFactor), (*C.float)(&coefficients[0]), &dstC, C.vDSP_Length(len(output.Real)), C.vDSP_Length(len(coefficients)))
+	C.vDSP_zrdesamp(&srcC, C.vDSP_Stride(decimationFactor), (*C.float)(&coefficients[0]), &dstC, C.vDSP_Length(n), C.vDSP_Length(len(coefficients)))
 }
 
 // Zvphas calculates the complex vector phase.
@@ -187,7 +172,7 @@ func Zvphas(input DSPSplitComplex, inputStride int, output []float32, outputStri
 	var srcC C.DSPSplitComplex
 	srcC.realp = (*C.float)(&input.Real[0])
 	srcC.imagp = (*C.float)(&input.Imag[0])
-	C.vDSP_zvphas(&srcC, C.vDSP_Stride(inputStride), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), C.vDSP_Length(len(output)))
+	C.vDSP_zvphas(&srcC, C.vDSP_Stride(inputStride), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input.Real)/inputStride, len(output)/outputStride))
 }
 
 // Zidotpr calculates the conjugate dot product (or inner dot product) of complex vectors A and B and leave the result in complex vector C; single precision.
@@ -201,7 +186,7 @@ func Zidotpr(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride
 	var res C.DSPSplitComplex
 	res.realp = (*C.float)(&result.Real[0])
 	res.imagp = (*C.float)(&result.Imag[0])
-	C.vDSP_zidotpr(&in1, C.vDSP_Stride(stride1), &in2, C.vDSP_Stride(stride2), &res, C.vDSP_Length(len(result.Real)))
+	C.vDSP_zidotpr(&in1, C.vDSP_Stride(stride1), &in2, C.vDSP_Stride(stride2), &res, minLen(len(input1.Real)/stride1, len(input2.Real)/stride2))
 }
 
 // Zvcmul performs a complex vector conjugate and multiply.
@@ -225,7 +210,7 @@ func Vfix16(input []float32, inputStride int, output []int16, outputStride int)
 
 // Vfix16_byte converts an array of single-precision floating-point values to signed 16-bit integer values, rounding towards zero. Output to a byte stream.
 func Vfix16_byte(input []float32, inputStride int, output []byte, outputStride int) {
-	C.vDSP_vfix16((*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.short)(unsafe.Pointer(&output[0])), C.vDSP_Stride(outputStride), C.vDSP_Length(len(output)/2/outputStride))
+	C.vDSP_vfix16((*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.short)(unsafe.Pointer(&output[0])), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/2/outputStride))
 }
 
 // Vadd adds two vectors.
@@ -254,7 +239,7 @@ func Zvabs(input DSPSplitComplex, inputStride int, output []float32, outputStrid
 	var in C.DSPSplitComplex
 	in.realp = (*C.float)(&input.Real[0])
 	in.imagp = (*C.float)(&input.Imag[0])
-	C.vDSP_zvabs(&in, C.vDSP_Stride(inputStride), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), C.vDSP_Length(len(output)/outputStride))
+	C.vDSP_zvabs(&in, C.vDSP_Stride(inputStride), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input.Real)/inputStride, len(output)/outputStride))
 }
 
 // Complex vector absolute values; double precision.
@@ -262,7 +247,7 @@ func ZvabsD(input DSPDoubleSplitComplex, inputStride int, output []float64, outp
 	var in C.DSPDoubleSplitComplex
 	in.realp = (*C.double)(&input.Real[0])
 	in.imagp = (*C.double)(&input.Imag[0])
-	C.vDSP_zvabsD(&in, C.vDSP_Stride(inputStride), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), C.vDSP_Length(len(output)/outputStride))
+	C.vDSP_zvabsD(&in, C.vDSP_Stride(inputStride), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input.Real)/inputStride, len(output)/outputStride))
 }
 
 // Vector maximum value; single precision.
@@ -288,38 +273,42 @@ func Sve(input []float32, inputStride int) float32 {
 
 // Vector linear average; single precision.
 func Vavlin(input []float32, inputStride int, count float32, output []float32, outputStride int) {
-	C.vDSP_vavlin((*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.float)(&count), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), C.vDSP_Length(len(output)/outputStride))
+	C.vDSP_vavlin((*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.float)(&count), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
 }
 
 // Multiplies vector A by vector B and leaves the result in vector C; single precision.
 func Vmul(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
-	C.vDSP_vmul((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), C.vDSP_Length(len(output)/outputStride))
+	C.vDSP_vmul((*C.float)(&input1[0]), C.vDSP_Stride(stride1), (*C.float)(&input2[0]), C.vDSP_Stride(stride2), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
 }
 
 // Vector scalar add; single precision.
 func Vsadd(input []float32, inputStride int, add float32, output []float32, outputStride int) {
-	C.vDSP_vsadd((*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.float)(&add), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), C.vDSP_Length(len(output)/outputStride))
+	C.vDSP_vsadd((*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.float)(&add), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
 }
 
 // Vector scalar divide; single precision.
 func Vsdiv(input []float32, inputStride int, divisor float32, output []float32, outputStride int) {
-	C.vDSP_vsdiv((*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.float)(&divisor), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), C.vDSP_Length(len(output)/outputStride))
+	C.vDSP_vsdiv((*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.float)(&divisor), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
 }
 
 // Vector negative values; single precision.
 func Vneg(input []float32, inputStride int, output []float32, outputStride int) {
-	C.vDSP_vneg((*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), C.vDSP_Length(len(output)/outputStride))
+	C.vDSP_vneg((*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
 }
 
 // Vector sliding window sum; single precision.
 func Vswsum(input []float32, inputStride int, output []float32, outputStride, windowLen int) {
-	C.vDSP_vswsum((*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), C.vDSP_Length(len(output)/outputStride), C.vDSP_Length(windowLen))
+	n := minLenGeneric(len(input)/inputStride-windowLen+1, len(output)/outputStride)
+	if n <= 0 {
+		return
+	}
+	C.vDSP_vswsum((*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), C.vDSP_Length(n), C.vDSP_Length(windowLen))
 }
 
 // Vector convert power or amplitude to decibels; single precision.
 // α * log10(input(n)/zeroReference) [α is 20 if Amplitude (flag=1), or 10 if F is Power (flag=0)]
 func Vdbcon(input []float32, inputStride int, zeroReference float32, output []float32, outputStride int, flag DBFlag) {
-	C.vDSP_vdbcon((*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.float)(&zeroReference), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), C.vDSP_Length(len(output)/outputStride), C.uint(flag))
+	C.vDSP_vdbcon((*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.float)(&zeroReference), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride), C.uint(flag))
 }
 
 // Meanv returns the mean of the input vector.
@@ -343,3 +332,68 @@ func HammWindow(output []float32, flag WindowFlag) {
 func BlkmanWindow(output []float32, flag WindowFlag) {
 	C.vDSP_blkman_window((*C.float)(&output[0]), C.vDSP_Length(len(output)), C.int(flag))
 }
+
+// Conv performs correlation of the input with the filter, or convolution
+// when filterStride is negative (the filter is then read backwards from
+// its last element).
+func Conv(input []float32, inputStride int, filter []float32, filterStride int, output []float32, outputStride int) {
+	n, p := convLen(len(input), inputStride, len(filter), filterStride, len(output), outputStride)
+	if n <= 0 || p <= 0 {
+		return
+	}
+	C.vDSP_conv((*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.float)(&filter[convFilterStart(p, filterStride)]), C.vDSP_Stride(filterStride), (*C.float)(&output[0]), C.vDSP_Stride(outputStride), C.vDSP_Length(n), C.vDSP_Length(p))
+}
+
+// Zconv performs complex correlation of the input with the filter, or
+// convolution when filterStride is negative.
+func Zconv(input DSPSplitComplex, inputStride int, filter DSPSplitComplex, filterStride int, output DSPSplitComplex, outputStride int) {
+	n, p := convLen(len(input.Real), inputStride, len(filter.Real), filterStride, len(output.Real), outputStride)
+	if n <= 0 || p <= 0 {
+		return
+	}
+	f0 := convFilterStart(p, filterStride)
+	var srcC C.DSPSplitComplex
+	srcC.realp = (*C.float)(&input.Real[0])
+	srcC.imagp = (*C.float)(&input.Imag[0])
+	var filterC C.DSPSplitComplex
+	filterC.realp = (*C.float)(&filter.Real[f0])
+	filterC.imagp = (*C.float)(&filter.Imag[f0])
+	var dstC C.DSPSplitComplex
+	dstC.realp = (*C.float)(&output.Real[0])
+	dstC.imagp = (*C.float)(&output.Imag[0])
+	C.vDSP_zconv(&srcC, C.vDSP_Stride(inputStride), &filterC, C.vDSP_Stride(filterStride), &dstC, C.vDSP_Stride(outputStride), C.vDSP_Length(n), C.vDSP_Length(p))
+}
+
+// Zvmul multiplies complex vectors, conjugating input1 first when
+// conjugate is true.
+func Zvmul(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex, resultStride int, conjugate bool) {
+	var in1 C.DSPSplitComplex
+	in1.realp = (*C.float)(&input1.Real[0])
+	in1.imagp = (*C.float)(&input1.Imag[0])
+	var in2 C.DSPSplitComplex
+	in2.realp = (*C.float)(&input2.Real[0])
+	in2.imagp = (*C.float)(&input2.Imag[0])
+	var res C.DSPSplitComplex
+	res.realp = (*C.float)(&result.Real[0])
+	res.imagp = (*C.float)(&result.Imag[0])
+	C.vDSP_zvmul(&in1, C.vDSP_Stride(stride1), &in2, C.vDSP_Stride(stride2), &res, C.vDSP_Stride(resultStride), minLen(len(input1.Real)/stride1, len(input2.Real)/stride2, len(result.Real)/resultStride), zvmulConjugate(conjugate))
+}
+
+// Zvconj conjugates a complex vector.
+func Zvconj(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int) {
+	var in C.DSPSplitComplex
+	in.realp = (*C.float)(&input.Real[0])
+	in.imagp = (*C.float)(&input.Imag[0])
+	var out C.DSPSplitComplex
+	out.realp = (*C.float)(&output.Real[0])
+	out.imagp = (*C.float)(&output.Imag[0])
+	C.vDSP_zvconj(&in, C.vDSP_Stride(inputStride), &out, C.vDSP_Stride(outputStride), minLen(len(input.Real)/inputStride, len(output.Real)/outputStride))
+}
+
+// zvmulConjugate returns the Conjugate argument of vDSP_zvmul.
+func zvmulConjugate(conjugate bool) C.int {
+	if conjugate {
+		return -1
+	}
+	return 1
+}
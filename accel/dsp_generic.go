@@ -0,0 +1,563 @@
+package accel
+
+import (
+	"math"
+	"unsafe"
+)
+
+// Portable implementations of the vDSP routines. They follow the same
+// element counts, stride and scaling conventions as the Accelerate
+// wrappers in dsp.go so that results can be used interchangeably.
+
+// floating is the set of element types with both single and double
+// precision vDSP routines.
+type floating interface {
+	float32 | float64
+}
+
+func minLenGeneric(size ...int) int {
+	min := size[0]
+	for i := 1; i < len(size); i++ {
+		if size[i] < min {
+			min = size[i]
+		}
+	}
+	return min
+}
+
+func vflt8Generic[T floating](input []int8, inputStride int, output []T, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = T(input[i*inputStride])
+	}
+}
+
+func vflt8ByteGeneric(input []byte, inputStride int, output []float32, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = float32(int8(input[i*inputStride]))
+	}
+}
+
+func vfltu8Generic[T floating](input []byte, inputStride int, output []T, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = T(input[i*inputStride])
+	}
+}
+
+func vflt16Generic[T floating](input []int16, inputStride int, output []T, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = T(input[i*inputStride])
+	}
+}
+
+func vflt16ByteGeneric(input []byte, inputStride int, output []float32, outputStride int) {
+	vflt16Generic(bytesAsInt16(input), inputStride, output, outputStride)
+}
+
+func vflt32Generic[T floating](input []int32, inputStride int, output []T, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = T(input[i*inputStride])
+	}
+}
+
+func vflt32ByteGeneric(input []byte, inputStride int, output []float32, outputStride int) {
+	vflt32Generic(bytesAsInt32(input), inputStride, output, outputStride)
+}
+
+func vdpspGeneric(input []float64, inputStride int, output []float32, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = float32(input[i*inputStride])
+	}
+}
+
+func vdpspByteGeneric(input []byte, inputStride int, output []float32, outputStride int) {
+	vdpspGeneric(bytesAsFloat64(input), inputStride, output, outputStride)
+}
+
+// ctozGeneric implements vDSP_ctoz. The interleaved stride is counted in
+// float32 units (2 per complex value) like vDSP.
+func ctozGeneric(input []complex64, inputStride int, output DSPSplitComplex, outputStride int) {
+	n := len(output.Real) / outputStride
+	if n2 := 2 * len(input) / inputStride; n2 < n {
+		n = n2
+	}
+	ctozN(complex64AsFloat32(input), inputStride, output, outputStride, n)
+}
+
+func ctozFloatGeneric(input []float32, inputStride int, output DSPSplitComplex, outputStride int) {
+	n := len(output.Real) / outputStride
+	if n2 := len(input) / inputStride; n2 < n {
+		n = n2
+	}
+	ctozN(input, inputStride, output, outputStride, n)
+}
+
+func ctozByteGeneric(input []byte, inputStride int, output DSPSplitComplex, outputStride int) {
+	n := len(output.Real) / outputStride
+	if n2 := len(input) / (4 * inputStride); n2 < n {
+		n = n2
+	}
+	ctozN(bytesAsFloat32(input), inputStride, output, outputStride, n)
+}
+
+func ctozN(input []float32, inputStride int, output DSPSplitComplex, outputStride int, n int) {
+	ctozSplit(input, inputStride, output.Real, output.Imag, outputStride, n)
+}
+
+func ctozSplit[T floating](input []T, inputStride int, re, im []T, outputStride int, n int) {
+	for i := 0; i < n; i++ {
+		re[i*outputStride] = input[i*inputStride]
+		im[i*outputStride] = input[i*inputStride+1]
+	}
+}
+
+func ztocGeneric(input DSPSplitComplex, inputStride int, output []complex64, outputStride int) {
+	ztocN(input, inputStride, complex64AsFloat32(output), outputStride, minLenGeneric(len(input.Real)/inputStride, 2*len(output)/outputStride))
+}
+
+func ztocFloatGeneric(input DSPSplitComplex, inputStride int, output []float32, outputStride int) {
+	ztocN(input, inputStride, output, outputStride, minLenGeneric(len(input.Real)/inputStride, len(output)/outputStride))
+}
+
+func ztocByteGeneric(input DSPSplitComplex, inputStride int, output []byte, outputStride int) {
+	ztocN(input, inputStride, bytesAsFloat32(output), outputStride, minLenGeneric(len(input.Real)/inputStride, len(output)/4/outputStride))
+}
+
+func ztocN(input DSPSplitComplex, inputStride int, output []float32, outputStride int, n int) {
+	ztocSplit(input.Real, input.Imag, inputStride, output, outputStride, n)
+}
+
+func ztocSplit[T floating](re, im []T, inputStride int, output []T, outputStride int, n int) {
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = re[i*inputStride]
+		output[i*outputStride+1] = im[i*inputStride]
+	}
+}
+
+func vclrGeneric[T floating](vec []T, stride int) {
+	n := len(vec) / stride
+	for i := 0; i < n; i++ {
+		vec[i*stride] = 0
+	}
+}
+
+func vfillGeneric[T floating](value T, output []T, stride int) {
+	n := len(output) / stride
+	for i := 0; i < n; i++ {
+		output[i*stride] = value
+	}
+}
+
+func vclipGeneric[T floating](input []T, inputStride int, low, high T, output []T, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		v := input[i*inputStride]
+		if v < low {
+			v = low
+		} else if v > high {
+			v = high
+		}
+		output[i*outputStride] = v
+	}
+}
+
+func vthrGeneric[T floating](input []T, inputStride int, low T, output []T, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		v := input[i*inputStride]
+		if v < low {
+			v = low
+		}
+		output[i*outputStride] = v
+	}
+}
+
+// desampLen returns the number of outputs of a decimating filter that can
+// be computed without reading past the end of the input.
+func desampLen(inputLen, factor, coeffLen, outputLen int) int {
+	if inputLen < coeffLen {
+		return 0
+	}
+	return minLenGeneric((inputLen-coeffLen)/factor+1, outputLen)
+}
+
+func desampGeneric[T floating](input []T, desamplingFactor int, coeff []T, output []T) {
+	for n, count := 0, desampLen(len(input), desamplingFactor, len(coeff), len(output)); n < count; n++ {
+		in := input[n*desamplingFactor:]
+		var sum T
+		for p, c := range coeff {
+			sum += in[p] * c
+		}
+		output[n] = sum
+	}
+}
+
+func zrdesampGeneric(input DSPSplitComplex, decimationFactor int, coefficients []float32, output DSPSplitComplex) {
+	zrdesampSplit(input.Real, input.Imag, decimationFactor, coefficients, output.Real, output.Imag)
+}
+
+func zrdesampSplit[T floating](inRe, inIm []T, decimationFactor int, coefficients []T, outRe, outIm []T) {
+	for n, count := 0, desampLen(len(inRe), decimationFactor, len(coefficients), len(outRe)); n < count; n++ {
+		re := inRe[n*decimationFactor:]
+		im := inIm[n*decimationFactor:]
+		var sumRe, sumIm T
+		for p, c := range coefficients {
+			sumRe += re[p] * c
+			sumIm += im[p] * c
+		}
+		outRe[n] = sumRe
+		outIm[n] = sumIm
+	}
+}
+
+func zvphasGeneric(input DSPSplitComplex, inputStride int, output []float32, outputStride int) {
+	zvphasSplit(input.Real, input.Imag, inputStride, output, outputStride)
+}
+
+func zvphasSplit[T floating](re, im []T, inputStride int, output []T, outputStride int) {
+	n := minLenGeneric(len(re)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = T(math.Atan2(float64(im[i*inputStride]), float64(re[i*inputStride])))
+	}
+}
+
+func zidotprGeneric(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex) {
+	result.Real[0], result.Imag[0] = zidotprSplit(input1.Real, input1.Imag, stride1, input2.Real, input2.Imag, stride2)
+}
+
+func zidotprSplit[T floating](aRe, aIm []T, stride1 int, bRe, bIm []T, stride2 int) (T, T) {
+	var sumRe, sumIm T
+	n := minLenGeneric(len(aRe)/stride1, len(bRe)/stride2)
+	for i := 0; i < n; i++ {
+		ar, ai := aRe[i*stride1], aIm[i*stride1]
+		br, bi := bRe[i*stride2], bIm[i*stride2]
+		sumRe += ar*br + ai*bi
+		sumIm += ar*bi - ai*br
+	}
+	return sumRe, sumIm
+}
+
+func zvcmulGeneric(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex, resultStride int) {
+	zvcmulSplit(input1.Real, input1.Imag, stride1, input2.Real, input2.Imag, stride2, result.Real, result.Imag, resultStride)
+}
+
+func zvcmulSplit[T floating](aRe, aIm []T, stride1 int, bRe, bIm []T, stride2 int, outRe, outIm []T, resultStride int) {
+	n := minLenGeneric(len(aRe)/stride1, len(bRe)/stride2, len(outRe)/resultStride)
+	for i := 0; i < n; i++ {
+		ar, ai := aRe[i*stride1], aIm[i*stride1]
+		br, bi := bRe[i*stride2], bIm[i*stride2]
+		outRe[i*resultStride] = ar*br + ai*bi
+		outIm[i*resultStride] = ar*bi - ai*br
+	}
+}
+
+func vfix16Generic[T floating](input []T, inputStride int, output []int16, outputStride int) {
+	vfix16N(input, inputStride, output, outputStride, minLenGeneric(len(input)/inputStride, len(output)/outputStride))
+}
+
+func vfix16ByteGeneric(input []float32, inputStride int, output []byte, outputStride int) {
+	vfix16N(input, inputStride, bytesAsInt16(output), outputStride, minLenGeneric(len(input)/inputStride, len(output)/2/outputStride))
+}
+
+func vfix16N[T floating](input []T, inputStride int, output []int16, outputStride int, n int) {
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = int16(input[i*inputStride])
+	}
+}
+
+func vaddGeneric[T floating](input1 []T, input1Stride int, input2 []T, input2Stride int, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/input1Stride, len(input2)/input2Stride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = input1[i*input1Stride] + input2[i*input2Stride]
+	}
+}
+
+func vsmsaGeneric[T floating](input []T, inputStride int, mult, add T, output []T, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = input[i*inputStride]*mult + add
+	}
+}
+
+func vabsGeneric[T floating](input []T, inputStride int, output []T, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = T(math.Abs(float64(input[i*inputStride])))
+	}
+}
+
+func vsqGeneric[T floating](input []T, inputStride int, output []T, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		v := input[i*inputStride]
+		output[i*outputStride] = v * v
+	}
+}
+
+func zvabsGeneric(input DSPSplitComplex, inputStride int, output []float32, outputStride int) {
+	n := minLenGeneric(len(input.Real)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = float32(math.Hypot(float64(input.Real[i*inputStride]), float64(input.Imag[i*inputStride])))
+	}
+}
+
+func zvabsDGeneric(input DSPDoubleSplitComplex, inputStride int, output []float64, outputStride int) {
+	n := minLenGeneric(len(input.Real)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = math.Hypot(input.Real[i*inputStride], input.Imag[i*inputStride])
+	}
+}
+
+func maxvGeneric[T floating](input []T, stride int) T {
+	max := T(math.Inf(-1))
+	n := len(input) / stride
+	for i := 0; i < n; i++ {
+		if v := input[i*stride]; v > max {
+			max = v
+		}
+	}
+	return max
+}
+
+func minvGeneric[T floating](input []T, stride int) T {
+	min := T(math.Inf(1))
+	n := len(input) / stride
+	for i := 0; i < n; i++ {
+		if v := input[i*stride]; v < min {
+			min = v
+		}
+	}
+	return min
+}
+
+func sveGeneric[T floating](input []T, inputStride int) T {
+	var sum T
+	n := len(input) / inputStride
+	for i := 0; i < n; i++ {
+		sum += input[i*inputStride]
+	}
+	return sum
+}
+
+func vavlinGeneric[T floating](input []T, inputStride int, count T, output []T, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = (output[i*outputStride]*count + input[i*inputStride]) / (count + 1)
+	}
+}
+
+func vmulGeneric[T floating](input1 []T, stride1 int, input2 []T, stride2 int, output []T, outputStride int) {
+	n := minLenGeneric(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = input1[i*stride1] * input2[i*stride2]
+	}
+}
+
+func vsaddGeneric[T floating](input []T, inputStride int, add T, output []T, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = input[i*inputStride] + add
+	}
+}
+
+func vsdivGeneric[T floating](input []T, inputStride int, divisor T, output []T, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = input[i*inputStride] / divisor
+	}
+}
+
+func vnegGeneric[T floating](input []T, inputStride int, output []T, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = -input[i*inputStride]
+	}
+}
+
+func vswsumGeneric[T floating](input []T, inputStride int, output []T, outputStride, windowLen int) {
+	n := minLenGeneric(len(input)/inputStride-windowLen+1, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		var sum T
+		for p := 0; p < windowLen; p++ {
+			sum += input[(i+p)*inputStride]
+		}
+		output[i*outputStride] = sum
+	}
+}
+
+func vdbconGeneric[T floating](input []T, inputStride int, zeroReference T, output []T, outputStride int, flag DBFlag) {
+	alpha := 10.0
+	if flag == DBFlagAmplitude {
+		alpha = 20.0
+	}
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = T(alpha * math.Log10(float64(input[i*inputStride]/zeroReference)))
+	}
+}
+
+func meanvGeneric[T floating](input []T, stride int) T {
+	n := len(input) / stride
+	if n == 0 {
+		return 0
+	}
+	return sveGeneric(input, stride) / T(n)
+}
+
+// windowLen returns the number of points to generate for a window of
+// length n taking WindowFlagHalfWindow into account.
+func windowLen(n int, flag WindowFlag) int {
+	if flag&WindowFlagHalfWindow != 0 {
+		return (n + 1) / 2
+	}
+	return n
+}
+
+func hannWindowGeneric[T floating](output []T, flag WindowFlag) {
+	n := float64(len(output))
+	w := 1.0
+	if flag&WindowFlagHannNorm != 0 {
+		w = 0.8165
+	}
+	for i := 0; i < windowLen(len(output), flag); i++ {
+		output[i] = T(w * 0.5 * (1 - math.Cos(2*math.Pi*float64(i)/n)))
+	}
+}
+
+func hammWindowGeneric[T floating](output []T, flag WindowFlag) {
+	n := float64(len(output))
+	for i := 0; i < windowLen(len(output), flag); i++ {
+		output[i] = T(0.54 - 0.46*math.Cos(2*math.Pi*float64(i)/n))
+	}
+}
+
+func blkmanWindowGeneric[T floating](output []T, flag WindowFlag) {
+	n := float64(len(output))
+	for i := 0; i < windowLen(len(output), flag); i++ {
+		x := 2 * math.Pi * float64(i) / n
+		output[i] = T(0.42 - 0.5*math.Cos(x) + 0.08*math.Cos(2*x))
+	}
+}
+
+// The _byte variants reinterpret a byte buffer in native byte order the
+// same way the cgo wrappers do by passing the pointer through.
+
+func bytesAsInt16(b []byte) []int16 {
+	if len(b) < 2 {
+		return nil
+	}
+	return unsafe.Slice((*int16)(unsafe.Pointer(&b[0])), len(b)/2)
+}
+
+func bytesAsInt32(b []byte) []int32 {
+	if len(b) < 4 {
+		return nil
+	}
+	return unsafe.Slice((*int32)(unsafe.Pointer(&b[0])), len(b)/4)
+}
+
+func bytesAsFloat32(b []byte) []float32 {
+	if len(b) < 4 {
+		return nil
+	}
+	return unsafe.Slice((*float32)(unsafe.Pointer(&b[0])), len(b)/4)
+}
+
+func bytesAsFloat64(b []byte) []float64 {
+	if len(b) < 8 {
+		return nil
+	}
+	return unsafe.Slice((*float64)(unsafe.Pointer(&b[0])), len(b)/8)
+}
+
+func complex64AsFloat32(c []complex64) []float32 {
+	if len(c) == 0 {
+		return nil
+	}
+	return unsafe.Slice((*float32)(unsafe.Pointer(&c[0])), len(c)*2)
+}
+
+// convLen returns the number of outputs and filter taps of vDSP_conv. A
+// negative filterStride reverses the filter, turning the correlation into
+// a convolution.
+func convLen(inputLen, inputStride, filterLen, filterStride, outputLen, outputStride int) (n, p int) {
+	if filterStride < 0 {
+		filterStride = -filterStride
+	}
+	p = filterLen / filterStride
+	return minLenGeneric(inputLen/inputStride-p+1, outputLen/outputStride), p
+}
+
+// convFilterStart returns the index of the first filter element used by
+// vDSP_conv, which starts at the end of the filter for negative strides.
+func convFilterStart(p, filterStride int) int {
+	if filterStride < 0 {
+		return (p - 1) * -filterStride
+	}
+	return 0
+}
+
+func convGeneric[T floating](input []T, inputStride int, filter []T, filterStride int, output []T, outputStride int) {
+	n, p := convLen(len(input), inputStride, len(filter), filterStride, len(output), outputStride)
+	f0 := convFilterStart(p, filterStride)
+	for i := 0; i < n; i++ {
+		var sum T
+		for j := 0; j < p; j++ {
+			sum += input[(i+j)*inputStride] * filter[f0+j*filterStride]
+		}
+		output[i*outputStride] = sum
+	}
+}
+
+func zconvGeneric(input DSPSplitComplex, inputStride int, filter DSPSplitComplex, filterStride int, output DSPSplitComplex, outputStride int) {
+	zconvSplit(input.Real, input.Imag, inputStride, filter.Real, filter.Imag, filterStride, output.Real, output.Imag, outputStride)
+}
+
+func zconvSplit[T floating](inRe, inIm []T, inputStride int, fRe, fIm []T, filterStride int, outRe, outIm []T, outputStride int) {
+	n, p := convLen(len(inRe), inputStride, len(fRe), filterStride, len(outRe), outputStride)
+	f0 := convFilterStart(p, filterStride)
+	for i := 0; i < n; i++ {
+		var sumRe, sumIm T
+		for j := 0; j < p; j++ {
+			a, b := (i+j)*inputStride, f0+j*filterStride
+			sumRe += inRe[a]*fRe[b] - inIm[a]*fIm[b]
+			sumIm += inRe[a]*fIm[b] + inIm[a]*fRe[b]
+		}
+		outRe[i*outputStride] = sumRe
+		outIm[i*outputStride] = sumIm
+	}
+}
+
+func zvmulGeneric(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex, resultStride int, conjugate bool) {
+	zvmulSplit(input1.Real, input1.Imag, stride1, input2.Real, input2.Imag, stride2, result.Real, result.Imag, resultStride, conjugate)
+}
+
+func zvmulSplit[T floating](aRe, aIm []T, stride1 int, bRe, bIm []T, stride2 int, outRe, outIm []T, resultStride int, conjugate bool) {
+	n := minLenGeneric(len(aRe)/stride1, len(bRe)/stride2, len(outRe)/resultStride)
+	for i := 0; i < n; i++ {
+		ar, ai := aRe[i*stride1], aIm[i*stride1]
+		br, bi := bRe[i*stride2], bIm[i*stride2]
+		if conjugate {
+			ai = -ai
+		}
+		outRe[i*resultStride] = ar*br - ai*bi
+		outIm[i*resultStride] = ar*bi + ai*br
+	}
+}
+
+func zvconjGeneric(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int) {
+	zvconjSplit(input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride)
+}
+
+func zvconjSplit[T floating](inRe, inIm []T, inputStride int, outRe, outIm []T, outputStride int) {
+	n := minLenGeneric(len(inRe)/inputStride, len(outRe)/outputStride)
+	for i := 0; i < n; i++ {
+		outRe[i*outputStride] = inRe[i*inputStride]
+		outIm[i*outputStride] = -inIm[i*inputStride]
+	}
+}
@@ -0,0 +1,260 @@
+//go:build !darwin || !cgo || purego
+
+package accel
+
+// Vflt8 converts an array of signed 8-bit integers to single-precision floating-point values.
+func Vflt8(input []int8, inputStride int, output []float32, outputStride int) {
+	vflt8Generic(input, inputStride, output, outputStride)
+}
+
+// Vflt8_byte converts an array of signed 8-bit integers to single-precision floating-point values.
+func Vflt8_byte(input []byte, inputStride int, output []float32, outputStride int) {
+	vflt8ByteGeneric(input, inputStride, output, outputStride)
+}
+
+// Vfltu8 converts an array of unsigned 8-bit integers to single-precision floating-point values.
+func Vfltu8(input []byte, inputStride int, output []float32, outputStride int) {
+	vfltu8Generic(input, inputStride, output, outputStride)
+}
+
+// Vflt16 converts an array of signed 16-bit integers to single-precision floating-point values.
+func Vflt16(input []int16, inputStride int, output []float32, outputStride int) {
+	vflt16Generic(input, inputStride, output, outputStride)
+}
+
+// Vflt16_byte converts an array of signed 16-bit integers to single-precision floating-point values.
+func Vflt16_byte(input []byte, inputStride int, output []float32, outputStride int) {
+	vflt16ByteGeneric(input, inputStride, output, outputStride)
+}
+
+// Vflt32 converts an array of signed 32-bit integers to single-precision floating-point values.
+func Vflt32(input []int32, inputStride int, output []float32, outputStride int) {
+	vflt32Generic(input, inputStride, output, outputStride)
+}
+
+// Vflt32_byte converts an array of signed 16-bit integers to single-precision floating-point values.
+func Vflt32_byte(input []byte, inputStride int, output []float32, outputStride int) {
+	vflt32ByteGeneric(input, inputStride, output, outputStride)
+}
+
+// Vdpsp convert a double-precision vector to single-precision.
+func Vdpsp(input []float64, inputStride int, output []float32, outputStride int) {
+	vdpspGeneric(input, inputStride, output, outputStride)
+}
+
+// Vdpsp_byte converts a double-precision to single-precision.
+// Operate on a byte buffer which contains float64
+func Vdpsp_byte(input []byte, inputStride int, output []float32, outputStride int) {
+	vdpspByteGeneric(input, inputStride, output, outputStride)
+}
+
+// Ctoz copies the contents of an interleaved complex vector C to a split complex vector Z; single precision.
+func Ctoz(input []complex64, inputStride int, output DSPSplitComplex, outputStride int) {
+	ctozGeneric(input, inputStride, output, outputStride)
+}
+
+func Ctoz_float(input []float32, inputStride int, output DSPSplitComplex, outputStride int) {
+	ctozFloatGeneric(input, inputStride, output, outputStride)
+}
+
+// Ctoz_byte copies the contents of an interleaved complex vector C to a split complex vector Z; single precision. Operate on a byte buffer which contains complex64
+func Ctoz_byte(input []byte, inputStride int, output DSPSplitComplex, outputStride int) {
+	ctozByteGeneric(input, inputStride, output, outputStride)
+}
+
+// Ztoc copies the contents of a split complex vector Z to an interleaved complex vector C; single precision.
+func Ztoc(input DSPSplitComplex, inputStride int, output []complex64, outputStride int) {
+	ztocGeneric(input, inputStride, output, outputStride)
+}
+
+// Ztoc_float copies the contents of a split complex vector Z to an interleaved complex vector C; single precision.
+func Ztoc_float(input DSPSplitComplex, inputStride int, output []float32, outputStride int) {
+	ztocFloatGeneric(input, inputStride, output, outputStride)
+}
+
+// Ztoc_byte copies the contents of a split complex vector Z to an interleaved complex vector C; single precision. Operate on a byte buffer which contains complex64
+func Ztoc_byte(input DSPSplitComplex, inputStride int, output []byte, outputStride int) {
+	ztocByteGeneric(input, inputStride, output, outputStride)
+}
+
+// Vclr clears the provided vector
+func Vclr(vec []float32, stride int) {
+	vclrGeneric(vec, stride)
+}
+
+// Vfill fills the output vector with the provided value.
+func Vfill(value float32, output []float32, stride int) {
+	vfillGeneric(value, output, stride)
+}
+
+// Vclip clips the input vector using the given low and high and writes
+// the result to the output vector.
+func Vclip(input []float32, inputStride int, low, high float32, output []float32, outputStride int) {
+	vclipGeneric(input, inputStride, low, high, output, outputStride)
+}
+
+// Vthr thresholds the input vector writing the the result ot the output vector.
+func Vthr(input []float32, inputStride int, low float32, output []float32, outputStride int) {
+	vthrGeneric(input, inputStride, low, output, outputStride)
+}
+
+// Desamp performs convolution with decimation.
+func Desamp(input []float32, desamplingFactor int, coeff []float32, output []float32) {
+	desampGeneric(input, desamplingFactor, coeff, output)
+}
+
+// Zrdesamp performs a complex-real downsample with anti-aliasing.
+func Zrdesamp(input DSPSplitComplex, decimationFactor int, coefficients []float32, output DSPSplitComplex) {
+	zrdesampGeneric(input, decimationFactor, coefficients, output)
+}
+
+// Zvphas calculates the complex vector phase.
+func Zvphas(input DSPSplitComplex, inputStride int, output []float32, outputStride int) {
+	zvphasGeneric(input, inputStride, output, outputStride)
+}
+
+// Zidotpr calculates the conjugate dot product (or inner dot product) of complex vectors A and B and leave the result in complex vector C; single precision.
+func Zidotpr(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex) {
+	zidotprGeneric(input1, stride1, input2, stride2, result)
+}
+
+// Zvcmul performs a complex vector conjugate and multiply.
+func Zvcmul(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex, resultStride int) {
+	zvcmulGeneric(input1, stride1, input2, stride2, result, resultStride)
+}
+
+// Vfix16 converts an array of single-precision floating-point values to signed 16-bit integer values, rounding towards zero.
+func Vfix16(input []float32, inputStride int, output []int16, outputStride int) {
+	vfix16Generic(input, inputStride, output, outputStride)
+}
+
+// Vfix16_byte converts an array of single-precision floating-point values to signed 16-bit integer values, rounding towards zero. Output to a byte stream.
+func Vfix16_byte(input []float32, inputStride int, output []byte, outputStride int) {
+	vfix16ByteGeneric(input, inputStride, output, outputStride)
+}
+
+// Vadd adds two vectors.
+func Vadd(input1 []float32, input1Stride int, input2 []float32, input2Stride int, output []float32, outputStride int) {
+	vaddGeneric(input1, input1Stride, input2, input2Stride, output, outputStride)
+}
+
+// Vsmsa is vector scalar multiply and scalar add; single precision.
+// output[n] = input[n] * mult + add
+func Vsmsa(input []float32, inputStride int, mult, add float32, output []float32, outputStride int) {
+	vsmsaGeneric(input, inputStride, mult, add, output, outputStride)
+}
+
+// Vabs calcultes the absolute value of every value in the provided vector.
+func Vabs(input []float32, inputStride int, output []float32, outputStride int) {
+	vabsGeneric(input, inputStride, output, outputStride)
+}
+
+// Computes the squared values of vector input and leaves the result in vector result; single precision.
+func Vsq(input []float32, inputStride int, output []float32, outputStride int) {
+	vsqGeneric(input, inputStride, output, outputStride)
+}
+
+// Zvabs calculates the absolute values of all values in the complex input.
+func Zvabs(input DSPSplitComplex, inputStride int, output []float32, outputStride int) {
+	zvabsGeneric(input, inputStride, output, outputStride)
+}
+
+// Complex vector absolute values; double precision.
+func ZvabsD(input DSPDoubleSplitComplex, inputStride int, output []float64, outputStride int) {
+	zvabsDGeneric(input, inputStride, output, outputStride)
+}
+
+// Vector maximum value; single precision.
+func Maxv(input []float32, stride int) float32 {
+	return maxvGeneric(input, stride)
+}
+
+// Vector minimum value; single precision.
+func Minv(input []float32, stride int) float32 {
+	return minvGeneric(input, stride)
+}
+
+// Vector sum; single precision.
+func Sve(input []float32, inputStride int) float32 {
+	return sveGeneric(input, inputStride)
+}
+
+// Vector linear average; single precision.
+func Vavlin(input []float32, inputStride int, count float32, output []float32, outputStride int) {
+	vavlinGeneric(input, inputStride, count, output, outputStride)
+}
+
+// Multiplies vector A by vector B and leaves the result in vector C; single precision.
+func Vmul(input1 []float32, stride1 int, input2 []float32, stride2 int, output []float32, outputStride int) {
+	vmulGeneric(input1, stride1, input2, stride2, output, outputStride)
+}
+
+// Vector scalar add; single precision.
+func Vsadd(input []float32, inputStride int, add float32, output []float32, outputStride int) {
+	vsaddGeneric(input, inputStride, add, output, outputStride)
+}
+
+// Vector scalar divide; single precision.
+func Vsdiv(input []float32, inputStride int, divisor float32, output []float32, outputStride int) {
+	vsdivGeneric(input, inputStride, divisor, output, outputStride)
+}
+
+// Vector negative values; single precision.
+func Vneg(input []float32, inputStride int, output []float32, outputStride int) {
+	vnegGeneric(input, inputStride, output, outputStride)
+}
+
+// Vector sliding window sum; single precision.
+func Vswsum(input []float32, inputStride int, output []float32, outputStride, windowLen int) {
+	vswsumGeneric(input, inputStride, output, outputStride, windowLen)
+}
+
+// Vector convert power or amplitude to decibels; single precision.
+// α * log10(input(n)/zeroReference) [α is 20 if Amplitude (flag=1), or 10 if F is Power (flag=0)]
+func Vdbcon(input []float32, inputStride int, zeroReference float32, output []float32, outputStride int, flag DBFlag) {
+	vdbconGeneric(input, inputStride, zeroReference, output, outputStride, flag)
+}
+
+// Meanv returns the mean of the input vector.
+func Meanv(input []float32, stride int) float32 {
+	return meanvGeneric(input, stride)
+}
+
+// HannWindow creates a single-precision Hanning window.
+func HannWindow(output []float32, flag WindowFlag) {
+	hannWindowGeneric(output, flag)
+}
+
+// HammWindow creates a single-precision Hamming window.
+func HammWindow(output []float32, flag WindowFlag) {
+	hammWindowGeneric(output, flag)
+}
+
+// BlkmanWindow creates a single-precision Blackman window.
+func BlkmanWindow(output []float32, flag WindowFlag) {
+	blkmanWindowGeneric(output, flag)
+}
+
+// Conv performs correlation of the input with the filter, or convolution
+// when filterStride is negative (the filter is then read backwards from
+// its last element).
+func Conv(input []float32, inputStride int, filter []float32, filterStride int, output []float32, outputStride int) {
+	convGeneric(input, inputStride, filter, filterStride, output, outputStride)
+}
+
+// Zconv performs complex correlation of the input with the filter, or
+// convolution when filterStride is negative.
+func Zconv(input DSPSplitComplex, inputStride int, filter DSPSplitComplex, filterStride int, output DSPSplitComplex, outputStride int) {
+	zconvGeneric(input, inputStride, filter, filterStride, output, outputStride)
+}
+
+// Zvmul multiplies complex vectors, conjugating input1 first when
+// conjugate is true.
+func Zvmul(input1 DSPSplitComplex, stride1 int, input2 DSPSplitComplex, stride2 int, result DSPSplitComplex, resultStride int, conjugate bool) {
+	zvmulGeneric(input1, stride1, input2, stride2, result, resultStride, conjugate)
+}
+
+// Zvconj conjugates a complex vector.
+func Zvconj(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int) {
+	zvconjGeneric(input, inputStride, output, outputStride)
+}
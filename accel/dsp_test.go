@@ -127,6 +127,129 @@ func TestZtoc_float(t *testing.T) {
 	}
 }
 
+// withTail returns a slice of n elements whose backing array holds tail
+// beyond them, so a call that reads past the length picks up tail and a
+// call that writes past it overwrites tail.
+func withTail(n int, tail float32) []float32 {
+	s := make([]float32, 2*n)
+	for i := n; i < len(s); i++ {
+		s[i] = tail
+	}
+	return s[:n]
+}
+
+// The unchecked functions take their element count from every operand, not
+// from the output alone, and never touch memory past any of them.
+func TestLengthsBoundedByEveryOperand(t *testing.T) {
+	const n = 4
+	nan := float32(math.NaN())
+	in := func() []float32 {
+		s := withTail(n, 99)
+		for i := range s {
+			s[i] = float32(i + 1)
+		}
+		return s
+	}
+	inSplit := func() DSPSplitComplex { return DSPSplitComplex{Real: in(), Imag: in()} }
+	untouched := func(name string, s []float32, from int) {
+		t.Helper()
+		for i := from; i < len(s); i++ {
+			if !math.IsNaN(float64(s[i])) {
+				t.Errorf("%s wrote element %d, past what its operands hold", name, i)
+				return
+			}
+		}
+	}
+
+	// Outputs twice as long as the inputs: only n elements may be written.
+	for _, c := range []struct {
+		name    string
+		fn      func(out []float32)
+		written int
+	}{
+		{"Vmul", func(o []float32) { Vmul(in(), 1, in(), 1, o, 1) }, n},
+		{"Vsadd", func(o []float32) { Vsadd(in(), 1, 1, o, 1) }, n},
+		{"Vsdiv", func(o []float32) { Vsdiv(in(), 1, 2, o, 1) }, n},
+		{"Vneg", func(o []float32) { Vneg(in(), 1, o, 1) }, n},
+		{"Vavlin", func(o []float32) { Vavlin(in(), 1, 1, o, 1) }, n},
+		{"Vdbcon", func(o []float32) { Vdbcon(in(), 1, 1, o, 1, DBFlagPower) }, n},
+		{"Vswsum", func(o []float32) { Vswsum(in(), 1, o, 1, 2) }, n - 1},
+		{"Zvphas", func(o []float32) { Zvphas(inSplit(), 1, o, 1) }, n},
+		{"Zvabs", func(o []float32) { Zvabs(inSplit(), 1, o, 1) }, n},
+		{"Ztoc_float", func(o []float32) { Ztoc_float(inSplit(), 1, o, 2) }, 2 * n},
+		{"Desamp", func(o []float32) { Desamp(in(), 2, []float32{1, 1}, o) }, (n-2)/2 + 1},
+		{"Zrdesamp", func(o []float32) {
+			Zrdesamp(inSplit(), 2, []float32{1, 1}, DSPSplitComplex{Real: o, Imag: make([]float32, len(o))})
+		}, (n-2)/2 + 1},
+	} {
+		out := make([]float32, 4*n)
+		for i := range out {
+			out[i] = nan
+		}
+		c.fn(out)
+		untouched(c.name, out, c.written)
+	}
+
+	complexOut := make([]complex64, 2*n)
+	for i := range complexOut {
+		complexOut[i] = complex(nan, nan)
+	}
+	Ztoc(inSplit(), 1, complexOut, 2)
+	untouched("Ztoc", complex64AsFloat32(complexOut), 2*n)
+
+	byteOut := make([]byte, 16*n)
+	Ztoc_byte(inSplit(), 1, byteOut, 2)
+	for i, b := range byteOut[8*n:] {
+		if b != 0 {
+			t.Errorf("Ztoc_byte wrote byte %d, past what its input holds", 8*n+i)
+			break
+		}
+	}
+	byteOut = make([]byte, 4*n)
+	Vfix16_byte(in(), 1, byteOut, 1)
+	for i, b := range byteOut[2*n:] {
+		if b != 0 {
+			t.Errorf("Vfix16_byte wrote byte %d, past what its input holds", 2*n+i)
+			break
+		}
+	}
+
+	// Split outputs shorter than the interleaved input: Ctoz converts one
+	// complex value per output element.
+	interleaved := make([]complex64, 2*n)
+	for i := range interleaved {
+		interleaved[i] = complex(float32(i), -float32(i))
+	}
+	for _, c := range []struct {
+		name string
+		fn   func(out DSPSplitComplex)
+	}{
+		{"Ctoz", func(o DSPSplitComplex) { Ctoz(interleaved, 2, o, 1) }},
+		{"Ctoz_float", func(o DSPSplitComplex) { Ctoz_float(complex64AsFloat32(interleaved), 2, o, 1) }},
+		{"Ctoz_byte", func(o DSPSplitComplex) {
+			Ctoz_byte(unsafe.Slice((*byte)(unsafe.Pointer(&interleaved[0])), 8*len(interleaved)), 2, o, 1)
+		}},
+	} {
+		out := DSPSplitComplex{Real: withTail(n, nan), Imag: withTail(n, nan)}
+		c.fn(out)
+		for i := 0; i < n; i++ {
+			if out.Real[i] != float32(i) || out.Imag[i] != -float32(i) {
+				t.Errorf("%s[%d] = (%f,%f); want (%d,%d)", c.name, i, out.Real[i], out.Imag[i], i, -i)
+			}
+		}
+		untouched(c.name+" real", out.Real[:2*n], n)
+		untouched(c.name+" imag", out.Imag[:2*n], n)
+	}
+
+	// Zidotpr sums over the inputs; the result holds a single value.
+	result := DSPSplitComplex{Real: make([]float32, 1), Imag: make([]float32, 1)}
+	Zidotpr(inSplit(), 1, inSplit(), 1, result)
+	// Σ conj(a)·a = Σ 2·i² for a = i+ii, i = 1..n.
+	if want := float32(2 * (1 + 4 + 9 + 16)); result.Real[0] != want || result.Imag[0] != 0 {
+		t.Errorf("Zidotpr = (%f,%f); want (%f,0)", result.Real[0], result.Imag[0], want)
+	}
+}
+
 // func lowPassReal(samples DSPSplitComplex, fast, slow int) DSPSplitComplex {
 // 	i2 := 0
 // 	var nowLPR complex64 = complex(0, 0)
@@ -173,6 +296,38 @@ func TestVsdiv(t *testing.T) {
 	}
 }
 
+func TestZvmul(t *testing.T) {
+	a := []complex64{1 + 2i, -3 + 0.5i, 2 - 1i}
+	b := []complex64{0.5 - 1i, 2 + 2i, -1 - 3i}
+	split := func(c []complex64) DSPSplitComplex {
+		s := makeSplit(len(c))
+		Ctoz(c, 2, s, 1)
+		return s
+	}
+	as, bs := split(a), split(b)
+	for _, conjugate := range []bool{false, true} {
+		out := makeSplit(len(a))
+		Zvmul(as, 1, bs, 1, out, 1, conjugate)
+		for i := range a {
+			want := a[i] * b[i]
+			if conjugate {
+				want = conj64(a[i]) * b[i]
+			}
+			if !almostEqual32(out.Real[i], real(want), maxFloatDiffErr) || !almostEqual32(out.Imag[i], imag(want), maxFloatDiffErr) {
+				t.Errorf("Zvmul(conjugate %v)[%d] = %f%+fi; want %v", conjugate, i, out.Real[i], out.Imag[i], want)
+			}
+		}
+	}
+
+	// In place, conjugating.
+	Zvconj(as, 1, as, 1)
+	for i, c := range a {
+		if as.Real[i] != real(c) || as.Imag[i] != -imag(c) {
+			t.Errorf("Zvconj[%d] = %f%+fi; want %v", i, as.Real[i], as.Imag[i], conj64(c))
+		}
+	}
+}
+
 func BenchmarkZvabs(b *testing.B) {
 	temp := make([]float32, 64*1024)
 	samples := DSPSplitComplex{
@@ -0,0 +1,291 @@
+//go:build darwin && cgo && !purego
+
+package accel
+
+// #include <Accelerate/Accelerate.h>
+import "C"
+
+import "unsafe"
+
+// VclipD is the double-precision version of Vclip.
+func VclipD(input []float64, inputStride int, low, high float64, output []float64, outputStride int) {
+	C.vDSP_vclipD((*C.double)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&low), (*C.double)(&high), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// VthrD is the double-precision version of Vthr.
+func VthrD(input []float64, inputStride int, low float64, output []float64, outputStride int) {
+	C.vDSP_vthrD((*C.double)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&low), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// VaddD adds two double-precision vectors.
+func VaddD(input1 []float64, input1Stride int, input2 []float64, input2Stride int, output []float64, outputStride int) {
+	C.vDSP_vaddD((*C.double)(&input1[0]), C.vDSP_Stride(input1Stride), (*C.double)(&input2[0]), C.vDSP_Stride(input2Stride), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/input1Stride, len(input2)/input2Stride, len(output)/outputStride))
+}
+
+// VsmsaD is vector scalar multiply and scalar add; double precision.
+// output[n] = input[n] * mult + add
+func VsmsaD(input []float64, inputStride int, mult, add float64, output []float64, outputStride int) {
+	C.vDSP_vsmsaD((*C.double)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&mult), (*C.double)(&add), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// VabsD is the double-precision version of Vabs.
+func VabsD(input []float64, inputStride int, output []float64, outputStride int) {
+	C.vDSP_vabsD((*C.double)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// VsqD is the double-precision version of Vsq.
+func VsqD(input []float64, inputStride int, output []float64, outputStride int) {
+	C.vDSP_vsqD((*C.double)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// Vector maximum value; double precision.
+func MaxvD(input []float64, stride int) float64 {
+	var out C.double
+	C.vDSP_maxvD((*C.double)(&input[0]), C.vDSP_Stride(stride), &out, C.vDSP_Length(len(input)/stride))
+	return float64(out)
+}
+
+// Vector minimum value; double precision.
+func MinvD(input []float64, stride int) float64 {
+	var out C.double
+	C.vDSP_minvD((*C.double)(&input[0]), C.vDSP_Stride(stride), &out, C.vDSP_Length(len(input)/stride))
+	return float64(out)
+}
+
+// Vector sum; double precision.
+func SveD(input []float64, inputStride int) float64 {
+	var sum C.double
+	C.vDSP_sveD((*C.double)(&input[0]), C.vDSP_Stride(inputStride), &sum, C.vDSP_Length(len(input)/inputStride))
+	return float64(sum)
+}
+
+// VmulD multiplies two double-precision vectors.
+func VmulD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	C.vDSP_vmulD((*C.double)(&input1[0]), C.vDSP_Stride(stride1), (*C.double)(&input2[0]), C.vDSP_Stride(stride2), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input1)/stride1, len(input2)/stride2, len(output)/outputStride))
+}
+
+// VsaddD is the double-precision version of Vsadd.
+func VsaddD(input []float64, inputStride int, add float64, output []float64, outputStride int) {
+	C.vDSP_vsaddD((*C.double)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&add), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// VnegD is the double-precision version of Vneg.
+func VnegD(input []float64, inputStride int, output []float64, outputStride int) {
+	C.vDSP_vnegD((*C.double)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// MeanvD returns the mean of the double-precision input vector.
+func MeanvD(input []float64, stride int) float64 {
+	var mean C.double
+	C.vDSP_meanvD((*C.double)(&input[0]), C.vDSP_Stride(stride), &mean, C.vDSP_Length(len(input)/stride))
+	return float64(mean)
+}
+
+// Vflt8D converts an array of signed 8-bit integers to double-precision floating-point values.
+func Vflt8D(input []int8, inputStride int, output []float64, outputStride int) {
+	C.vDSP_vflt8D((*C.char)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// Vfltu8D converts an array of unsigned 8-bit integers to double-precision floating-point values.
+func Vfltu8D(input []byte, inputStride int, output []float64, outputStride int) {
+	C.vDSP_vfltu8D((*C.uchar)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// Vflt16D converts an array of signed 16-bit integers to double-precision floating-point values.
+func Vflt16D(input []int16, inputStride int, output []float64, outputStride int) {
+	C.vDSP_vflt16D((*C.short)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// Vflt32D converts an array of signed 32-bit integers to double-precision floating-point values.
+func Vflt32D(input []int32, inputStride int, output []float64, outputStride int) {
+	C.vDSP_vflt32D((*C.int)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// Vspdp converts a single-precision vector to double-precision.
+func Vspdp(input []float32, inputStride int, output []float64, outputStride int) {
+	C.vDSP_vspdp((*C.float)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// Vfix16D converts an array of double-precision values to signed 16-bit integers rounding towards zero.
+func Vfix16D(input []float64, inputStride int, output []int16, outputStride int) {
+	C.vDSP_vfix16D((*C.double)(&input[0]), C.vDSP_Stride(inputStride), (*C.short)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// CtozD copies the contents of an interleaved complex vector C to a split complex vector Z; double precision.
+func CtozD(input []complex128, inputStride int, output DSPDoubleSplitComplex, outputStride int) {
+	var splitComplex C.DSPDoubleSplitComplex
+	splitComplex.realp = (*C.double)(&output.Real[0])
+	splitComplex.imagp = (*C.double)(&output.Imag[0])
+	C.vDSP_ctozD((*C.DSPDoubleComplex)(unsafe.Pointer(&input[0])), C.vDSP_Stride(inputStride), &splitComplex, C.vDSP_Stride(outputStride), minLen(len(output.Real)/outputStride, 2*len(input)/inputStride))
+}
+
+// ZtocD copies the contents of a split complex vector Z to an interleaved complex vector C; double precision.
+func ZtocD(input DSPDoubleSplitComplex, inputStride int, output []complex128, outputStride int) {
+	var splitComplex C.DSPDoubleSplitComplex
+	splitComplex.realp = (*C.double)(&input.Real[0])
+	splitComplex.imagp = (*C.double)(&input.Imag[0])
+	C.vDSP_ztocD(&splitComplex, C.vDSP_Stride(inputStride), (*C.DSPDoubleComplex)(unsafe.Pointer(&output[0])), C.vDSP_Stride(outputStride), minLen(len(input.Real)/inputStride, 2*len(output)/outputStride))
+}
+
+// VclrD clears the provided double-precision vector.
+func VclrD(vec []float64, stride int) {
+	C.vDSP_vclrD((*C.double)(&vec[0]), (C.vDSP_Stride)(stride), (C.vDSP_Length)(len(vec)/stride))
+}
+
+// VfillD fills the double-precision vector with the provided value.
+func VfillD(value float64, output []float64, stride int) {
+	C.vDSP_vfillD((*C.double)(&value), (*C.double)(&output[0]), (C.vDSP_Stride)(stride), (C.vDSP_Length)(len(output)/stride))
+}
+
+// DesampD performs convolution with decimation; double precision.
+func DesampD(input []float64, desamplingFactor int, coeff []float64, output []float64) {
+	n := desampLen(len(input), desamplingFactor, len(coeff), len(output))
+	if n <= 0 {
+		return
+	}
+	C.vDSP_desampD((*C.double)(&input[0]), C.vDSP_Stride(desamplingFactor), (*C.double)(&coeff[0]), (*C.double)(&output[0]), C.vDSP_Length(n), C.vDSP_Length(len(coeff)))
+}
+
+// ZrdesampD performs complex-real downsample with anti-aliasing; double precision.
+func ZrdesampD(input DSPDoubleSplitComplex, decimationFactor int, coefficients []float64, output DSPDoubleSplitComplex) {
+	n := desampLen(len(input.Real), decimationFactor, len(coefficients), len(output.Real))
+	if n <= 0 {
+		return
+	}
+	var srcC C.DSPDoubleSplitComplex
+	srcC.realp = (*C.double)(&input.Real[0])
+	srcC.imagp = (*C.double)(&input.Imag[0])
+	var dstC C.DSPDoubleSplitComplex
+	dstC.realp = (*C.double)(&output.Real[0])
+	dstC.imagp = (*C.double)(&output.Imag[0])
+	C.vDSP_zrdesampD(&srcC, C.vDSP_Stride(decimationFactor), (*C.double)(&coefficients[0]), &dstC, C.vDSP_Length(n), C.vDSP_Length(len(coefficients)))
+}
+
+// ZvphasD computes the phase values of the complex vector; double precision.
+func ZvphasD(input DSPDoubleSplitComplex, inputStride int, output []float64, outputStride int) {
+	var srcC C.DSPDoubleSplitComplex
+	srcC.realp = (*C.double)(&input.Real[0])
+	srcC.imagp = (*C.double)(&input.Imag[0])
+	C.vDSP_zvphasD(&srcC, C.vDSP_Stride(inputStride), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input.Real)/inputStride, len(output)/outputStride))
+}
+
+// ZidotprD calculates the conjugate dot product of complex vectors; double precision.
+func ZidotprD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex) {
+	var in1 C.DSPDoubleSplitComplex
+	in1.realp = (*C.double)(&input1.Real[0])
+	in1.imagp = (*C.double)(&input1.Imag[0])
+	var in2 C.DSPDoubleSplitComplex
+	in2.realp = (*C.double)(&input2.Real[0])
+	in2.imagp = (*C.double)(&input2.Imag[0])
+	var res C.DSPDoubleSplitComplex
+	res.realp = (*C.double)(&result.Real[0])
+	res.imagp = (*C.double)(&result.Imag[0])
+	C.vDSP_zidotprD(&in1, C.vDSP_Stride(stride1), &in2, C.vDSP_Stride(stride2), &res, minLen(len(input1.Real)/stride1, len(input2.Real)/stride2))
+}
+
+// ZvcmulD multiplies complex vectors conjugating input1; double precision.
+func ZvcmulD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex, resultStride int) {
+	var in1 C.DSPDoubleSplitComplex
+	in1.realp = (*C.double)(&input1.Real[0])
+	in1.imagp = (*C.double)(&input1.Imag[0])
+	var in2 C.DSPDoubleSplitComplex
+	in2.realp = (*C.double)(&input2.Real[0])
+	in2.imagp = (*C.double)(&input2.Imag[0])
+	var res C.DSPDoubleSplitComplex
+	res.realp = (*C.double)(&result.Real[0])
+	res.imagp = (*C.double)(&result.Imag[0])
+	C.vDSP_zvcmulD(&in1, C.vDSP_Stride(stride1), &in2, C.vDSP_Stride(stride2), &res, C.vDSP_Stride(resultStride), minLen(len(input1.Real)/stride1, len(input2.Real)/stride2, len(result.Real)/resultStride))
+}
+
+// Vector linear average; double precision.
+func VavlinD(input []float64, inputStride int, count float64, output []float64, outputStride int) {
+	C.vDSP_vavlinD((*C.double)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&count), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// Vector scalar divide; double precision.
+func VsdivD(input []float64, inputStride int, divisor float64, output []float64, outputStride int) {
+	C.vDSP_vsdivD((*C.double)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&divisor), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride))
+}
+
+// VswsumD computes the sliding window sum of a double-precision vector.
+func VswsumD(input []float64, inputStride int, output []float64, outputStride, windowLen int) {
+	n := minLenGeneric(len(input)/inputStride-windowLen+1, len(output)/outputStride)
+	if n <= 0 {
+		return
+	}
+	C.vDSP_vswsumD((*C.double)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), C.vDSP_Length(n), C.vDSP_Length(windowLen))
+}
+
+// VdbconD converts double-precision power or amplitude values to decibels.
+func VdbconD(input []float64, inputStride int, zeroReference float64, output []float64, outputStride int, flag DBFlag) {
+	C.vDSP_vdbconD((*C.double)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&zeroReference), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), minLen(len(input)/inputStride, len(output)/outputStride), C.uint(flag))
+}
+
+// HannWindowD creates a double-precision Hanning window.
+func HannWindowD(output []float64, flag WindowFlag) {
+	C.vDSP_hann_windowD((*C.double)(&output[0]), C.vDSP_Length(len(output)), C.int(flag))
+}
+
+// HammWindowD creates a double-precision Hamming window.
+func HammWindowD(output []float64, flag WindowFlag) {
+	C.vDSP_hamm_windowD((*C.double)(&output[0]), C.vDSP_Length(len(output)), C.int(flag))
+}
+
+// BlkmanWindowD creates a double-precision Blackman window.
+func BlkmanWindowD(output []float64, flag WindowFlag) {
+	C.vDSP_blkman_windowD((*C.double)(&output[0]), C.vDSP_Length(len(output)), C.int(flag))
+}
+
+// ConvD performs double-precision correlation or convolution; see Conv.
+func ConvD(input []float64, inputStride int, filter []float64, filterStride int, output []float64, outputStride int) {
+	n, p := convLen(len(input), inputStride, len(filter), filterStride, len(output), outputStride)
+	if n <= 0 || p <= 0 {
+		return
+	}
+	C.vDSP_convD((*C.double)(&input[0]), C.vDSP_Stride(inputStride), (*C.double)(&filter[convFilterStart(p, filterStride)]), C.vDSP_Stride(filterStride), (*C.double)(&output[0]), C.vDSP_Stride(outputStride), C.vDSP_Length(n), C.vDSP_Length(p))
+}
+
+// ZconvD performs double-precision complex correlation or convolution;
+// see Zconv.
+func ZconvD(input DSPDoubleSplitComplex, inputStride int, filter DSPDoubleSplitComplex, filterStride int, output DSPDoubleSplitComplex, outputStride int) {
+	n, p := convLen(len(input.Real), inputStride, len(filter.Real), filterStride, len(output.Real), outputStride)
+	if n <= 0 || p <= 0 {
+		return
+	}
+	f0 := convFilterStart(p, filterStride)
+	var srcC C.DSPDoubleSplitComplex
+	srcC.realp = (*C.double)(&input.Real[0])
+	srcC.imagp = (*C.double)(&input.Imag[0])
+	var filterC C.DSPDoubleSplitComplex
+	filterC.realp = (*C.double)(&filter.Real[f0])
+	filterC.imagp = (*C.double)(&filter.Imag[f0])
+	var dstC C.DSPDoubleSplitComplex
+	dstC.realp = (*C.double)(&output.Real[0])
+	dstC.imagp = (*C.double)(&output.Imag[0])
+	C.vDSP_zconvD(&srcC, C.vDSP_Stride(inputStride), &filterC, C.vDSP_Stride(filterStride), &dstC, C.vDSP_Stride(outputStride), C.vDSP_Length(n), C.vDSP_Length(p))
+}
+
+// ZvmulD multiplies complex vectors; double precision. See Zvmul.
+func ZvmulD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex, resultStride int, conjugate bool) {
+	var in1 C.DSPDoubleSplitComplex
+	in1.realp = (*C.double)(&input1.Real[0])
+	in1.imagp = (*C.double)(&input1.Imag[0])
+	var in2 C.DSPDoubleSplitComplex
+	in2.realp = (*C.double)(&input2.Real[0])
+	in2.imagp = (*C.double)(&input2.Imag[0])
+	var res C.DSPDoubleSplitComplex
+	res.realp = (*C.double)(&result.Real[0])
+	res.imagp = (*C.double)(&result.Imag[0])
+	C.vDSP_zvmulD(&in1, C.vDSP_Stride(stride1), &in2, C.vDSP_Stride(stride2), &res, C.vDSP_Stride(resultStride), minLen(len(input1.Real)/stride1, len(input2.Real)/stride2, len(result.Real)/resultStride), zvmulConjugate(conjugate))
+}
+
+// ZvconjD conjugates a complex vector; double precision.
+func ZvconjD(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int) {
+	var in C.DSPDoubleSplitComplex
+	in.realp = (*C.double)(&input.Real[0])
+	in.imagp = (*C.double)(&input.Imag[0])
+	var out C.DSPDoubleSplitComplex
+	out.realp = (*C.double)(&output.Real[0])
+	out.imagp = (*C.double)(&output.Imag[0])
+	C.vDSP_zvconjD(&in, C.vDSP_Stride(inputStride), &out, C.vDSP_Stride(outputStride), minLen(len(input.Real)/inputStride, len(output.Real)/outputStride))
+}
@@ -0,0 +1,60 @@
+package accel
+
+import "unsafe"
+
+// Portable implementations of the double-precision vDSP routines that can
+// not share a generic implementation with their single-precision versions.
+
+func vspdpGeneric(input []float32, inputStride int, output []float64, outputStride int) {
+	n := minLenGeneric(len(input)/inputStride, len(output)/outputStride)
+	for i := 0; i < n; i++ {
+		output[i*outputStride] = float64(input[i*inputStride])
+	}
+}
+
+// ctozDGeneric implements vDSP_ctozD. The interleaved stride is counted in
+// float64 units (2 per complex value) like vDSP.
+func ctozDGeneric(input []complex128, inputStride int, output DSPDoubleSplitComplex, outputStride int) {
+	n := minLenGeneric(len(output.Real)/outputStride, 2*len(input)/inputStride)
+	ctozSplit(complex128AsFloat64(input), inputStride, output.Real, output.Imag, outputStride, n)
+}
+
+func ztocDGeneric(input DSPDoubleSplitComplex, inputStride int, output []complex128, outputStride int) {
+	n := minLenGeneric(len(input.Real)/inputStride, 2*len(output)/outputStride)
+	ztocSplit(input.Real, input.Imag, inputStride, complex128AsFloat64(output), outputStride, n)
+}
+
+func zrdesampDGeneric(input DSPDoubleSplitComplex, decimationFactor int, coefficients []float64, output DSPDoubleSplitComplex) {
+	zrdesampSplit(input.Real, input.Imag, decimationFactor, coefficients, output.Real, output.Imag)
+}
+
+func zvphasDGeneric(input DSPDoubleSplitComplex, inputStride int, output []float64, outputStride int) {
+	zvphasSplit(input.Real, input.Imag, inputStride, output, outputStride)
+}
+
+func zidotprDGeneric(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex) {
+	result.Real[0], result.Imag[0] = zidotprSplit(input1.Real, input1.Imag, stride1, input2.Real, input2.Imag, stride2)
+}
+
+func zvcmulDGeneric(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex, resultStride int) {
+	zvcmulSplit(input1.Real, input1.Imag, stride1, input2.Real, input2.Imag, stride2, result.Real, result.Imag, resultStride)
+}
+
+func complex128AsFloat64(c []complex128) []float64 {
+	if len(c) == 0 {
+		return nil
+	}
+	return unsafe.Slice((*float64)(unsafe.Pointer(&c[0])), len(c)*2)
+}
+
+func zconvDGeneric(input DSPDoubleSplitComplex, inputStride int, filter DSPDoubleSplitComplex, filterStride int, output DSPDoubleSplitComplex, outputStride int) {
+	zconvSplit(input.Real, input.Imag, inputStride, filter.Real, filter.Imag, filterStride, output.Real, output.Imag, outputStride)
+}
+
+func zvmulDGeneric(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex, resultStride int, conjugate bool) {
+	zvmulSplit(input1.Real, input1.Imag, stride1, input2.Real, input2.Imag, stride2, result.Real, result.Imag, resultStride, conjugate)
+}
+
+func zvconjDGeneric(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int) {
+	zvconjSplit(input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride)
+}
@@ -0,0 +1,200 @@
+//go:build !darwin || !cgo || purego
+
+package accel
+
+// VclipD is the double-precision version of Vclip.
+func VclipD(input []float64, inputStride int, low, high float64, output []float64, outputStride int) {
+	vclipGeneric(input, inputStride, low, high, output, outputStride)
+}
+
+// VthrD is the double-precision version of Vthr.
+func VthrD(input []float64, inputStride int, low float64, output []float64, outputStride int) {
+	vthrGeneric(input, inputStride, low, output, outputStride)
+}
+
+// VaddD adds two double-precision vectors.
+func VaddD(input1 []float64, input1Stride int, input2 []float64, input2Stride int, output []float64, outputStride int) {
+	vaddGeneric(input1, input1Stride, input2, input2Stride, output, outputStride)
+}
+
+// VsmsaD is vector scalar multiply and scalar add; double precision.
+// output[n] = input[n] * mult + add
+func VsmsaD(input []float64, inputStride int, mult, add float64, output []float64, outputStride int) {
+	vsmsaGeneric(input, inputStride, mult, add, output, outputStride)
+}
+
+// VabsD is the double-precision version of Vabs.
+func VabsD(input []float64, inputStride int, output []float64, outputStride int) {
+	vabsGeneric(input, inputStride, output, outputStride)
+}
+
+// VsqD is the double-precision version of Vsq.
+func VsqD(input []float64, inputStride int, output []float64, outputStride int) {
+	vsqGeneric(input, inputStride, output, outputStride)
+}
+
+// Vector maximum value; double precision.
+func MaxvD(input []float64, stride int) float64 {
+	return maxvGeneric(input, stride)
+}
+
+// Vector minimum value; double precision.
+func MinvD(input []float64, stride int) float64 {
+	return minvGeneric(input, stride)
+}
+
+// Vector sum; double precision.
+func SveD(input []float64, inputStride int) float64 {
+	return sveGeneric(input, inputStride)
+}
+
+// VmulD multiplies two double-precision vectors.
+func VmulD(input1 []float64, stride1 int, input2 []float64, stride2 int, output []float64, outputStride int) {
+	vmulGeneric(input1, stride1, input2, stride2, output, outputStride)
+}
+
+// VsaddD is the double-precision version of Vsadd.
+func VsaddD(input []float64, inputStride int, add float64, output []float64, outputStride int) {
+	vsaddGeneric(input, inputStride, add, output, outputStride)
+}
+
+// VnegD is the double-precision version of Vneg.
+func VnegD(input []float64, inputStride int, output []float64, outputStride int) {
+	vnegGeneric(input, inputStride, output, outputStride)
+}
+
+// MeanvD returns the mean of the double-precision input vector.
+func MeanvD(input []float64, stride int) float64 {
+	return meanvGeneric(input, stride)
+}
+
+// Vflt8D converts an array of signed 8-bit integers to double-precision floating-point values.
+func Vflt8D(input []int8, inputStride int, output []float64, outputStride int) {
+	vflt8Generic(input, inputStride, output, outputStride)
+}
+
+// Vfltu8D converts an array of unsigned 8-bit integers to double-precision floating-point values.
+func Vfltu8D(input []byte, inputStride int, output []float64, outputStride int) {
+	vfltu8Generic(input, inputStride, output, outputStride)
+}
+
+// Vflt16D converts an array of signed 16-bit integers to double-precision floating-point values.
+func Vflt16D(input []int16, inputStride int, output []float64, outputStride int) {
+	vflt16Generic(input, inputStride, output, outputStride)
+}
+
+// Vflt32D converts an array of signed 32-bit integers to double-precision floating-point values.
+func Vflt32D(input []int32, inputStride int, output []float64, outputStride int) {
+	vflt32Generic(input, inputStride, output, outputStride)
+}
+
+// Vspdp converts a single-precision vector to double-precision.
+func Vspdp(input []float32, inputStride int, output []float64, outputStride int) {
+	vspdpGeneric(input, inputStride, output, outputStride)
+}
+
+// Vfix16D converts an array of double-precision values to signed 16-bit integers rounding towards zero.
+func Vfix16D(input []float64, inputStride int, output []int16, outputStride int) {
+	vfix16Generic(input, inputStride, output, outputStride)
+}
+
+// CtozD copies the contents of an interleaved complex vector C to a split complex vector Z; double precision.
+func CtozD(input []complex128, inputStride int, output DSPDoubleSplitComplex, outputStride int) {
+	ctozDGeneric(input, inputStride, output, outputStride)
+}
+
+// ZtocD copies the contents of a split complex vector Z to an interleaved complex vector C; double precision.
+func ZtocD(input DSPDoubleSplitComplex, inputStride int, output []complex128, outputStride int) {
+	ztocDGeneric(input, inputStride, output, outputStride)
+}
+
+// VclrD clears the provided double-precision vector.
+func VclrD(vec []float64, stride int) {
+	vclrGeneric(vec, stride)
+}
+
+// VfillD fills the double-precision vector with the provided value.
+func VfillD(value float64, output []float64, stride int) {
+	vfillGeneric(value, output, stride)
+}
+
+// DesampD performs convolution with decimation; double precision.
+func DesampD(input []float64, desamplingFactor int, coeff []float64, output []float64) {
+	desampGeneric(input, desamplingFactor, coeff, output)
+}
+
+// ZrdesampD performs complex-real downsample with anti-aliasing; double precision.
+func ZrdesampD(input DSPDoubleSplitComplex, decimationFactor int, coefficients []float64, output DSPDoubleSplitComplex) {
+	zrdesampDGeneric(input, decimationFactor, coefficients, output)
+}
+
+// ZvphasD computes the phase values of the complex vector; double precision.
+func ZvphasD(input DSPDoubleSplitComplex, inputStride int, output []float64, outputStride int) {
+	zvphasDGeneric(input, inputStride, output, outputStride)
+}
+
+// ZidotprD calculates the conjugate dot product of complex vectors; double precision.
+func ZidotprD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex) {
+	zidotprDGeneric(input1, stride1, input2, stride2, result)
+}
+
+// ZvcmulD multiplies complex vectors conjugating input1; double precision.
+func ZvcmulD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex, resultStride int) {
+	zvcmulDGeneric(input1, stride1, input2, stride2, result, resultStride)
+}
+
+// Vector linear average; double precision.
+func VavlinD(input []float64, inputStride int, count float64, output []float64, outputStride int) {
+	vavlinGeneric(input, inputStride, count, output, outputStride)
+}
+
+// Vector scalar divide; double precision.
+func VsdivD(input []float64, inputStride int, divisor float64, output []float64, outputStride int) {
+	vsdivGeneric(input, inputStride, divisor, output, outputStride)
+}
+
+// VswsumD computes the sliding window sum of a double-precision vector.
+func VswsumD(input []float64, inputStride int, output []float64, outputStride, windowLen int) {
+	vswsumGeneric(input, inputStride, output, outputStride, windowLen)
+}
+
+// VdbconD converts double-precision power or amplitude values to decibels.
+func VdbconD(input []float64, inputStride int, zeroReference float64, output []float64, outputStride int, flag DBFlag) {
+	vdbconGeneric(input, inputStride, zeroReference, output, outputStride, flag)
+}
+
+// HannWindowD creates a double-precision Hanning window.
+func HannWindowD(output []float64, flag WindowFlag) {
+	hannWindowGeneric(output, flag)
+}
+
+// HammWindowD creates a double-precision Hamming window.
+func HammWindowD(output []float64, flag WindowFlag) {
+	hammWindowGeneric(output, flag)
+}
+
+// BlkmanWindowD creates a double-precision Blackman window.
+func BlkmanWindowD(output []float64, flag WindowFlag) {
+	blkmanWindowGeneric(output, flag)
+}
+
+// ConvD performs double-precision correlation or convolution; see Conv.
+func ConvD(input []float64, inputStride int, filter []float64, filterStride int, output []float64, outputStride int) {
+	convGeneric(input, inputStride, filter, filterStride, output, outputStride)
+}
+
+// ZconvD performs double-precision complex correlation or convolution;
+// see Zconv.
+func ZconvD(input DSPDoubleSplitComplex, inputStride int, filter DSPDoubleSplitComplex, filterStride int, output DSPDoubleSplitComplex, outputStride int) {
+	zconvDGeneric(input, inputStride, filter, filterStride, output, outputStride)
+}
+
+// ZvmulD multiplies complex vectors; double precision. See Zvmul.
+func ZvmulD(input1 DSPDoubleSplitComplex, stride1 int, input2 DSPDoubleSplitComplex, stride2 int, result DSPDoubleSplitComplex, resultStride int, conjugate bool) {
+	zvmulDGeneric(input1, stride1, input2, stride2, result, resultStride, conjugate)
+}
+
+// ZvconjD conjugates a complex vector; double precision.
+func ZvconjD(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int) {
+	zvconjDGeneric(input, inputStride, output, outputStride)
+}
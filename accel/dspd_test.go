@@ -0,0 +1,217 @@
+package accel
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVfltu8D(t *testing.T) {
+	input := []byte{4, 127, 250, 190}
+	output := make([]float64, 8)
+	for i := range output {
+		output[i] = math.NaN()
+	}
+
+	Vfltu8D(input, 2, output, 1)
+	for i := 0; i < len(input); i += 2 {
+		if expected := float64(input[i]); expected != output[i/2] {
+			t.Errorf("Vfltu8D in stride = 2 : output %f != expected %f for index %d", output[i/2], expected, i)
+		}
+	}
+	for i := len(input) / 2; i < len(output); i++ {
+		if !math.IsNaN(output[i]) {
+			t.Errorf("Vfltu8D wrote too far for input stride 2 (%d=%f)", i, output[i])
+		}
+	}
+}
+
+func TestVflt16D(t *testing.T) {
+	input16 := []int16{-32768, -256, 0, 256, 32767}
+	output := make([]float64, len(input16))
+	Vflt16D(input16, 1, output, 1)
+	for i, x := range input16 {
+		if float64(x) != output[i] {
+			t.Errorf("Vflt16D(%d) = %f; want %f", x, output[i], float64(x))
+		}
+	}
+}
+
+func TestVspdp(t *testing.T) {
+	input := []float32{0.5, -1.25, 3e10}
+	output := make([]float64, len(input))
+	Vspdp(input, 1, output, 1)
+	for i, x := range input {
+		if float64(x) != output[i] {
+			t.Errorf("Vspdp(%f) = %f", x, output[i])
+		}
+	}
+}
+
+func TestCtozZtocD(t *testing.T) {
+	input := []complex128{complex(1, 2), complex(3, 4), complex(5, 6), complex(7, 8)}
+	split := DSPDoubleSplitComplex{Real: make([]float64, len(input)), Imag: make([]float64, len(input))}
+	CtozD(input, 2, split, 1)
+	for i, c := range input {
+		if split.Real[i] != real(c) || split.Imag[i] != imag(c) {
+			t.Errorf("CtozD index %d : (%f, %f) != expected %v", i, split.Real[i], split.Imag[i], c)
+		}
+	}
+
+	output := make([]complex128, len(input)*2) // extra padding to check for overflow
+	for i := range output {
+		output[i] = complex(math.NaN(), math.NaN())
+	}
+	ZtocD(split, 1, output[:len(input)], 2)
+	for i, c := range input {
+		if output[i] != c {
+			t.Errorf("ZtocD index %d : output %v != expected %v", i, output[i], c)
+		}
+	}
+	if !math.IsNaN(real(output[len(input)])) {
+		t.Errorf("ZtocD wrote past the end of the output")
+	}
+}
+
+func TestVsdivD(t *testing.T) {
+	input := []float64{1.0, 2.0, 3.0, 4.0, 5.0}
+	output := make([]float64, len(input))
+	VsdivD(input, 1, 3.0, output, 1)
+	for i := range output {
+		if expected := input[i] / 3.0; !almostEqual64(output[i], expected, 1e-12) {
+			t.Errorf("Expected %f/3.0 to return %f instead of %f", input[i], expected, output[i])
+		}
+	}
+}
+
+// TestDoubleMatchesSingle checks that every double-precision routine
+// agrees with its single-precision counterpart on the same input.
+func TestDoubleMatchesSingle(t *testing.T) {
+	const n = 16
+	x32 := make([]float32, n)
+	y32 := make([]float32, n)
+	x64 := make([]float64, n)
+	y64 := make([]float64, n)
+	for i := range x32 {
+		x32[i] = float32(math.Sin(float64(i)) * 4)
+		y32[i] = float32(float64(i)/3 + 0.5)
+		x64[i] = float64(x32[i])
+		y64[i] = float64(y32[i])
+	}
+	split32 := DSPSplitComplex{Real: x32, Imag: y32}
+	split64 := DSPDoubleSplitComplex{Real: x64, Imag: y64}
+
+	compare := func(name string, got []float64, want []float32) {
+		t.Helper()
+		for i := range want {
+			if !almostEqual64(got[i], float64(want[i]), maxFloatDiffErr*math.Max(1, math.Abs(got[i]))) {
+				t.Errorf("%s[%d] = %f; single precision gave %f", name, i, got[i], want[i])
+			}
+		}
+	}
+	vector := func(name string, f32 func(out []float32), f64 func(out []float64)) {
+		t.Helper()
+		out32 := make([]float32, n)
+		out64 := make([]float64, n)
+		f32(out32)
+		f64(out64)
+		compare(name, out64, out32)
+	}
+
+	vector("VaddD", func(o []float32) { Vadd(x32, 1, y32, 1, o, 1) }, func(o []float64) { VaddD(x64, 1, y64, 1, o, 1) })
+	vector("VmulD", func(o []float32) { Vmul(x32, 1, y32, 1, o, 1) }, func(o []float64) { VmulD(x64, 1, y64, 1, o, 1) })
+	vector("VsmsaD", func(o []float32) { Vsmsa(x32, 1, 2, 3, o, 1) }, func(o []float64) { VsmsaD(x64, 1, 2, 3, o, 1) })
+	vector("VclipD", func(o []float32) { Vclip(x32, 1, -1, 2, o, 1) }, func(o []float64) { VclipD(x64, 1, -1, 2, o, 1) })
+	vector("VthrD", func(o []float32) { Vthr(x32, 1, 0.5, o, 1) }, func(o []float64) { VthrD(x64, 1, 0.5, o, 1) })
+	vector("VabsD", func(o []float32) { Vabs(x32, 1, o, 1) }, func(o []float64) { VabsD(x64, 1, o, 1) })
+	vector("VsqD", func(o []float32) { Vsq(x32, 1, o, 1) }, func(o []float64) { VsqD(x64, 1, o, 1) })
+	vector("VnegD", func(o []float32) { Vneg(x32, 1, o, 1) }, func(o []float64) { VnegD(x64, 1, o, 1) })
+	vector("VsaddD", func(o []float32) { Vsadd(x32, 1, 1.5, o, 1) }, func(o []float64) { VsaddD(x64, 1, 1.5, o, 1) })
+	vector("VsubD", func(o []float32) { Vsub(x32, 1, y32, 1, o, 1) }, func(o []float64) { VsubD(x64, 1, y64, 1, o, 1) })
+	vector("VdivD", func(o []float32) { Vdiv(x32, 1, y32, 1, o, 1) }, func(o []float64) { VdivD(x64, 1, y64, 1, o, 1) })
+	vector("VsmulD", func(o []float32) { Vsmul(x32, 1, 1.5, o, 1) }, func(o []float64) { VsmulD(x64, 1, 1.5, o, 1) })
+	vector("SvdivD", func(o []float32) { Svdiv(3, y32, 1, o, 1) }, func(o []float64) { SvdivD(3, y64, 1, o, 1) })
+	vector("VmaD", func(o []float32) { Vma(x32, 1, y32, 1, x32, 1, o, 1) }, func(o []float64) { VmaD(x64, 1, y64, 1, x64, 1, o, 1) })
+	vector("VmsaD", func(o []float32) { Vmsa(x32, 1, y32, 1, 2, o, 1) }, func(o []float64) { VmsaD(x64, 1, y64, 1, 2, o, 1) })
+	vector("VmsbD", func(o []float32) { Vmsb(x32, 1, y32, 1, x32, 1, o, 1) }, func(o []float64) { VmsbD(x64, 1, y64, 1, x64, 1, o, 1) })
+	vector("VamD", func(o []float32) { Vam(x32, 1, y32, 1, x32, 1, o, 1) }, func(o []float64) { VamD(x64, 1, y64, 1, x64, 1, o, 1) })
+	vector("VsbmD", func(o []float32) { Vsbm(x32, 1, y32, 1, x32, 1, o, 1) }, func(o []float64) { VsbmD(x64, 1, y64, 1, x64, 1, o, 1) })
+	vector("VasmD", func(o []float32) { Vasm(x32, 1, y32, 1, 0.5, o, 1) }, func(o []float64) { VasmD(x64, 1, y64, 1, 0.5, o, 1) })
+	vector("VsbsmD", func(o []float32) { Vsbsm(x32, 1, y32, 1, 0.5, o, 1) }, func(o []float64) { VsbsmD(x64, 1, y64, 1, 0.5, o, 1) })
+	vector("VsmaD", func(o []float32) { Vsma(x32, 1, 2, y32, 1, o, 1) }, func(o []float64) { VsmaD(x64, 1, 2, y64, 1, o, 1) })
+	vector("VsmsbD", func(o []float32) { Vsmsb(x32, 1, 2, y32, 1, o, 1) }, func(o []float64) { VsmsbD(x64, 1, 2, y64, 1, o, 1) })
+	vector("VmmaD", func(o []float32) { Vmma(x32, 1, y32, 1, y32, 1, x32, 1, o, 1) }, func(o []float64) { VmmaD(x64, 1, y64, 1, y64, 1, x64, 1, o, 1) })
+	vector("VmmsbD", func(o []float32) { Vmmsb(x32, 1, y32, 1, y32, 1, x32, 1, o, 1) }, func(o []float64) { VmmsbD(x64, 1, y64, 1, y64, 1, x64, 1, o, 1) })
+	vector("VaamD", func(o []float32) { Vaam(x32, 1, y32, 1, y32, 1, x32, 1, o, 1) }, func(o []float64) { VaamD(x64, 1, y64, 1, y64, 1, x64, 1, o, 1) })
+	vector("VsbsbmD", func(o []float32) { Vsbsbm(x32, 1, y32, 1, y32, 1, x32, 1, o, 1) }, func(o []float64) { VsbsbmD(x64, 1, y64, 1, y64, 1, x64, 1, o, 1) })
+	vector("VasbmD", func(o []float32) { Vasbm(x32, 1, y32, 1, y32, 1, x32, 1, o, 1) }, func(o []float64) { VasbmD(x64, 1, y64, 1, y64, 1, x64, 1, o, 1) })
+	vector("VintbD", func(o []float32) { Vintb(x32, 1, y32, 1, 0.25, o, 1) }, func(o []float64) { VintbD(x64, 1, y64, 1, 0.25, o, 1) })
+	vector("VdistD", func(o []float32) { Vdist(x32, 1, y32, 1, o, 1) }, func(o []float64) { VdistD(x64, 1, y64, 1, o, 1) })
+	vector("VpolyD", func(o []float32) { Vpoly([]float32{0.5, -1, 2}, 1, x32, 1, o, 1) }, func(o []float64) { VpolyD([]float64{0.5, -1, 2}, 1, x64, 1, o, 1) })
+	vector("VlintD", func(o []float32) { Vlint(x32, y32, 1, o, 1) }, func(o []float64) { VlintD(x64, y64, 1, o, 1) })
+	vector("VdbconD", func(o []float32) { Vdbcon(y32, 1, 2, o, 1, DBFlagAmplitude) }, func(o []float64) { VdbconD(y64, 1, 2, o, 1, DBFlagAmplitude) })
+	vector("VavlinD", func(o []float32) { copy(o, y32); Vavlin(x32, 1, 3, o, 1) }, func(o []float64) { copy(o, y64); VavlinD(x64, 1, 3, o, 1) })
+	vector("VfillD", func(o []float32) { Vfill(2.5, o, 2) }, func(o []float64) { VfillD(2.5, o, 2) })
+	vector("VswsumD", func(o []float32) { Vswsum(x32, 1, o[:n-3], 1, 4) }, func(o []float64) { VswsumD(x64, 1, o[:n-3], 1, 4) })
+	vector("DesampD", func(o []float32) { Desamp(x32, 2, []float32{0.25, 0.5, 0.25}, o[:7]) }, func(o []float64) { DesampD(x64, 2, []float64{0.25, 0.5, 0.25}, o[:7]) })
+	vector("ZvphasD", func(o []float32) { Zvphas(split32, 1, o, 1) }, func(o []float64) { ZvphasD(split64, 1, o, 1) })
+	vector("ZvabsD", func(o []float32) { Zvabs(split32, 1, o, 1) }, func(o []float64) { ZvabsD(split64, 1, o, 1) })
+	vector("HannWindowD", func(o []float32) { HannWindow(o, WindowFlagHannNorm) }, func(o []float64) { HannWindowD(o, WindowFlagHannNorm) })
+	vector("HammWindowD", func(o []float32) { HammWindow(o, 0) }, func(o []float64) { HammWindowD(o, 0) })
+	vector("BlkmanWindowD", func(o []float32) { BlkmanWindow(o, 0) }, func(o []float64) { BlkmanWindowD(o, 0) })
+
+	split := func(name string, f32 func(out DSPSplitComplex), f64 func(out DSPDoubleSplitComplex)) {
+		t.Helper()
+		out32 := DSPSplitComplex{Real: make([]float32, n), Imag: make([]float32, n)}
+		out64 := DSPDoubleSplitComplex{Real: make([]float64, n), Imag: make([]float64, n)}
+		f32(out32)
+		f64(out64)
+		compare(name+".Real", out64.Real, out32.Real)
+		compare(name+".Imag", out64.Imag, out32.Imag)
+	}
+	split("ZvcmulD", func(o DSPSplitComplex) { Zvcmul(split32, 1, split32, 1, o, 1) }, func(o DSPDoubleSplitComplex) { ZvcmulD(split64, 1, split64, 1, o, 1) })
+	split("ZvmulD", func(o DSPSplitComplex) { Zvmul(split32, 1, split32, 1, o, 1, false) }, func(o DSPDoubleSplitComplex) { ZvmulD(split64, 1, split64, 1, o, 1, false) })
+	split("ZvmulD conjugate", func(o DSPSplitComplex) { Zvmul(split32, 1, split32, 1, o, 1, true) }, func(o DSPDoubleSplitComplex) { ZvmulD(split64, 1, split64, 1, o, 1, true) })
+	split("ZvconjD", func(o DSPSplitComplex) { Zvconj(split32, 1, o, 1) }, func(o DSPDoubleSplitComplex) { ZvconjD(split64, 1, o, 1) })
+	split("ZidotprD", func(o DSPSplitComplex) { Zidotpr(split32, 1, split32, 1, o) }, func(o DSPDoubleSplitComplex) { ZidotprD(split64, 1, split64, 1, o) })
+	split("ZrdesampD", func(o DSPSplitComplex) {
+		Zrdesamp(split32, 2, []float32{0.5, 0.5}, DSPSplitComplex{Real: o.Real[:7], Imag: o.Imag[:7]})
+	}, func(o DSPDoubleSplitComplex) {
+		ZrdesampD(split64, 2, []float64{0.5, 0.5}, DSPDoubleSplitComplex{Real: o.Real[:7], Imag: o.Imag[:7]})
+	})
+
+	scalars := []struct {
+		name string
+		got  float64
+		want float32
+	}{
+		{"MaxvD", MaxvD(x64, 1), Maxv(x32, 1)},
+		{"MinvD", MinvD(x64, 1), Minv(x32, 1)},
+		{"SveD", SveD(x64, 2), Sve(x32, 2)},
+		{"MeanvD", MeanvD(x64, 1), Meanv(x32, 1)},
+	}
+	for _, s := range scalars {
+		compare(s.name, []float64{s.got}, []float32{s.want})
+	}
+
+	fix := make([]int16, n)
+	Vfix16D(x64, 1, fix, 1)
+	for i, x := range x64 {
+		if fix[i] != int16(x) {
+			t.Errorf("Vfix16D(%f) = %d; want %d", x, fix[i], int16(x))
+		}
+	}
+	clr := []float64{1, 2, 3, 4}
+	VclrD(clr, 2)
+	if clr[0] != 0 || clr[1] != 2 || clr[2] != 0 || clr[3] != 4 {
+		t.Errorf("VclrD with stride 2 gave %v", clr)
+	}
+}
+
+func BenchmarkVsmsaD(b *testing.B) {
+	input := make([]float64, 4096)
+	b.SetBytes(int64(len(input)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		VsmsaD(input, 1, 2.0, 0.0, input, 1)
+	}
+}
@@ -1,31 +1,16 @@
+//go:build darwin && cgo && !purego
+
 package accel
 
 // #include <Accelerate/Accelerate.h>
 import "C"
 
-import (
-	"errors"
-	"runtime"
-)
-
-var ErrFailedToCreateFFTSetup = errors.New("accel: failed to create FFT setup")
+import "runtime"
 
 type FFTSetup struct {
 	cFFTSetup C.FFTSetup
 }
 
-type FFTRadix C.FFTRadix
-type FFTDirection C.FFTDirection
-
-const (
-	FFTRadix2 FFTRadix = C.kFFTRadix2
-	FFTRadix3 FFTRadix = C.kFFTRadix3
-	FFTRadix5 FFTRadix = C.kFFTRadix5
-
-	FFTDirectionForward FFTDirection = C.kFFTDirection_Forward
-	FFTDirectionInverse FFTDirection = C.kFFTDirection_Inverse
-)
-
 func CreateFFTSetup(log2n int, radix FFTRadix) (*FFTSetup, error) {
 	fftSetup := C.vDSP_create_fftsetup(C.vDSP_Length(log2n), C.FFTRadix(radix))
 	if fftSetup == nil {
@@ -57,7 +42,7 @@ func (fs *FFTSetup) Zrip(ioData DSPSplitComplex, stride, log2n int, direction FF
 	C.vDSP_fft_zrip(fs.cFFTSetup, &splitComplex, C.vDSP_Stride(stride), C.vDSP_Length(log2n), C.FFTDirection(direction))
 }
 
-// Zop computes an out-of-place single-precision real discrete Fourier transform of the
+// Zrop computes an out-of-place single-precision real discrete Fourier transform of the
 // input vector, either from the time domain to the frequency domain (forward) or from the
 // frequency domain to the time domain (inverse).
 func (fs *FFTSetup) Zrop(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection) {
@@ -67,7 +52,7 @@ func (fs *FFTSetup) Zrop(input DSPSplitComplex, inputStride int, output DSPSplit
 	var outC C.DSPSplitComplex
 	outC.realp = (*C.float)(&output.Real[0])
 	outC.imagp = (*C.float)(&output.Imag[0])
-	C.vDSP_fft_zop(fs.cFFTSetup, &inC, C.vDSP_Stride(inputStride), &outC, C.vDSP_Stride(outputStride), C.vDSP_Length(log2n), C.FFTDirection(direction))
+	C.vDSP_fft_zrop(fs.cFFTSetup, &inC, C.vDSP_Stride(inputStride), &outC, C.vDSP_Stride(outputStride), C.vDSP_Length(log2n), C.FFTDirection(direction))
 }
 
 // Zip computess an in-place single-precision complex discrete Fourier transform of the
@@ -92,3 +77,107 @@ func (fs *FFTSetup) Zop(input DSPSplitComplex, inputStride int, output DSPSplitC
 	outC.imagp = (*C.float)(&output.Imag[0])
 	C.vDSP_fft_zop(fs.cFFTSetup, &inC, C.vDSP_Stride(inputStride), &outC, C.vDSP_Stride(outputStride), C.vDSP_Length(log2n), C.FFTDirection(direction))
 }
+
+// Zop3 computes an out-of-place single-precision complex discrete Fourier transform of
+// 3*2^log2n elements. The setup must have been created with FFTRadix3.
+func (fs *FFTSetup) Zop3(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	var inC C.DSPSplitComplex
+	inC.realp = (*C.float)(&input.Real[0])
+	inC.imagp = (*C.float)(&input.Imag[0])
+	var outC C.DSPSplitComplex
+	outC.realp = (*C.float)(&output.Real[0])
+	outC.imagp = (*C.float)(&output.Imag[0])
+	C.vDSP_fft3_zop(fs.cFFTSetup, &inC, C.vDSP_Stride(inputStride), &outC, C.vDSP_Stride(outputStride), C.vDSP_Length(log2n), C.FFTDirection(direction))
+}
+
+// Zop5 computes an out-of-place single-precision complex discrete Fourier transform of
+// 5*2^log2n elements. The setup must have been created with FFTRadix5.
+func (fs *FFTSetup) Zop5(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	var inC C.DSPSplitComplex
+	inC.realp = (*C.float)(&input.Real[0])
+	inC.imagp = (*C.float)(&input.Imag[0])
+	var outC C.DSPSplitComplex
+	outC.realp = (*C.float)(&output.Real[0])
+	outC.imagp = (*C.float)(&output.Imag[0])
+	C.vDSP_fft5_zop(fs.cFFTSetup, &inC, C.vDSP_Stride(inputStride), &outC, C.vDSP_Stride(outputStride), C.vDSP_Length(log2n), C.FFTDirection(direction))
+}
+
+// Zip2D computes an in-place single-precision complex two-dimensional discrete Fourier
+// transform of a matrix with 2^log2nRows rows of 2^log2nCols elements. rowStride is
+// the stride between elements of a row and colStride the stride between rows (0 for
+// rowStride*2^log2nCols).
+func (fs *FFTSetup) Zip2D(ioData DSPSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	var splitComplex C.DSPSplitComplex
+	splitComplex.realp = (*C.float)(&ioData.Real[0])
+	splitComplex.imagp = (*C.float)(&ioData.Imag[0])
+	C.vDSP_fft2d_zip(fs.cFFTSetup, &splitComplex, C.vDSP_Stride(rowStride), C.vDSP_Stride(colStride), C.vDSP_Length(log2nCols), C.vDSP_Length(log2nRows), C.FFTDirection(direction))
+}
+
+// Zop2D computes an out-of-place single-precision complex two-dimensional discrete
+// Fourier transform. Strides are interpreted as for Zip2D.
+func (fs *FFTSetup) Zop2D(input DSPSplitComplex, inputRowStride, inputColStride int, output DSPSplitComplex, outputRowStride, outputColStride, log2nCols, log2nRows int, direction FFTDirection) {
+	var inC C.DSPSplitComplex
+	inC.realp = (*C.float)(&input.Real[0])
+	inC.imagp = (*C.float)(&input.Imag[0])
+	var outC C.DSPSplitComplex
+	outC.realp = (*C.float)(&output.Real[0])
+	outC.imagp = (*C.float)(&output.Imag[0])
+	C.vDSP_fft2d_zop(fs.cFFTSetup, &inC, C.vDSP_Stride(inputRowStride), C.vDSP_Stride(inputColStride), &outC, C.vDSP_Stride(outputRowStride), C.vDSP_Stride(outputColStride), C.vDSP_Length(log2nCols), C.vDSP_Length(log2nRows), C.FFTDirection(direction))
+}
+
+// Zrip2D computes an in-place single-precision real two-dimensional discrete Fourier
+// transform. Each row of 2^log2nCols real values is stored as 2^(log2nCols-1) complex
+// elements with even samples in the real part and odd samples in the imaginary part.
+// A colStride of 0 means rowStride*2^(log2nCols-1).
+func (fs *FFTSetup) Zrip2D(ioData DSPSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	var splitComplex C.DSPSplitComplex
+	splitComplex.realp = (*C.float)(&ioData.Real[0])
+	splitComplex.imagp = (*C.float)(&ioData.Imag[0])
+	C.vDSP_fft2d_zrip(fs.cFFTSetup, &splitComplex, C.vDSP_Stride(rowStride), C.vDSP_Stride(colStride), C.vDSP_Length(log2nCols), C.vDSP_Length(log2nRows), C.FFTDirection(direction))
+}
+
+// ZripM computes in-place single-precision real discrete Fourier transforms of count
+// signals, like Zrip. The first elements of consecutive signals are signalStride
+// elements apart.
+func (fs *FFTSetup) ZripM(ioData DSPSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection) {
+	var splitComplex C.DSPSplitComplex
+	splitComplex.realp = (*C.float)(&ioData.Real[0])
+	splitComplex.imagp = (*C.float)(&ioData.Imag[0])
+	C.vDSP_fftm_zrip(fs.cFFTSetup, &splitComplex, C.vDSP_Stride(stride), C.vDSP_Stride(signalStride), C.vDSP_Length(log2n), C.vDSP_Length(count), C.FFTDirection(direction))
+}
+
+// ZropM computes out-of-place single-precision real discrete Fourier transforms of count
+// signals, like Zrop. The first elements of consecutive signals are
+// inputSignalStride and outputSignalStride elements apart.
+func (fs *FFTSetup) ZropM(input DSPSplitComplex, inputStride, inputSignalStride int, output DSPSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	var inC C.DSPSplitComplex
+	inC.realp = (*C.float)(&input.Real[0])
+	inC.imagp = (*C.float)(&input.Imag[0])
+	var outC C.DSPSplitComplex
+	outC.realp = (*C.float)(&output.Real[0])
+	outC.imagp = (*C.float)(&output.Imag[0])
+	C.vDSP_fftm_zrop(fs.cFFTSetup, &inC, C.vDSP_Stride(inputStride), C.vDSP_Stride(inputSignalStride), &outC, C.vDSP_Stride(outputStride), C.vDSP_Stride(outputSignalStride), C.vDSP_Length(log2n), C.vDSP_Length(count), C.FFTDirection(direction))
+}
+
+// ZipM computes in-place single-precision complex discrete Fourier transforms of count
+// signals, like Zip. The first elements of consecutive signals are signalStride
+// elements apart.
+func (fs *FFTSetup) ZipM(ioData DSPSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection) {
+	var splitComplex C.DSPSplitComplex
+	splitComplex.realp = (*C.float)(&ioData.Real[0])
+	splitComplex.imagp = (*C.float)(&ioData.Imag[0])
+	C.vDSP_fftm_zip(fs.cFFTSetup, &splitComplex, C.vDSP_Stride(stride), C.vDSP_Stride(signalStride), C.vDSP_Length(log2n), C.vDSP_Length(count), C.FFTDirection(direction))
+}
+
+// ZopM computes out-of-place single-precision complex discrete Fourier transforms of
+// count signals, like Zop. The first elements of consecutive signals are
+// inputSignalStride and outputSignalStride elements apart.
+func (fs *FFTSetup) ZopM(input DSPSplitComplex, inputStride, inputSignalStride int, output DSPSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	var inC C.DSPSplitComplex
+	inC.realp = (*C.float)(&input.Real[0])
+	inC.imagp = (*C.float)(&input.Imag[0])
+	var outC C.DSPSplitComplex
+	outC.realp = (*C.float)(&output.Real[0])
+	outC.imagp = (*C.float)(&output.Imag[0])
+	C.vDSP_fftm_zop(fs.cFFTSetup, &inC, C.vDSP_Stride(inputStride), C.vDSP_Stride(inputSignalStride), &outC, C.vDSP_Stride(outputStride), C.vDSP_Stride(outputSignalStride), C.vDSP_Length(log2n), C.vDSP_Length(count), C.FFTDirection(direction))
+}
@@ -0,0 +1,199 @@
+package accel
+
+import "math/bits"
+
+// FFT2D computes the two-dimensional discrete Fourier transform of real
+// images whose width and height are powers of two. Like RealFFT it hides
+// vDSP's packed format: the forward transform returns Height() rows of
+// Width()/2+1 complex bins (the non-negative horizontal frequencies), and
+// the inverse takes them back to a real image.
+//
+// The forward transform is unnormalised and the inverse divides by
+// Width()*Height(), so Inverse(Forward(x)) == x.
+//
+// An FFT2D holds scratch buffers and must not be used concurrently.
+type FFT2D struct {
+	width, height int
+	log2w, log2h  int
+	setup         *FFTSetup
+	buf           DSPSplitComplex
+}
+
+// NewFFT2D returns an FFT2D for images of width by height pixels. Both
+// dimensions must be powers of two of at least 2.
+func NewFFT2D(width, height int) (*FFT2D, error) {
+	if width < 2 || width&(width-1) != 0 || height < 2 || height&(height-1) != 0 {
+		return nil, ErrFFTLength
+	}
+	log2w := bits.TrailingZeros(uint(width))
+	log2h := bits.TrailingZeros(uint(height))
+	setup, err := CreateFFTSetup(max(log2w, log2h), FFTRadix2)
+	if err != nil {
+		return nil, err
+	}
+	n := width / 2 * height
+	return &FFT2D{
+		width:  width,
+		height: height,
+		log2w:  log2w,
+		log2h:  log2h,
+		setup:  setup,
+		buf:    DSPSplitComplex{Real: make([]float32, n), Imag: make([]float32, n)},
+	}, nil
+}
+
+// Width returns the number of columns of the images.
+func (f *FFT2D) Width() int {
+	return f.width
+}
+
+// Height returns the number of rows of the images.
+func (f *FFT2D) Height() int {
+	return f.height
+}
+
+// Bins returns the number of complex bins in each row of the spectrum
+// (Width()/2+1).
+func (f *FFT2D) Bins() int {
+	return f.width/2 + 1
+}
+
+// Destroy releases the underlying FFT setup.
+func (f *FFT2D) Destroy() {
+	f.setup.Destroy()
+}
+
+// Forward transforms the Height() rows of Width() samples in src and
+// stores the spectrum in dst, which is allocated if it is too small. It
+// returns dst resliced to Height() rows of Bins().
+func (f *FFT2D) Forward(dst [][]complex64, src [][]float32) [][]complex64 {
+	if len(src) != f.height {
+		panic(ErrLengthMismatch)
+	}
+	for r, row := range src {
+		if len(row) != f.width {
+			panic(ErrLengthMismatch)
+		}
+		Ctoz_float(row, 2, f.row(r), 1)
+	}
+	return f.forward(dst)
+}
+
+// ForwardImage is like Forward but reads a PlanarF image whose dimensions
+// must match the transform.
+func (f *FFT2D) ForwardImage(dst [][]complex64, src *VImageBuffer) [][]complex64 {
+	f.checkImage(src)
+	for r := 0; r < f.height; r++ {
+		row := bytesAsFloat32(src.Data[r*src.RowBytes : r*src.RowBytes+4*f.width])
+		Ctoz_float(row, 2, f.row(r), 1)
+	}
+	return f.forward(dst)
+}
+
+// Inverse transforms the Height() rows of Bins() complex bins in src back
+// to a real image stored in dst, which is allocated if it is too small.
+// Only the real parts of the bins that must be real for a real image are
+// used. It returns dst resliced to Height() rows of Width().
+func (f *FFT2D) Inverse(dst [][]float32, src [][]complex64) [][]float32 {
+	f.inverse(src)
+	if cap(dst) < f.height {
+		dst = make([][]float32, f.height)
+	}
+	dst = dst[:f.height]
+	for r := range dst {
+		dst[r] = growFloat32(dst[r], f.width)
+		Ztoc_float(f.row(r), 1, dst[r], 2)
+	}
+	return dst
+}
+
+// InverseImage is like Inverse but writes a PlanarF image whose
+// dimensions must match the transform.
+func (f *FFT2D) InverseImage(dst *VImageBuffer, src [][]complex64) {
+	f.checkImage(dst)
+	f.inverse(src)
+	for r := 0; r < f.height; r++ {
+		row := bytesAsFloat32(dst.Data[r*dst.RowBytes : r*dst.RowBytes+4*f.width])
+		Ztoc_float(f.row(r), 1, row, 2)
+	}
+}
+
+func (f *FFT2D) checkImage(img *VImageBuffer) {
+	if img.Width != f.width || img.Height != f.height || img.RowBytes < 4*f.width ||
+		len(img.Data) < (f.height-1)*img.RowBytes+4*f.width {
+		panic(ErrLengthMismatch)
+	}
+}
+
+// row returns row r of the packed scratch buffer.
+func (f *FFT2D) row(r int) DSPSplitComplex {
+	half := f.width / 2
+	return DSPSplitComplex{
+		Real: f.buf.Real[r*half : (r+1)*half],
+		Imag: f.buf.Imag[r*half : (r+1)*half],
+	}
+}
+
+// forward transforms the scratch buffer and unpacks it into dst. See
+// fft2dZripGeneric for the packed layout.
+func (f *FFT2D) forward(dst [][]complex64) [][]complex64 {
+	f.setup.Zrip2D(f.buf, 1, f.width/2, f.log2w, f.log2h, FFTDirectionForward)
+	// vDSP scales the forward real transform by 2.
+	Vsmsa(f.buf.Real, 1, 0.5, 0, f.buf.Real, 1)
+	Vsmsa(f.buf.Imag, 1, 0.5, 0, f.buf.Imag, 1)
+	if cap(dst) < f.height {
+		dst = make([][]complex64, f.height)
+	}
+	dst = dst[:f.height]
+	half := f.width / 2
+	for r := range dst {
+		dst[r] = growComplex64(dst[r], f.Bins())
+		Ztoc(f.row(r), 1, dst[r], 2)
+	}
+	re, im := f.buf.Real, f.buf.Imag
+	h := f.height
+	dst[0][0] = complex(re[0], 0)
+	dst[0][half] = complex(im[0], 0)
+	dst[h/2][0] = complex(re[half], 0)
+	dst[h/2][half] = complex(im[half], 0)
+	for k := 1; k < h/2; k++ {
+		i, j := 2*k*half, (2*k+1)*half
+		dc := complex(re[i], re[j])
+		nyq := complex(im[i], im[j])
+		dst[k][0], dst[h-k][0] = dc, conj64(dc)
+		dst[k][half], dst[h-k][half] = nyq, conj64(nyq)
+	}
+	return dst
+}
+
+// inverse packs src into the scratch buffer and transforms it back to a
+// normalised real image.
+func (f *FFT2D) inverse(src [][]complex64) {
+	if len(src) != f.height {
+		panic(ErrLengthMismatch)
+	}
+	half := f.width / 2
+	for r, row := range src {
+		if len(row) != f.Bins() {
+			panic(ErrLengthMismatch)
+		}
+		Ctoz(row[:half], 2, f.row(r), 1)
+	}
+	re, im := f.buf.Real, f.buf.Imag
+	h := f.height
+	re[0], im[0] = real(src[0][0]), real(src[0][half])
+	re[half], im[half] = real(src[h/2][0]), real(src[h/2][half])
+	for k := 1; k < h/2; k++ {
+		i, j := 2*k*half, (2*k+1)*half
+		re[i], re[j] = real(src[k][0]), imag(src[k][0])
+		im[i], im[j] = real(src[k][half]), imag(src[k][half])
+	}
+	f.setup.Zrip2D(f.buf, 1, half, f.log2w, f.log2h, FFTDirectionInverse)
+	scale := 1 / float32(f.width*f.height)
+	Vsmsa(f.buf.Real, 1, scale, 0, f.buf.Real, 1)
+	Vsmsa(f.buf.Imag, 1, scale, 0, f.buf.Imag, 1)
+}
+
+func conj64(v complex64) complex64 {
+	return complex(real(v), -imag(v))
+}
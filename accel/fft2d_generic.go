@@ -0,0 +1,156 @@
+package accel
+
+// Portable implementations of the two-dimensional vDSP FFTs. Element
+// (r, c) of a matrix lives at index r*colStride + c*rowStride where
+// rowStride is the distance between elements of a row and colStride the
+// distance between rows. A colStride of 0 means rows are packed
+// contiguously like vDSP.
+
+func fft2dLoad[T float32 | float64](re, im []T, rowStride, colStride, rows, cols int) [][]complex128 {
+	m := make([][]complex128, rows)
+	for r := range m {
+		m[r] = make([]complex128, cols)
+		for c := range m[r] {
+			i := r*colStride + c*rowStride
+			m[r][c] = complex(float64(re[i]), float64(im[i]))
+		}
+	}
+	return m
+}
+
+func fft2dStore[T float32 | float64](m [][]complex128, re, im []T, rowStride, colStride int) {
+	for r, row := range m {
+		for c, v := range row {
+			i := r*colStride + c*rowStride
+			re[i] = T(real(v))
+			im[i] = T(imag(v))
+		}
+	}
+}
+
+// transform2D computes the unscaled 2D DFT of m in place by transforming
+// every row and then every column.
+func (p *fftPlan) transform2D(m [][]complex128, inverse bool) {
+	for _, row := range m {
+		p.transform(row, inverse)
+	}
+	col := make([]complex128, len(m))
+	for c := range m[0] {
+		for r := range m {
+			col[r] = m[r][c]
+		}
+		p.transform(col, inverse)
+		for r := range m {
+			m[r][c] = col[r]
+		}
+	}
+}
+
+func fft2dZipGeneric[T float32 | float64](p *fftPlan, re, im []T, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	cols, rows := 1<<uint(log2nCols), 1<<uint(log2nRows)
+	if colStride == 0 {
+		colStride = rowStride * cols
+	}
+	m := fft2dLoad(re, im, rowStride, colStride, rows, cols)
+	p.transform2D(m, direction == FFTDirectionInverse)
+	fft2dStore(m, re, im, rowStride, colStride)
+}
+
+func fft2dZopGeneric[T float32 | float64](p *fftPlan, inRe, inIm []T, inputRowStride, inputColStride int, outRe, outIm []T, outputRowStride, outputColStride, log2nCols, log2nRows int, direction FFTDirection) {
+	cols, rows := 1<<uint(log2nCols), 1<<uint(log2nRows)
+	if inputColStride == 0 {
+		inputColStride = inputRowStride * cols
+	}
+	if outputColStride == 0 {
+		outputColStride = outputRowStride * cols
+	}
+	m := fft2dLoad(inRe, inIm, inputRowStride, inputColStride, rows, cols)
+	p.transform2D(m, direction == FFTDirectionInverse)
+	fft2dStore(m, outRe, outIm, outputRowStride, outputColStride)
+}
+
+// fft2dZripGeneric implements vDSP_fft2d_zrip. Each row of the real image
+// is stored as cols/2 complex elements with even samples in the real part
+// and odd samples in the imaginary part. The forward transform is scaled
+// by 2 and packed as documented for vDSP: columns 1 to cols/2-1 hold the
+// complex spectrum, while column 0 holds the real DC column transform in
+// its real part and the real Nyquist column transform in its imaginary
+// part, both packed like a one-dimensional real FFT down the rows.
+func fft2dZripGeneric[T float32 | float64](p *fftPlan, re, im []T, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	cols, rows := 1<<uint(log2nCols), 1<<uint(log2nRows)
+	half := cols / 2
+	if colStride == 0 {
+		colStride = rowStride * half
+	}
+	packed := fft2dLoad(re, im, rowStride, colStride, rows, half)
+	m := make([][]complex128, rows)
+	for r := range m {
+		m[r] = make([]complex128, cols)
+	}
+	if direction == FFTDirectionInverse {
+		fft2dUnpack(packed, m)
+		p.transform2D(m, true)
+		for r, row := range m {
+			for c := range packed[r] {
+				packed[r][c] = complex(real(row[2*c]), real(row[2*c+1]))
+			}
+		}
+	} else {
+		for r, row := range packed {
+			for c, v := range row {
+				m[r][2*c] = complex(real(v), 0)
+				m[r][2*c+1] = complex(imag(v), 0)
+			}
+		}
+		p.transform2D(m, false)
+		fft2dPack(m, packed)
+	}
+	fft2dStore(packed, re, im, rowStride, colStride)
+}
+
+// fft2dPack packs the full spectrum m of a real image into the vDSP
+// format, scaling by 2.
+func fft2dPack(m, packed [][]complex128) {
+	rows, half := len(m), len(packed[0])
+	for r := range packed {
+		for c := 1; c < half; c++ {
+			packed[r][c] = 2 * m[r][c]
+		}
+	}
+	packed[0][0] = complex(2*real(m[0][0]), 2*real(m[0][half]))
+	if rows == 1 {
+		return
+	}
+	packed[1][0] = complex(2*real(m[rows/2][0]), 2*real(m[rows/2][half]))
+	for k := 1; k < rows/2; k++ {
+		dc, nyq := 2*m[k][0], 2*m[k][half]
+		packed[2*k][0] = complex(real(dc), real(nyq))
+		packed[2*k+1][0] = complex(imag(dc), imag(nyq))
+	}
+}
+
+// fft2dUnpack is the inverse of fft2dPack without the scaling. It
+// rebuilds the full spectrum from its Hermitian symmetry.
+func fft2dUnpack(packed, m [][]complex128) {
+	rows, cols, half := len(m), len(m[0]), len(packed[0])
+	conj := func(v complex128) complex128 { return complex(real(v), -imag(v)) }
+	for r := range packed {
+		for c := 1; c < half; c++ {
+			m[r][c] = packed[r][c]
+			m[(rows-r)%rows][cols-c] = conj(packed[r][c])
+		}
+	}
+	m[0][0] = complex(real(packed[0][0]), 0)
+	m[0][half] = complex(imag(packed[0][0]), 0)
+	if rows == 1 {
+		return
+	}
+	m[rows/2][0] = complex(real(packed[1][0]), 0)
+	m[rows/2][half] = complex(imag(packed[1][0]), 0)
+	for k := 1; k < rows/2; k++ {
+		dc := complex(real(packed[2*k][0]), real(packed[2*k+1][0]))
+		nyq := complex(imag(packed[2*k][0]), imag(packed[2*k+1][0]))
+		m[k][0], m[rows-k][0] = dc, conj(dc)
+		m[k][half], m[rows-k][half] = nyq, conj(nyq)
+	}
+}
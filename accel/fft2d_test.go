@@ -0,0 +1,245 @@
+package accel
+
+import (
+	"math"
+	"testing"
+)
+
+// naiveDFT2D computes the 2D DFT of m by transforming rows then columns.
+func naiveDFT2D(m [][]complex128, sign float64) [][]complex128 {
+	out := make([][]complex128, len(m))
+	for r, row := range m {
+		out[r] = naiveDFT(row, sign)
+	}
+	col := make([]complex128, len(m))
+	for c := range out[0] {
+		for r := range out {
+			col[r] = out[r][c]
+		}
+		col = naiveDFT(col, sign)
+		for r := range out {
+			out[r][c] = col[r]
+		}
+	}
+	return out
+}
+
+func testImage(rows, cols int) [][]float64 {
+	img := make([][]float64, rows)
+	for r := range img {
+		img[r] = make([]float64, cols)
+		for c := range img[r] {
+			img[r][c] = math.Sin(float64(r)*0.7+float64(c)*0.3) + 0.1*float64(r-c)
+		}
+	}
+	return img
+}
+
+func TestFFTZip2D(t *testing.T) {
+	const log2nCols, log2nRows = 3, 2
+	cols, rows := 1<<log2nCols, 1<<log2nRows
+	fft, err := CreateFFTSetup(log2nCols, FFTRadix2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fft.Destroy()
+	input := make([][]complex128, rows)
+	// Store the matrix with a row stride of 2 and padded rows.
+	const rowStride, colStride = 2, 20
+	data := DSPSplitComplex{Real: make([]float32, rows*colStride), Imag: make([]float32, rows*colStride)}
+	for r := range input {
+		input[r] = make([]complex128, cols)
+		for c := range input[r] {
+			input[r][c] = complex(math.Sin(float64(r*cols+c)*0.4), float64(c-r)*0.25)
+			data.Real[r*colStride+c*rowStride] = float32(real(input[r][c]))
+			data.Imag[r*colStride+c*rowStride] = float32(imag(input[r][c]))
+		}
+	}
+	fft.Zip2D(data, rowStride, colStride, log2nCols, log2nRows, FFTDirectionForward)
+	expected := naiveDFT2D(input, -1)
+	for r := range expected {
+		for c, e := range expected[r] {
+			i := r*colStride + c*rowStride
+			if !almostEqual64(float64(data.Real[i]), real(e), 1e-3) || !almostEqual64(float64(data.Imag[i]), imag(e), 1e-3) {
+				t.Errorf("Zip2D forward [%d,%d] = (%f,%f); want %v", r, c, data.Real[i], data.Imag[i], e)
+			}
+		}
+	}
+
+	output := DSPSplitComplex{Real: make([]float32, rows*cols), Imag: make([]float32, rows*cols)}
+	fft.Zop2D(data, rowStride, colStride, output, 1, 0, log2nCols, log2nRows, FFTDirectionInverse)
+	scale := float64(rows * cols)
+	for r := range input {
+		for c, x := range input[r] {
+			i := r*cols + c
+			if !almostEqual64(float64(output.Real[i])/scale, real(x), 1e-4) || !almostEqual64(float64(output.Imag[i])/scale, imag(x), 1e-4) {
+				t.Errorf("Zop2D round trip [%d,%d] = (%f,%f); want %v", r, c, output.Real[i], output.Imag[i], x)
+			}
+		}
+	}
+}
+
+func TestFFTZrip2DPacking(t *testing.T) {
+	const log2nCols, log2nRows = 3, 3
+	cols, rows := 1<<log2nCols, 1<<log2nRows
+	half := cols / 2
+	fft, err := CreateFFTSetupD(log2nCols, FFTRadix2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fft.Destroy()
+	img := testImage(rows, cols)
+	input := make([][]complex128, rows)
+	data := DSPDoubleSplitComplex{Real: make([]float64, rows*half), Imag: make([]float64, rows*half)}
+	for r := range img {
+		input[r] = make([]complex128, cols)
+		for c, v := range img[r] {
+			input[r][c] = complex(v, 0)
+		}
+		for c := 0; c < half; c++ {
+			data.Real[r*half+c] = img[r][2*c]
+			data.Imag[r*half+c] = img[r][2*c+1]
+		}
+	}
+	fft.Zrip2D(data, 1, 0, log2nCols, log2nRows, FFTDirectionForward)
+	x := naiveDFT2D(input, -1)
+	check := func(name string, got, want float64) {
+		if !almostEqual64(got, 2*want, 1e-9) {
+			t.Errorf("%s = %f; want %f", name, got, 2*want)
+		}
+	}
+	check("DC", data.Real[0], real(x[0][0]))
+	check("Nyquist column DC", data.Imag[0], real(x[0][half]))
+	check("Nyquist row DC", data.Real[half], real(x[rows/2][0]))
+	check("Nyquist", data.Imag[half], real(x[rows/2][half]))
+	for k := 1; k < rows/2; k++ {
+		check("Re DC column", data.Real[2*k*half], real(x[k][0]))
+		check("Im DC column", data.Real[(2*k+1)*half], imag(x[k][0]))
+		check("Re Nyquist column", data.Imag[2*k*half], real(x[k][half]))
+		check("Im Nyquist column", data.Imag[(2*k+1)*half], imag(x[k][half]))
+	}
+	for r := 0; r < rows; r++ {
+		for c := 1; c < half; c++ {
+			check("Re", data.Real[r*half+c], real(x[r][c]))
+			check("Im", data.Imag[r*half+c], imag(x[r][c]))
+		}
+	}
+
+	fft.Zrip2D(data, 1, 0, log2nCols, log2nRows, FFTDirectionInverse)
+	scale := float64(2 * rows * cols)
+	for r := range img {
+		for c := 0; c < half; c++ {
+			if !almostEqual64(data.Real[r*half+c]/scale, img[r][2*c], 1e-12) || !almostEqual64(data.Imag[r*half+c]/scale, img[r][2*c+1], 1e-12) {
+				t.Errorf("Zrip2D round trip row %d pair %d = (%f,%f); want (%f,%f)", r, c,
+					data.Real[r*half+c]/scale, data.Imag[r*half+c]/scale, img[r][2*c], img[r][2*c+1])
+			}
+		}
+	}
+}
+
+func TestFFT2D(t *testing.T) {
+	for _, size := range [][2]int{{2, 2}, {8, 4}, {4, 16}, {32, 32}} {
+		width, height := size[0], size[1]
+		f, err := NewFFT2D(width, height)
+		if err != nil {
+			t.Fatal(err)
+		}
+		img := testImage(height, width)
+		src := make([][]float32, height)
+		input := make([][]complex128, height)
+		for r := range img {
+			src[r] = make([]float32, width)
+			input[r] = make([]complex128, width)
+			for c, v := range img[r] {
+				src[r][c] = float32(v)
+				input[r][c] = complex(float64(src[r][c]), 0)
+			}
+		}
+		spectrum := f.Forward(nil, src)
+		want := naiveDFT2D(input, -1)
+		for r := range spectrum {
+			if len(spectrum[r]) != f.Bins() {
+				t.Fatalf("%dx%d: row %d has %d bins; want %d", width, height, r, len(spectrum[r]), f.Bins())
+			}
+			for c, v := range spectrum[r] {
+				if !almostEqual64(float64(real(v)), real(want[r][c]), 1e-3) || !almostEqual64(float64(imag(v)), imag(want[r][c]), 1e-3) {
+					t.Errorf("%dx%d: Forward [%d,%d] = %v; want %v", width, height, r, c, v, want[r][c])
+				}
+			}
+		}
+		back := f.Inverse(nil, spectrum)
+		for r := range back {
+			for c, v := range back[r] {
+				if !almostEqual64(float64(v), float64(src[r][c]), 1e-4) {
+					t.Errorf("%dx%d: round trip [%d,%d] = %f; want %f", width, height, r, c, v, src[r][c])
+				}
+			}
+		}
+		f.Destroy()
+	}
+}
+
+func TestFFT2DImage(t *testing.T) {
+	const width, height = 8, 4
+	f, err := NewFFT2D(width, height)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Destroy()
+	// Rows are padded to 48 bytes.
+	src := &VImageBuffer{Width: width, Height: height, RowBytes: 48, Data: make([]byte, 48*height)}
+	rows := make([][]float32, height)
+	for r := range rows {
+		rows[r] = bytesAsFloat32(src.Data[r*src.RowBytes:])[:width]
+		for c := range rows[r] {
+			rows[r][c] = float32(r*width+c) * 0.125
+		}
+	}
+	spectrum := f.ForwardImage(nil, src)
+	want := f.Forward(nil, rows)
+	for r := range want {
+		for c := range want[r] {
+			if spectrum[r][c] != want[r][c] {
+				t.Errorf("ForwardImage [%d,%d] = %v; want %v", r, c, spectrum[r][c], want[r][c])
+			}
+		}
+	}
+	dst := &VImageBuffer{Width: width, Height: height, RowBytes: 4 * width, Data: make([]byte, 4*width*height)}
+	f.InverseImage(dst, spectrum)
+	out := bytesAsFloat32(dst.Data)
+	for r := range rows {
+		for c, v := range rows[r] {
+			if !almostEqual64(float64(out[r*width+c]), float64(v), 1e-4) {
+				t.Errorf("InverseImage [%d,%d] = %f; want %f", r, c, out[r*width+c], v)
+			}
+		}
+	}
+}
+
+func TestFFT2DSize(t *testing.T) {
+	for _, size := range [][2]int{{0, 4}, {1, 4}, {4, 1}, {6, 4}, {4, 12}} {
+		if _, err := NewFFT2D(size[0], size[1]); err != ErrFFTLength {
+			t.Errorf("NewFFT2D(%d, %d) returned %v; want %v", size[0], size[1], err, ErrFFTLength)
+		}
+	}
+}
+
+func BenchmarkFFT2DForward(b *testing.B) {
+	f, err := NewFFT2D(64, 64)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer f.Destroy()
+	src := make([][]float32, 64)
+	for r := range src {
+		src[r] = make([]float32, 64)
+		for c := range src[r] {
+			src[r][c] = float32(r ^ c)
+		}
+	}
+	var dst [][]complex64
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = f.Forward(dst, src)
+	}
+}
@@ -0,0 +1,223 @@
+package accel
+
+import (
+	"math"
+	"math/bits"
+	"math/cmplx"
+)
+
+// maxFFTLog2n is the largest transform a pure Go FFT setup will accept.
+const maxFFTLog2n = 30
+
+// fftPlan holds the twiddle factors for the pure Go radix-2 FFT. Like a
+// vDSP setup a plan created for log2n can be used for any smaller size.
+type fftPlan struct {
+	log2n   int
+	radix   FFTRadix
+	twiddle []complex128 // exp(-2πik/N) for k < N/2, N = 1<<log2n
+}
+
+func newFFTPlan(log2n int, radix FFTRadix) (*fftPlan, error) {
+	if log2n < 0 || log2n > maxFFTLog2n {
+		return nil, ErrFailedToCreateFFTSetup
+	}
+	switch radix {
+	case FFTRadix2, FFTRadix3, FFTRadix5:
+	default:
+		return nil, ErrFailedToCreateFFTSetup
+	}
+	n := 1 << uint(log2n)
+	p := &fftPlan{log2n: log2n, radix: radix, twiddle: make([]complex128, n/2)}
+	for k := range p.twiddle {
+		s, c := math.Sincos(-2 * math.Pi * float64(k) / float64(n))
+		p.twiddle[k] = complex(c, s)
+	}
+	return p, nil
+}
+
+// transform computes the unscaled in-place DFT of buf whose length must be
+// a power of two no larger than the plan. The inverse uses exp(+2πi/N).
+func (p *fftPlan) transform(buf []complex128, inverse bool) {
+	n := len(buf)
+	if n < 2 {
+		return
+	}
+	log2n := bits.TrailingZeros(uint(n))
+	if log2n > p.log2n || n != 1<<uint(log2n) {
+		panic("accel: FFT length not supported by setup")
+	}
+	shift := uint(bits.UintSize - log2n)
+	for i := 0; i < n; i++ {
+		if j := int(bits.Reverse(uint(i)) >> shift); j > i {
+			buf[i], buf[j] = buf[j], buf[i]
+		}
+	}
+	for size := 2; size <= n; size <<= 1 {
+		half := size / 2
+		step := len(p.twiddle) * 2 / size
+		for start := 0; start < n; start += size {
+			for k := 0; k < half; k++ {
+				w := p.twiddle[k*step]
+				if inverse {
+					w = cmplx.Conj(w)
+				}
+				a := buf[start+k]
+				b := buf[start+k+half] * w
+				buf[start+k] = a + b
+				buf[start+k+half] = a - b
+			}
+		}
+	}
+}
+
+// transformMixed computes the unscaled DFT of buf whose length is radix
+// (3 or 5) times a power of two no larger than the plan. The radix
+// interleaved subsequences are transformed with the radix-2 code and then
+// combined with a direct DFT across them.
+func (p *fftPlan) transformMixed(buf []complex128, radix int, inverse bool) {
+	n := len(buf)
+	l := n / radix
+	sub := make([]complex128, n)
+	for r := 0; r < radix; r++ {
+		s := sub[r*l : (r+1)*l]
+		for j := range s {
+			s[j] = buf[j*radix+r]
+		}
+		p.transform(s, inverse)
+	}
+	sign := -1.0
+	if inverse {
+		sign = 1
+	}
+	for k := 0; k < n; k++ {
+		sum := sub[k%l]
+		for r := 1; r < radix; r++ {
+			s, c := math.Sincos(sign * 2 * math.Pi * float64(r*k%n) / float64(n))
+			sum += sub[r*l+k%l] * complex(c, s)
+		}
+		buf[k] = sum
+	}
+}
+
+// realForward computes the vDSP packed forward real FFT of the n real
+// values x. The result is scaled by 2 like vDSP_fft_zrip: out[0] holds
+// DC in its real part and Nyquist in its imaginary part.
+func (p *fftPlan) realForward(x []float64, out []complex128) {
+	n := len(x)
+	buf := make([]complex128, n)
+	for i, v := range x {
+		buf[i] = complex(v, 0)
+	}
+	p.transform(buf, false)
+	out[0] = complex(2*real(buf[0]), 2*real(buf[n/2]))
+	for k := 1; k < n/2; k++ {
+		out[k] = 2 * buf[k]
+	}
+}
+
+// realInverse is the inverse of realForward without normalisation, so a
+// forward and inverse round trip scales the signal by 2N.
+func (p *fftPlan) realInverse(in []complex128, x []float64) {
+	n := len(x)
+	buf := make([]complex128, n)
+	buf[0] = complex(real(in[0]), 0)
+	buf[n/2] = complex(imag(in[0]), 0)
+	for k := 1; k < n/2; k++ {
+		buf[k] = in[k]
+		buf[n-k] = cmplx.Conj(in[k])
+	}
+	p.transform(buf, true)
+	for i := range x {
+		x[i] = real(buf[i])
+	}
+}
+
+func loadSplit[T float32 | float64](re, im []T, stride int, buf []complex128) {
+	for i := range buf {
+		buf[i] = complex(float64(re[i*stride]), float64(im[i*stride]))
+	}
+}
+
+func storeSplit[T float32 | float64](buf []complex128, re, im []T, stride int) {
+	for i, v := range buf {
+		re[i*stride] = T(real(v))
+		im[i*stride] = T(imag(v))
+	}
+}
+
+func fftZipGeneric[T float32 | float64](p *fftPlan, re, im []T, stride, log2n int, direction FFTDirection) {
+	buf := make([]complex128, 1<<uint(log2n))
+	loadSplit(re, im, stride, buf)
+	p.transform(buf, direction == FFTDirectionInverse)
+	storeSplit(buf, re, im, stride)
+}
+
+func fftZopGeneric[T float32 | float64](p *fftPlan, inRe, inIm []T, inputStride int, outRe, outIm []T, outputStride, log2n int, direction FFTDirection) {
+	buf := make([]complex128, 1<<uint(log2n))
+	loadSplit(inRe, inIm, inputStride, buf)
+	p.transform(buf, direction == FFTDirectionInverse)
+	storeSplit(buf, outRe, outIm, outputStride)
+}
+
+// fftZropGeneric implements vDSP_fft_zrop (and zrip when input and output
+// are the same). Real signals are stored with even samples in the real
+// part and odd samples in the imaginary part.
+func fftZropGeneric[T float32 | float64](p *fftPlan, inRe, inIm []T, inputStride int, outRe, outIm []T, outputStride, log2n int, direction FFTDirection) {
+	n := 1 << uint(log2n)
+	if n < 2 {
+		return
+	}
+	packed := make([]complex128, n/2)
+	x := make([]float64, n)
+	if direction == FFTDirectionInverse {
+		loadSplit(inRe, inIm, inputStride, packed)
+		p.realInverse(packed, x)
+		for k := range packed {
+			packed[k] = complex(x[2*k], x[2*k+1])
+		}
+	} else {
+		for k := 0; k < n/2; k++ {
+			x[2*k] = float64(inRe[k*inputStride])
+			x[2*k+1] = float64(inIm[k*inputStride])
+		}
+		p.realForward(x, packed)
+	}
+	storeSplit(packed, outRe, outIm, outputStride)
+}
+
+// fftZopRadixGeneric implements vDSP_fft3_zop and vDSP_fft5_zop which
+// transform radix<<log2n elements. Like vDSP it requires a setup created
+// with the matching FFTRadix.
+func fftZopRadixGeneric[T float32 | float64](p *fftPlan, inRe, inIm []T, inputStride int, outRe, outIm []T, outputStride, log2n, radix int, direction FFTDirection) {
+	if (radix == 3 && p.radix != FFTRadix3) || (radix == 5 && p.radix != FFTRadix5) {
+		panic("accel: FFT setup not created with the required radix")
+	}
+	buf := make([]complex128, radix<<uint(log2n))
+	loadSplit(inRe, inIm, inputStride, buf)
+	p.transformMixed(buf, radix, direction == FFTDirectionInverse)
+	storeSplit(buf, outRe, outIm, outputStride)
+}
+
+// The fftm functions transform count signals whose first elements are
+// signalStride elements apart by looping over the single-signal code.
+
+func fftmZipGeneric[T float32 | float64](p *fftPlan, re, im []T, stride, signalStride, log2n, count int, direction FFTDirection) {
+	for m := 0; m < count; m++ {
+		o := m * signalStride
+		fftZipGeneric(p, re[o:], im[o:], stride, log2n, direction)
+	}
+}
+
+func fftmZopGeneric[T float32 | float64](p *fftPlan, inRe, inIm []T, inputStride, inputSignalStride int, outRe, outIm []T, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	for m := 0; m < count; m++ {
+		i, o := m*inputSignalStride, m*outputSignalStride
+		fftZopGeneric(p, inRe[i:], inIm[i:], inputStride, outRe[o:], outIm[o:], outputStride, log2n, direction)
+	}
+}
+
+func fftmZropGeneric[T float32 | float64](p *fftPlan, inRe, inIm []T, inputStride, inputSignalStride int, outRe, outIm []T, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	for m := 0; m < count; m++ {
+		i, o := m*inputSignalStride, m*outputSignalStride
+		fftZropGeneric(p, inRe[i:], inIm[i:], inputStride, outRe[o:], outIm[o:], outputStride, log2n, direction)
+	}
+}
@@ -0,0 +1,109 @@
+//go:build !darwin || !cgo || purego
+
+package accel
+
+type FFTSetup struct {
+	plan *fftPlan
+}
+
+func CreateFFTSetup(log2n int, radix FFTRadix) (*FFTSetup, error) {
+	plan, err := newFFTPlan(log2n, radix)
+	if err != nil {
+		return nil, err
+	}
+	return &FFTSetup{plan}, nil
+}
+
+func (fs *FFTSetup) Destroy() {
+	fs.plan = nil
+}
+
+// Zrip computess an in-place single-precision real discrete Fourier transform of the
+// input/output vector signal, either from the time domain to the frequency domain
+// (forward) or from the frequency domain to the time domain (inverse).
+func (fs *FFTSetup) Zrip(ioData DSPSplitComplex, stride, log2n int, direction FFTDirection) {
+	fftZropGeneric(fs.plan, ioData.Real, ioData.Imag, stride, ioData.Real, ioData.Imag, stride, log2n, direction)
+}
+
+// Zrop computes an out-of-place single-precision real discrete Fourier transform of the
+// input vector, either from the time domain to the frequency domain (forward) or from the
+// frequency domain to the time domain (inverse).
+func (fs *FFTSetup) Zrop(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	fftZropGeneric(fs.plan, input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride, log2n, direction)
+}
+
+// Zip computess an in-place single-precision complex discrete Fourier transform of the
+// input/output vector signal, either from the time domain to the frequency domain
+// (forward) or from the frequency domain to the time domain (inverse).
+func (fs *FFTSetup) Zip(ioData DSPSplitComplex, stride, log2n int, direction FFTDirection) {
+	fftZipGeneric(fs.plan, ioData.Real, ioData.Imag, stride, log2n, direction)
+}
+
+// Zop computes an out-of-place single-precision complex discrete Fourier transform of the
+// input vector, either from the time domain to the frequency domain (forward) or from the
+// frequency domain to the time domain (inverse).
+func (fs *FFTSetup) Zop(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	fftZopGeneric(fs.plan, input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride, log2n, direction)
+}
+
+// Zop3 computes an out-of-place single-precision complex discrete Fourier transform of
+// 3*2^log2n elements. The setup must have been created with FFTRadix3.
+func (fs *FFTSetup) Zop3(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	fftZopRadixGeneric(fs.plan, input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride, log2n, 3, direction)
+}
+
+// Zop5 computes an out-of-place single-precision complex discrete Fourier transform of
+// 5*2^log2n elements. The setup must have been created with FFTRadix5.
+func (fs *FFTSetup) Zop5(input DSPSplitComplex, inputStride int, output DSPSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	fftZopRadixGeneric(fs.plan, input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride, log2n, 5, direction)
+}
+
+// Zip2D computes an in-place single-precision complex two-dimensional discrete Fourier
+// transform of a matrix with 2^log2nRows rows of 2^log2nCols elements. rowStride is
+// the stride between elements of a row and colStride the stride between rows (0 for
+// rowStride*2^log2nCols).
+func (fs *FFTSetup) Zip2D(ioData DSPSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	fft2dZipGeneric(fs.plan, ioData.Real, ioData.Imag, rowStride, colStride, log2nCols, log2nRows, direction)
+}
+
+// Zop2D computes an out-of-place single-precision complex two-dimensional discrete
+// Fourier transform. Strides are interpreted as for Zip2D.
+func (fs *FFTSetup) Zop2D(input DSPSplitComplex, inputRowStride, inputColStride int, output DSPSplitComplex, outputRowStride, outputColStride, log2nCols, log2nRows int, direction FFTDirection) {
+	fft2dZopGeneric(fs.plan, input.Real, input.Imag, inputRowStride, inputColStride, output.Real, output.Imag, outputRowStride, outputColStride, log2nCols, log2nRows, direction)
+}
+
+// Zrip2D computes an in-place single-precision real two-dimensional discrete Fourier
+// transform. Each row of 2^log2nCols real values is stored as 2^(log2nCols-1) complex
+// elements with even samples in the real part and odd samples in the imaginary part.
+// A colStride of 0 means rowStride*2^(log2nCols-1).
+func (fs *FFTSetup) Zrip2D(ioData DSPSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	fft2dZripGeneric(fs.plan, ioData.Real, ioData.Imag, rowStride, colStride, log2nCols, log2nRows, direction)
+}
+
+// ZripM computes in-place single-precision real discrete Fourier transforms of count
+// signals, like Zrip. The first elements of consecutive signals are signalStride
+// elements apart.
+func (fs *FFTSetup) ZripM(ioData DSPSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection) {
+	fftmZropGeneric(fs.plan, ioData.Real, ioData.Imag, stride, signalStride, ioData.Real, ioData.Imag, stride, signalStride, log2n, count, direction)
+}
+
+// ZropM computes out-of-place single-precision real discrete Fourier transforms of count
+// signals, like Zrop. The first elements of consecutive signals are
+// inputSignalStride and outputSignalStride elements apart.
+func (fs *FFTSetup) ZropM(input DSPSplitComplex, inputStride, inputSignalStride int, output DSPSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	fftmZropGeneric(fs.plan, input.Real, input.Imag, inputStride, inputSignalStride, output.Real, output.Imag, outputStride, outputSignalStride, log2n, count, direction)
+}
+
+// ZipM computes in-place single-precision complex discrete Fourier transforms of count
+// signals, like Zip. The first elements of consecutive signals are signalStride
+// elements apart.
+func (fs *FFTSetup) ZipM(ioData DSPSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection) {
+	fftmZipGeneric(fs.plan, ioData.Real, ioData.Imag, stride, signalStride, log2n, count, direction)
+}
+
+// ZopM computes out-of-place single-precision complex discrete Fourier transforms of
+// count signals, like Zop. The first elements of consecutive signals are
+// inputSignalStride and outputSignalStride elements apart.
+func (fs *FFTSetup) ZopM(input DSPSplitComplex, inputStride, inputSignalStride int, output DSPSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	fftmZopGeneric(fs.plan, input.Real, input.Imag, inputStride, inputSignalStride, output.Real, output.Imag, outputStride, outputSignalStride, log2n, count, direction)
+}
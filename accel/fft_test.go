@@ -1,6 +1,123 @@
 package accel
 
-import "testing"
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func naiveDFT(input []complex128, sign float64) []complex128 {
+	n := len(input)
+	out := make([]complex128, n)
+	for k := 0; k < n; k++ {
+		var sum complex128
+		for j, x := range input {
+			sum += x * cmplx.Exp(complex(0, sign*2*math.Pi*float64(j*k)/float64(n)))
+		}
+		out[k] = sum
+	}
+	return out
+}
+
+func TestFFTZip(t *testing.T) {
+	const log2n = 6
+	n := 1 << log2n
+	fft, err := CreateFFTSetup(log2n+2, FFTRadix2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fft.Destroy()
+	input := make([]complex128, n)
+	data := DSPSplitComplex{Real: make([]float32, n), Imag: make([]float32, n)}
+	for i := range input {
+		input[i] = complex(math.Sin(float64(i)*0.3)+0.25, math.Cos(float64(i)*0.7))
+		data.Real[i] = float32(real(input[i]))
+		data.Imag[i] = float32(imag(input[i]))
+	}
+	fft.Zip(data, 1, log2n, FFTDirectionForward)
+	expected := naiveDFT(input, -1)
+	for i, e := range expected {
+		if !almostEqual64(float64(data.Real[i]), real(e), 1e-3) || !almostEqual64(float64(data.Imag[i]), imag(e), 1e-3) {
+			t.Errorf("Zip forward [%d] = (%f,%f); want %v", i, data.Real[i], data.Imag[i], e)
+		}
+	}
+	fft.Zip(data, 1, log2n, FFTDirectionInverse)
+	for i, x := range input {
+		if !almostEqual64(float64(data.Real[i])/float64(n), real(x), 1e-4) || !almostEqual64(float64(data.Imag[i])/float64(n), imag(x), 1e-4) {
+			t.Errorf("Zip round trip [%d] = (%f,%f); want %v", i, data.Real[i]/float32(n), data.Imag[i]/float32(n), x)
+		}
+	}
+}
+
+func TestFFTZripPacking(t *testing.T) {
+	const log2n = 5
+	n := 1 << log2n
+	fft, err := CreateFFTSetup(log2n, FFTRadix2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fft.Destroy()
+	signal := make([]float32, n)
+	input := make([]complex128, n)
+	for i := range signal {
+		signal[i] = float32(math.Cos(2*math.Pi*3*float64(i)/float64(n)) + 0.5*float64(i%3))
+		input[i] = complex(float64(signal[i]), 0)
+	}
+	data := DSPSplitComplex{Real: make([]float32, n/2), Imag: make([]float32, n/2)}
+	Ctoz_float(signal, 2, data, 1)
+	fft.Zrip(data, 1, log2n, FFTDirectionForward)
+	expected := naiveDFT(input, -1)
+	if !almostEqual64(float64(data.Real[0]), 2*real(expected[0]), 1e-3) {
+		t.Errorf("Zrip DC = %f; want %f", data.Real[0], 2*real(expected[0]))
+	}
+	if !almostEqual64(float64(data.Imag[0]), 2*real(expected[n/2]), 1e-3) {
+		t.Errorf("Zrip Nyquist = %f; want %f", data.Imag[0], 2*real(expected[n/2]))
+	}
+	for k := 1; k < n/2; k++ {
+		if !almostEqual64(float64(data.Real[k]), 2*real(expected[k]), 1e-3) || !almostEqual64(float64(data.Imag[k]), 2*imag(expected[k]), 1e-3) {
+			t.Errorf("Zrip [%d] = (%f,%f); want 2*%v", k, data.Real[k], data.Imag[k], expected[k])
+		}
+	}
+	fft.Zrip(data, 1, log2n, FFTDirectionInverse)
+	output := make([]float32, n)
+	Ztoc_float(data, 1, output, 2)
+	for i, x := range signal {
+		if v := output[i] / float32(2*n); !almostEqual32(v, x, maxFloatDiffErr) {
+			t.Errorf("Zrip round trip [%d] = %f; want %f", i, v, x)
+		}
+	}
+}
+
+// Zrop must run the real transform out of place, matching Zrip on a copy.
+func TestFFTZrop(t *testing.T) {
+	const log2n = 5
+	n := 1 << log2n
+	fft, err := CreateFFTSetup(log2n, FFTRadix2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fft.Destroy()
+	signal := make([]float32, n)
+	for i := range signal {
+		signal[i] = float32(math.Sin(0.4*float64(i)) + 0.1*float64(i%5))
+	}
+	input := DSPSplitComplex{Real: make([]float32, n/2), Imag: make([]float32, n/2)}
+	Ctoz_float(signal, 2, input, 1)
+	want := DSPSplitComplex{Real: append([]float32(nil), input.Real...), Imag: append([]float32(nil), input.Imag...)}
+	fft.Zrip(want, 1, log2n, FFTDirectionForward)
+	output := DSPSplitComplex{Real: make([]float32, n/2), Imag: make([]float32, n/2)}
+	fft.Zrop(input, 1, output, 1, log2n, FFTDirectionForward)
+	for k := range want.Real {
+		if !almostEqual32(output.Real[k], want.Real[k], 1e-3) || !almostEqual32(output.Imag[k], want.Imag[k], 1e-3) {
+			t.Errorf("Zrop [%d] = (%f,%f); Zrip gave (%f,%f)", k, output.Real[k], output.Imag[k], want.Real[k], want.Imag[k])
+		}
+	}
+	for i := 0; i < n/2; i++ {
+		if input.Real[i] != signal[2*i] || input.Imag[i] != signal[2*i+1] {
+			t.Fatalf("Zrop modified its input at %d", i)
+		}
+	}
+}
 
 func BenchmarkFFTZip10Radix2(b *testing.B) {
 	fft, err := CreateFFTSetup(10, FFTRadix2)
@@ -16,3 +133,92 @@ func BenchmarkFFTZip10Radix2(b *testing.B) {
 		fft.Zip(samples, 1, 10, FFTDirectionForward)
 	}
 }
+
+func TestFFTZipM(t *testing.T) {
+	const log2n, count = 5, 7
+	n := 1 << log2n
+	fft, err := CreateFFTSetup(log2n, FFTRadix2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fft.Destroy()
+	// Signals are interleaved: element i of signal m is at i*count+m.
+	batch := DSPSplitComplex{Real: make([]float32, n*count), Imag: make([]float32, n*count)}
+	single := make([]DSPSplitComplex, count)
+	for m := range single {
+		single[m] = DSPSplitComplex{Real: make([]float32, n), Imag: make([]float32, n)}
+		for i := 0; i < n; i++ {
+			re, im := float32(math.Sin(float64(i*m)*0.1)), float32(i-m)
+			single[m].Real[i], single[m].Imag[i] = re, im
+			batch.Real[i*count+m], batch.Imag[i*count+m] = re, im
+		}
+	}
+	fft.ZipM(batch, count, 1, log2n, count, FFTDirectionForward)
+	for m, s := range single {
+		fft.Zip(s, 1, log2n, FFTDirectionForward)
+		for i := 0; i < n; i++ {
+			if !almostEqual64(float64(batch.Real[i*count+m]), float64(s.Real[i]), 1e-3) || !almostEqual64(float64(batch.Imag[i*count+m]), float64(s.Imag[i]), 1e-3) {
+				t.Errorf("ZipM signal %d [%d] = (%f,%f); want (%f,%f)", m, i, batch.Real[i*count+m], batch.Imag[i*count+m], s.Real[i], s.Imag[i])
+			}
+		}
+	}
+
+	output := DSPSplitComplex{Real: make([]float32, n*count), Imag: make([]float32, n*count)}
+	fft.ZopM(batch, count, 1, output, 1, n, log2n, count, FFTDirectionInverse)
+	for m, s := range single {
+		fft.Zip(s, 1, log2n, FFTDirectionInverse)
+		for i := 0; i < n; i++ {
+			if !almostEqual64(float64(output.Real[m*n+i]), float64(s.Real[i]), 1e-3) || !almostEqual64(float64(output.Imag[m*n+i]), float64(s.Imag[i]), 1e-3) {
+				t.Errorf("ZopM signal %d [%d] = (%f,%f); want (%f,%f)", m, i, output.Real[m*n+i], output.Imag[m*n+i], s.Real[i], s.Imag[i])
+			}
+		}
+	}
+}
+
+func TestFFTZropMDouble(t *testing.T) {
+	const log2n, count = 4, 3
+	half := 1 << (log2n - 1)
+	fft, err := CreateFFTSetupD(log2n, FFTRadix2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fft.Destroy()
+	input := DSPDoubleSplitComplex{Real: make([]float64, half*count), Imag: make([]float64, half*count)}
+	for i := range input.Real {
+		input.Real[i] = math.Cos(float64(i) * 0.3)
+		input.Imag[i] = float64(i%5) - 2
+	}
+	output := DSPDoubleSplitComplex{Real: make([]float64, half*count), Imag: make([]float64, half*count)}
+	fft.ZropM(input, 1, half, output, 1, half, log2n, count, FFTDirectionForward)
+	ioData := cloneSplitD(input)
+	fft.ZripM(ioData, 1, half, log2n, count, FFTDirectionForward)
+	want := DSPDoubleSplitComplex{Real: make([]float64, half), Imag: make([]float64, half)}
+	for m := 0; m < count; m++ {
+		sig := DSPDoubleSplitComplex{Real: input.Real[m*half:], Imag: input.Imag[m*half:]}
+		fft.Zrop(sig, 1, want, 1, log2n, FFTDirectionForward)
+		for i := 0; i < half; i++ {
+			j := m*half + i
+			if !almostEqual64(output.Real[j], want.Real[i], 1e-9) || !almostEqual64(output.Imag[j], want.Imag[i], 1e-9) {
+				t.Errorf("ZropM signal %d [%d] = (%f,%f); want (%f,%f)", m, i, output.Real[j], output.Imag[j], want.Real[i], want.Imag[i])
+			}
+			if !almostEqual64(ioData.Real[j], want.Real[i], 1e-9) || !almostEqual64(ioData.Imag[j], want.Imag[i], 1e-9) {
+				t.Errorf("ZripM signal %d [%d] = (%f,%f); want (%f,%f)", m, i, ioData.Real[j], ioData.Imag[j], want.Real[i], want.Imag[i])
+			}
+		}
+	}
+}
+
+func BenchmarkFFTZipM10x64(b *testing.B) {
+	const log2n, count = 10, 64
+	fft, err := CreateFFTSetup(log2n, FFTRadix2)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer fft.Destroy()
+	n := 1 << log2n
+	data := DSPSplitComplex{Real: make([]float32, n*count), Imag: make([]float32, n*count)}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		fft.ZipM(data, 1, n, log2n, count, FFTDirectionForward)
+	}
+}
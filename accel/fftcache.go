@@ -0,0 +1,128 @@
+package accel
+
+import "sync"
+
+// DefaultFFTPlanCacheSize is the number of setups of each precision kept
+// by the process-wide cache used by FFTPlanFor and FFTPlanForD.
+const DefaultFFTPlanCacheSize = 8
+
+var defaultFFTPlanCache = NewFFTPlanCache(DefaultFFTPlanCacheSize)
+
+// FFTPlanFor returns a shared single-precision setup from the process-wide
+// cache that can transform 2^log2n elements with the given radix. See
+// FFTPlanCache for the rules on sharing.
+func FFTPlanFor(log2n int, radix FFTRadix) (*FFTSetup, error) {
+	return defaultFFTPlanCache.Setup(log2n, radix)
+}
+
+// FFTPlanForD is the double-precision counterpart of FFTPlanFor.
+func FFTPlanForD(log2n int, radix FFTRadix) (*FFTSetupD, error) {
+	return defaultFFTPlanCache.SetupD(log2n, radix)
+}
+
+// CloseFFTPlans destroys every setup held by the process-wide cache.
+func CloseFFTPlans() {
+	defaultFFTPlanCache.Close()
+}
+
+// FFTPlanCache shares FFT setups between callers. A setup created for
+// log2n can be used for any smaller transform, so a request is served by
+// the smallest cached setup of the same radix that is large enough, and a
+// new setup is only created when none is.
+//
+// The transform methods only read a setup, so a cached setup may be used
+// by any number of goroutines at once. Callers must not Destroy it.
+//
+// When more than the configured number of setups of one precision are
+// cached the least recently used is dropped. It is not destroyed, since
+// callers may still hold it, and is released once it becomes unreachable.
+// Close destroys all cached setups and must only be called once none of
+// them are in use; the cache remains usable afterwards.
+type FFTPlanCache struct {
+	mu       sync.Mutex
+	maxPlans int
+	tick     uint64
+	single   []cachedFFTSetup[*FFTSetup]
+	double   []cachedFFTSetup[*FFTSetupD]
+}
+
+type cachedFFTSetup[S interface{ Destroy() }] struct {
+	setup S
+	log2n int
+	radix FFTRadix
+	used  uint64
+}
+
+// NewFFTPlanCache returns a cache that keeps at most maxPlans setups of
+// each precision. maxPlans is raised to 1 if it is smaller.
+func NewFFTPlanCache(maxPlans int) *FFTPlanCache {
+	return &FFTPlanCache{maxPlans: max(maxPlans, 1)}
+}
+
+// Setup returns a cached single-precision setup for 2^log2n elements,
+// creating it if necessary.
+func (c *FFTPlanCache) Setup(log2n int, radix FFTRadix) (*FFTSetup, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return cacheFFTSetup(c, &c.single, log2n, radix, CreateFFTSetup)
+}
+
+// SetupD returns a cached double-precision setup for 2^log2n elements,
+// creating it if necessary.
+func (c *FFTPlanCache) SetupD(log2n int, radix FFTRadix) (*FFTSetupD, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return cacheFFTSetup(c, &c.double, log2n, radix, CreateFFTSetupD)
+}
+
+// Len returns the number of cached setups of both precisions.
+func (c *FFTPlanCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.single) + len(c.double)
+}
+
+// Close destroys and forgets every cached setup.
+func (c *FFTPlanCache) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, s := range c.single {
+		s.setup.Destroy()
+	}
+	for _, s := range c.double {
+		s.setup.Destroy()
+	}
+	c.single = nil
+	c.double = nil
+}
+
+// cacheFFTSetup implements Setup and SetupD. c.mu must be held, which also
+// keeps concurrent callers from creating duplicate setups.
+func cacheFFTSetup[S interface{ Destroy() }](c *FFTPlanCache, list *[]cachedFFTSetup[S], log2n int, radix FFTRadix, create func(int, FFTRadix) (S, error)) (S, error) {
+	c.tick++
+	best := -1
+	for i, s := range *list {
+		if log2n >= 0 && s.radix == radix && s.log2n >= log2n && (best < 0 || s.log2n < (*list)[best].log2n) {
+			best = i
+		}
+	}
+	if best >= 0 {
+		(*list)[best].used = c.tick
+		return (*list)[best].setup, nil
+	}
+	setup, err := create(log2n, radix)
+	if err != nil {
+		return setup, err
+	}
+	if len(*list) >= c.maxPlans {
+		lru := 0
+		for i, s := range *list {
+			if s.used < (*list)[lru].used {
+				lru = i
+			}
+		}
+		*list = append((*list)[:lru], (*list)[lru+1:]...)
+	}
+	*list = append(*list, cachedFFTSetup[S]{setup: setup, log2n: log2n, radix: radix, used: c.tick})
+	return setup, nil
+}
@@ -0,0 +1,137 @@
+package accel
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"testing"
+)
+
+func TestFFTPlanCacheReuse(t *testing.T) {
+	c := NewFFTPlanCache(4)
+	defer c.Close()
+	big, err := c.Setup(10, FFTRadix2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	small, err := c.Setup(6, FFTRadix2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if small != big {
+		t.Error("smaller transform did not reuse the larger setup")
+	}
+	radix3, err := c.Setup(6, FFTRadix3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if radix3 == big {
+		t.Error("radix 3 request returned the radix 2 setup")
+	}
+	bigD, err := c.SetupD(8, FFTRadix2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := c.SetupD(8, FFTRadix2); again != bigD {
+		t.Error("SetupD did not return the cached setup")
+	}
+	if c.Len() != 3 {
+		t.Errorf("Len() = %d; want 3", c.Len())
+	}
+	if _, err := c.Setup(-1, FFTRadix2); err != ErrFailedToCreateFFTSetup {
+		t.Errorf("Setup(-1) returned %v; want %v", err, ErrFailedToCreateFFTSetup)
+	}
+	if c.Len() != 3 {
+		t.Errorf("failed Setup changed Len() to %d", c.Len())
+	}
+}
+
+func TestFFTPlanCacheEviction(t *testing.T) {
+	c := NewFFTPlanCache(2)
+	defer c.Close()
+	s4, _ := c.Setup(4, FFTRadix2)
+	s4r3, _ := c.Setup(4, FFTRadix3)
+	// Touch the radix 2 setup so the radix 3 one is least recently used.
+	if s, _ := c.Setup(3, FFTRadix2); s != s4 {
+		t.Fatal("expected cached radix 2 setup")
+	}
+	if _, err := c.Setup(4, FFTRadix5); err != nil {
+		t.Fatal(err)
+	}
+	if c.Len() != 2 {
+		t.Errorf("Len() = %d; want 2", c.Len())
+	}
+	if s, _ := c.Setup(4, FFTRadix2); s != s4 {
+		t.Error("most recently used setup was evicted")
+	}
+	if s, _ := c.Setup(4, FFTRadix3); s == s4r3 {
+		t.Error("least recently used setup was not evicted")
+	}
+
+	c.Close()
+	if c.Len() != 0 {
+		t.Errorf("Len() after Close = %d; want 0", c.Len())
+	}
+	if _, err := c.Setup(4, FFTRadix2); err != nil {
+		t.Errorf("Setup after Close returned %v", err)
+	}
+}
+
+func TestFFTPlanForConcurrentZip(t *testing.T) {
+	const log2n = 8
+	n := 1 << log2n
+	input := DSPSplitComplex{Real: make([]float32, n), Imag: make([]float32, n)}
+	for i := range input.Real {
+		input.Real[i] = float32(math.Sin(float64(i) * 0.05))
+		input.Imag[i] = float32(math.Cos(float64(i) * 0.11))
+	}
+	c := NewFFTPlanCache(4)
+	defer c.Close()
+	setup, err := c.Setup(log2n, FFTRadix2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := cloneSplit(input)
+	setup.Zip(want, 1, log2n, FFTDirectionForward)
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 16)
+	for g := 0; g < 16; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Every goroutine asks the cache so lookups race as well. A
+			// setup for a larger log2n also transforms log2n elements, but
+			// may round differently, so it is compared with want within a
+			// tolerance and with its own first result exactly.
+			fft, err := c.Setup(log2n+g%3, FFTRadix2)
+			if err != nil {
+				errs <- err.Error()
+				return
+			}
+			ref := cloneSplit(input)
+			fft.Zip(ref, 1, log2n, FFTDirectionForward)
+			for i := range ref.Real {
+				if !almostEqual32(ref.Real[i], want.Real[i], 1e-3) || !almostEqual32(ref.Imag[i], want.Imag[i], 1e-3) {
+					errs <- fmt.Sprintf("Zip with a log2n %d setup differs at %d", log2n+g%3, i)
+					return
+				}
+			}
+			for iter := 0; iter < 20; iter++ {
+				data := cloneSplit(input)
+				fft.Zip(data, 1, log2n, FFTDirectionForward)
+				for i := range data.Real {
+					if data.Real[i] != ref.Real[i] || data.Imag[i] != ref.Imag[i] {
+						errs <- "concurrent Zip produced a different result"
+						return
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for e := range errs {
+		t.Error(e)
+	}
+}
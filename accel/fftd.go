@@ -1,3 +1,5 @@
+//go:build darwin && cgo && !purego
+
 package accel
 
 // #include <Accelerate/Accelerate.h>
@@ -30,7 +32,7 @@ func destroyFFTSetupD(fftSetup *FFTSetupD) {
 	}
 }
 
-// Zrip computess an in-place single-precision real discrete Fourier transform of the
+// Zrip computess an in-place double-precision real discrete Fourier transform of the
 // input/output vector signal, either from the time domain to the frequency domain
 // (forward) or from the frequency domain to the time domain (inverse).
 func (fs *FFTSetupD) Zrip(ioData DSPDoubleSplitComplex, stride, log2n int, direction FFTDirection) {
@@ -40,7 +42,7 @@ func (fs *FFTSetupD) Zrip(ioData DSPDoubleSplitComplex, stride, log2n int, direc
 	C.vDSP_fft_zripD(fs.cFFTSetupD, &splitComplex, C.vDSP_Stride(stride), C.vDSP_Length(log2n), C.FFTDirection(direction))
 }
 
-// Zop computes an out-of-place single-precision real discrete Fourier transform of the
+// Zrop computes an out-of-place double-precision real discrete Fourier transform of the
 // input vector, either from the time domain to the frequency domain (forward) or from the
 // frequency domain to the time domain (inverse).
 func (fs *FFTSetupD) Zrop(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection) {
@@ -50,10 +52,10 @@ func (fs *FFTSetupD) Zrop(input DSPDoubleSplitComplex, inputStride int, output D
 	var outC C.DSPDoubleSplitComplex
 	outC.realp = (*C.double)(&output.Real[0])
 	outC.imagp = (*C.double)(&output.Imag[0])
-	C.vDSP_fft_zopD(fs.cFFTSetupD, &inC, C.vDSP_Stride(inputStride), &outC, C.vDSP_Stride(outputStride), C.vDSP_Length(log2n), C.FFTDirection(direction))
+	C.vDSP_fft_zropD(fs.cFFTSetupD, &inC, C.vDSP_Stride(inputStride), &outC, C.vDSP_Stride(outputStride), C.vDSP_Length(log2n), C.FFTDirection(direction))
 }
 
-// Zip computess an in-place single-precision complex discrete Fourier transform of the
+// Zip computess an in-place double-precision complex discrete Fourier transform of the
 // input/output vector signal, either from the time domain to the frequency domain
 // (forward) or from the frequency domain to the time domain (inverse).
 func (fs *FFTSetupD) Zip(ioData DSPDoubleSplitComplex, stride, log2n int, direction FFTDirection) {
@@ -63,7 +65,7 @@ func (fs *FFTSetupD) Zip(ioData DSPDoubleSplitComplex, stride, log2n int, direct
 	C.vDSP_fft_zipD(fs.cFFTSetupD, &splitComplex, C.vDSP_Stride(stride), C.vDSP_Length(log2n), C.FFTDirection(direction))
 }
 
-// Zop computes an out-of-place single-precision complex discrete Fourier transform of the
+// Zop computes an out-of-place double-precision complex discrete Fourier transform of the
 // input vector, either from the time domain to the frequency domain (forward) or from the
 // frequency domain to the time domain (inverse).
 func (fs *FFTSetupD) Zop(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection) {
@@ -75,3 +77,107 @@ func (fs *FFTSetupD) Zop(input DSPDoubleSplitComplex, inputStride int, output DS
 	outC.imagp = (*C.double)(&output.Imag[0])
 	C.vDSP_fft_zopD(fs.cFFTSetupD, &inC, C.vDSP_Stride(inputStride), &outC, C.vDSP_Stride(outputStride), C.vDSP_Length(log2n), C.FFTDirection(direction))
 }
+
+// Zop3 computes an out-of-place double-precision complex discrete Fourier transform of
+// 3*2^log2n elements. The setup must have been created with FFTRadix3.
+func (fs *FFTSetupD) Zop3(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	var inC C.DSPDoubleSplitComplex
+	inC.realp = (*C.double)(&input.Real[0])
+	inC.imagp = (*C.double)(&input.Imag[0])
+	var outC C.DSPDoubleSplitComplex
+	outC.realp = (*C.double)(&output.Real[0])
+	outC.imagp = (*C.double)(&output.Imag[0])
+	C.vDSP_fft3_zopD(fs.cFFTSetupD, &inC, C.vDSP_Stride(inputStride), &outC, C.vDSP_Stride(outputStride), C.vDSP_Length(log2n), C.FFTDirection(direction))
+}
+
+// Zop5 computes an out-of-place double-precision complex discrete Fourier transform of
+// 5*2^log2n elements. The setup must have been created with FFTRadix5.
+func (fs *FFTSetupD) Zop5(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	var inC C.DSPDoubleSplitComplex
+	inC.realp = (*C.double)(&input.Real[0])
+	inC.imagp = (*C.double)(&input.Imag[0])
+	var outC C.DSPDoubleSplitComplex
+	outC.realp = (*C.double)(&output.Real[0])
+	outC.imagp = (*C.double)(&output.Imag[0])
+	C.vDSP_fft5_zopD(fs.cFFTSetupD, &inC, C.vDSP_Stride(inputStride), &outC, C.vDSP_Stride(outputStride), C.vDSP_Length(log2n), C.FFTDirection(direction))
+}
+
+// Zip2D computes an in-place double-precision complex two-dimensional discrete Fourier
+// transform of a matrix with 2^log2nRows rows of 2^log2nCols elements. rowStride is
+// the stride between elements of a row and colStride the stride between rows (0 for
+// rowStride*2^log2nCols).
+func (fs *FFTSetupD) Zip2D(ioData DSPDoubleSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	var splitComplex C.DSPDoubleSplitComplex
+	splitComplex.realp = (*C.double)(&ioData.Real[0])
+	splitComplex.imagp = (*C.double)(&ioData.Imag[0])
+	C.vDSP_fft2d_zipD(fs.cFFTSetupD, &splitComplex, C.vDSP_Stride(rowStride), C.vDSP_Stride(colStride), C.vDSP_Length(log2nCols), C.vDSP_Length(log2nRows), C.FFTDirection(direction))
+}
+
+// Zop2D computes an out-of-place double-precision complex two-dimensional discrete
+// Fourier transform. Strides are interpreted as for Zip2D.
+func (fs *FFTSetupD) Zop2D(input DSPDoubleSplitComplex, inputRowStride, inputColStride int, output DSPDoubleSplitComplex, outputRowStride, outputColStride, log2nCols, log2nRows int, direction FFTDirection) {
+	var inC C.DSPDoubleSplitComplex
+	inC.realp = (*C.double)(&input.Real[0])
+	inC.imagp = (*C.double)(&input.Imag[0])
+	var outC C.DSPDoubleSplitComplex
+	outC.realp = (*C.double)(&output.Real[0])
+	outC.imagp = (*C.double)(&output.Imag[0])
+	C.vDSP_fft2d_zopD(fs.cFFTSetupD, &inC, C.vDSP_Stride(inputRowStride), C.vDSP_Stride(inputColStride), &outC, C.vDSP_Stride(outputRowStride), C.vDSP_Stride(outputColStride), C.vDSP_Length(log2nCols), C.vDSP_Length(log2nRows), C.FFTDirection(direction))
+}
+
+// Zrip2D computes an in-place double-precision real two-dimensional discrete Fourier
+// transform. Each row of 2^log2nCols real values is stored as 2^(log2nCols-1) complex
+// elements with even samples in the real part and odd samples in the imaginary part.
+// A colStride of 0 means rowStride*2^(log2nCols-1).
+func (fs *FFTSetupD) Zrip2D(ioData DSPDoubleSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	var splitComplex C.DSPDoubleSplitComplex
+	splitComplex.realp = (*C.double)(&ioData.Real[0])
+	splitComplex.imagp = (*C.double)(&ioData.Imag[0])
+	C.vDSP_fft2d_zripD(fs.cFFTSetupD, &splitComplex, C.vDSP_Stride(rowStride), C.vDSP_Stride(colStride), C.vDSP_Length(log2nCols), C.vDSP_Length(log2nRows), C.FFTDirection(direction))
+}
+
+// ZripM computes in-place double-precision real discrete Fourier transforms of count
+// signals, like Zrip. The first elements of consecutive signals are signalStride
+// elements apart.
+func (fs *FFTSetupD) ZripM(ioData DSPDoubleSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection) {
+	var splitComplex C.DSPDoubleSplitComplex
+	splitComplex.realp = (*C.double)(&ioData.Real[0])
+	splitComplex.imagp = (*C.double)(&ioData.Imag[0])
+	C.vDSP_fftm_zripD(fs.cFFTSetupD, &splitComplex, C.vDSP_Stride(stride), C.vDSP_Stride(signalStride), C.vDSP_Length(log2n), C.vDSP_Length(count), C.FFTDirection(direction))
+}
+
+// ZropM computes out-of-place double-precision real discrete Fourier transforms of count
+// signals, like Zrop. The first elements of consecutive signals are
+// inputSignalStride and outputSignalStride elements apart.
+func (fs *FFTSetupD) ZropM(input DSPDoubleSplitComplex, inputStride, inputSignalStride int, output DSPDoubleSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	var inC C.DSPDoubleSplitComplex
+	inC.realp = (*C.double)(&input.Real[0])
+	inC.imagp = (*C.double)(&input.Imag[0])
+	var outC C.DSPDoubleSplitComplex
+	outC.realp = (*C.double)(&output.Real[0])
+	outC.imagp = (*C.double)(&output.Imag[0])
+	C.vDSP_fftm_zropD(fs.cFFTSetupD, &inC, C.vDSP_Stride(inputStride), C.vDSP_Stride(inputSignalStride), &outC, C.vDSP_Stride(outputStride), C.vDSP_Stride(outputSignalStride), C.vDSP_Length(log2n), C.vDSP_Length(count), C.FFTDirection(direction))
+}
+
+// ZipM computes in-place double-precision complex discrete Fourier transforms of count
+// signals, like Zip. The first elements of consecutive signals are signalStride
+// elements apart.
+func (fs *FFTSetupD) ZipM(ioData DSPDoubleSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection) {
+	var splitComplex C.DSPDoubleSplitComplex
+	splitComplex.realp = (*C.double)(&ioData.Real[0])
+	splitComplex.imagp = (*C.double)(&ioData.Imag[0])
+	C.vDSP_fftm_zipD(fs.cFFTSetupD, &splitComplex, C.vDSP_Stride(stride), C.vDSP_Stride(signalStride), C.vDSP_Length(log2n), C.vDSP_Length(count), C.FFTDirection(direction))
+}
+
+// ZopM computes out-of-place double-precision complex discrete Fourier transforms of
+// count signals, like Zop. The first elements of consecutive signals are
+// inputSignalStride and outputSignalStride elements apart.
+func (fs *FFTSetupD) ZopM(input DSPDoubleSplitComplex, inputStride, inputSignalStride int, output DSPDoubleSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	var inC C.DSPDoubleSplitComplex
+	inC.realp = (*C.double)(&input.Real[0])
+	inC.imagp = (*C.double)(&input.Imag[0])
+	var outC C.DSPDoubleSplitComplex
+	outC.realp = (*C.double)(&output.Real[0])
+	outC.imagp = (*C.double)(&output.Imag[0])
+	C.vDSP_fftm_zopD(fs.cFFTSetupD, &inC, C.vDSP_Stride(inputStride), C.vDSP_Stride(inputSignalStride), &outC, C.vDSP_Stride(outputStride), C.vDSP_Stride(outputSignalStride), C.vDSP_Length(log2n), C.vDSP_Length(count), C.FFTDirection(direction))
+}
@@ -0,0 +1,109 @@
+//go:build !darwin || !cgo || purego
+
+package accel
+
+type FFTSetupD struct {
+	plan *fftPlan
+}
+
+func CreateFFTSetupD(log2n int, radix FFTRadix) (*FFTSetupD, error) {
+	plan, err := newFFTPlan(log2n, radix)
+	if err != nil {
+		return nil, err
+	}
+	return &FFTSetupD{plan}, nil
+}
+
+func (fs *FFTSetupD) Destroy() {
+	fs.plan = nil
+}
+
+// Zrip computess an in-place double-precision real discrete Fourier transform of the
+// input/output vector signal, either from the time domain to the frequency domain
+// (forward) or from the frequency domain to the time domain (inverse).
+func (fs *FFTSetupD) Zrip(ioData DSPDoubleSplitComplex, stride, log2n int, direction FFTDirection) {
+	fftZropGeneric(fs.plan, ioData.Real, ioData.Imag, stride, ioData.Real, ioData.Imag, stride, log2n, direction)
+}
+
+// Zrop computes an out-of-place double-precision real discrete Fourier transform of the
+// input vector, either from the time domain to the frequency domain (forward) or from the
+// frequency domain to the time domain (inverse).
+func (fs *FFTSetupD) Zrop(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	fftZropGeneric(fs.plan, input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride, log2n, direction)
+}
+
+// Zip computess an in-place double-precision complex discrete Fourier transform of the
+// input/output vector signal, either from the time domain to the frequency domain
+// (forward) or from the frequency domain to the time domain (inverse).
+func (fs *FFTSetupD) Zip(ioData DSPDoubleSplitComplex, stride, log2n int, direction FFTDirection) {
+	fftZipGeneric(fs.plan, ioData.Real, ioData.Imag, stride, log2n, direction)
+}
+
+// Zop computes an out-of-place double-precision complex discrete Fourier transform of the
+// input vector, either from the time domain to the frequency domain (forward) or from the
+// frequency domain to the time domain (inverse).
+func (fs *FFTSetupD) Zop(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	fftZopGeneric(fs.plan, input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride, log2n, direction)
+}
+
+// Zop3 computes an out-of-place double-precision complex discrete Fourier transform of
+// 3*2^log2n elements. The setup must have been created with FFTRadix3.
+func (fs *FFTSetupD) Zop3(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	fftZopRadixGeneric(fs.plan, input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride, log2n, 3, direction)
+}
+
+// Zop5 computes an out-of-place double-precision complex discrete Fourier transform of
+// 5*2^log2n elements. The setup must have been created with FFTRadix5.
+func (fs *FFTSetupD) Zop5(input DSPDoubleSplitComplex, inputStride int, output DSPDoubleSplitComplex, outputStride int, log2n int, direction FFTDirection) {
+	fftZopRadixGeneric(fs.plan, input.Real, input.Imag, inputStride, output.Real, output.Imag, outputStride, log2n, 5, direction)
+}
+
+// Zip2D computes an in-place double-precision complex two-dimensional discrete Fourier
+// transform of a matrix with 2^log2nRows rows of 2^log2nCols elements. rowStride is
+// the stride between elements of a row and colStride the stride between rows (0 for
+// rowStride*2^log2nCols).
+func (fs *FFTSetupD) Zip2D(ioData DSPDoubleSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	fft2dZipGeneric(fs.plan, ioData.Real, ioData.Imag, rowStride, colStride, log2nCols, log2nRows, direction)
+}
+
+// Zop2D computes an out-of-place double-precision complex two-dimensional discrete
+// Fourier transform. Strides are interpreted as for Zip2D.
+func (fs *FFTSetupD) Zop2D(input DSPDoubleSplitComplex, inputRowStride, inputColStride int, output DSPDoubleSplitComplex, outputRowStride, outputColStride, log2nCols, log2nRows int, direction FFTDirection) {
+	fft2dZopGeneric(fs.plan, input.Real, input.Imag, inputRowStride, inputColStride, output.Real, output.Imag, outputRowStride, outputColStride, log2nCols, log2nRows, direction)
+}
+
+// Zrip2D computes an in-place double-precision real two-dimensional discrete Fourier
+// transform. Each row of 2^log2nCols real values is stored as 2^(log2nCols-1) complex
+// elements with even samples in the real part and odd samples in the imaginary part.
+// A colStride of 0 means rowStride*2^(log2nCols-1).
+func (fs *FFTSetupD) Zrip2D(ioData DSPDoubleSplitComplex, rowStride, colStride, log2nCols, log2nRows int, direction FFTDirection) {
+	fft2dZripGeneric(fs.plan, ioData.Real, ioData.Imag, rowStride, colStride, log2nCols, log2nRows, direction)
+}
+
+// ZripM computes in-place double-precision real discrete Fourier transforms of count
+// signals, like Zrip. The first elements of consecutive signals are signalStride
+// elements apart.
+func (fs *FFTSetupD) ZripM(ioData DSPDoubleSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection) {
+	fftmZropGeneric(fs.plan, ioData.Real, ioData.Imag, stride, signalStride, ioData.Real, ioData.Imag, stride, signalStride, log2n, count, direction)
+}
+
+// ZropM computes out-of-place double-precision real discrete Fourier transforms of count
+// signals, like Zrop. The first elements of consecutive signals are
+// inputSignalStride and outputSignalStride elements apart.
+func (fs *FFTSetupD) ZropM(input DSPDoubleSplitComplex, inputStride, inputSignalStride int, output DSPDoubleSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	fftmZropGeneric(fs.plan, input.Real, input.Imag, inputStride, inputSignalStride, output.Real, output.Imag, outputStride, outputSignalStride, log2n, count, direction)
+}
+
+// ZipM computes in-place double-precision complex discrete Fourier transforms of count
+// signals, like Zip. The first elements of consecutive signals are signalStride
+// elements apart.
+func (fs *FFTSetupD) ZipM(ioData DSPDoubleSplitComplex, stride, signalStride, log2n, count int, direction FFTDirection) {
+	fftmZipGeneric(fs.plan, ioData.Real, ioData.Imag, stride, signalStride, log2n, count, direction)
+}
+
+// ZopM computes out-of-place double-precision complex discrete Fourier transforms of
+// count signals, like Zop. The first elements of consecutive signals are
+// inputSignalStride and outputSignalStride elements apart.
+func (fs *FFTSetupD) ZopM(input DSPDoubleSplitComplex, inputStride, inputSignalStride int, output DSPDoubleSplitComplex, outputStride, outputSignalStride, log2n, count int, direction FFTDirection) {
+	fftmZopGeneric(fs.plan, input.Real, input.Imag, inputStride, inputSignalStride, output.Real, output.Imag, outputStride, outputSignalStride, log2n, count, direction)
+}
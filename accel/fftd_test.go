@@ -1,6 +1,32 @@
 package accel
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
+
+func TestFFTDoubleZop(t *testing.T) {
+	const log2n = 4
+	n := 1 << log2n
+	fft, err := CreateFFTSetupD(log2n, FFTRadix2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fft.Destroy()
+	input := make([]complex128, n)
+	in := DSPDoubleSplitComplex{Real: make([]float64, n), Imag: make([]float64, n)}
+	for i := range input {
+		input[i] = complex(float64(i), math.Sin(float64(i)))
+		in.Real[i], in.Imag[i] = real(input[i]), imag(input[i])
+	}
+	out := DSPDoubleSplitComplex{Real: make([]float64, n*2), Imag: make([]float64, n*2)}
+	fft.Zop(in, 1, out, 2, log2n, FFTDirectionForward)
+	for i, e := range naiveDFT(input, -1) {
+		if !almostEqual64(out.Real[i*2], real(e), 1e-9) || !almostEqual64(out.Imag[i*2], imag(e), 1e-9) {
+			t.Errorf("Zop [%d] = (%f,%f); want %v", i, out.Real[i*2], out.Imag[i*2], e)
+		}
+	}
+}
 
 func BenchmarkFFTDoubleZip10Radix2(b *testing.B) {
 	fft, err := CreateFFTSetupD(10, FFTRadix2)
@@ -0,0 +1,187 @@
+package accel
+
+import (
+	"math"
+	"math/bits"
+)
+
+type fftKind int
+
+const (
+	fftKindTrivial fftKind = iota
+	fftKindRadix2
+	fftKindRadix3
+	fftKindRadix5
+	fftKindBluestein
+)
+
+// minMixedRadixLog2n is the smallest power of two factor used with the
+// radix 3 and 5 transforms. Shorter lengths go through Bluestein.
+const minMixedRadixLog2n = 3
+
+// FFT computes complex discrete Fourier transforms of any length. Lengths
+// of the form 2^k use the radix-2 transform, 3·2^k and 5·2^k use the
+// radix-3 and radix-5 transforms (vDSP_fft3_zop and vDSP_fft5_zop), and
+// every other length uses Bluestein's chirp-z algorithm on top of a
+// power of two transform.
+//
+// An FFT holds scratch buffers and must not be used concurrently.
+type FFT struct {
+	n     int
+	kind  fftKind
+	log2n int // log2 of the power of two factor, or of the Bluestein length
+	setup *FFTSetup
+
+	in, out DSPSplitComplex // scratch for Forward and Inverse
+
+	// Bluestein state. chirp holds e^(iπk²/N), kernel the conjugated and
+	// 1/M scaled transform of the chirp filter, work and tmp are scratch.
+	chirp, kernel, work, tmp DSPSplitComplex
+}
+
+// NewFFT returns an FFT for n complex elements. n must be at least 1.
+func NewFFT(n int) (*FFT, error) {
+	if n < 1 {
+		return nil, ErrFFTLength
+	}
+	f := &FFT{
+		n:   n,
+		in:  makeSplit(n),
+		out: makeSplit(n),
+	}
+	if n == 1 {
+		f.kind = fftKindTrivial
+		return f, nil
+	}
+	log2n := bits.TrailingZeros(uint(n))
+	radix := FFTRadix2
+	switch odd := n >> uint(log2n); {
+	case odd == 1:
+		f.kind = fftKindRadix2
+	case odd == 3 && log2n >= minMixedRadixLog2n:
+		f.kind, radix = fftKindRadix3, FFTRadix3
+	case odd == 5 && log2n >= minMixedRadixLog2n:
+		f.kind, radix = fftKindRadix5, FFTRadix5
+	default:
+		f.kind = fftKindBluestein
+		log2n = bits.Len(uint(2*n - 2))
+	}
+	setup, err := CreateFFTSetup(log2n, radix)
+	if err != nil {
+		return nil, err
+	}
+	f.log2n = log2n
+	f.setup = setup
+	if f.kind == fftKindBluestein {
+		f.initBluestein()
+	}
+	return f, nil
+}
+
+func (f *FFT) initBluestein() {
+	n, m := f.n, 1<<uint(f.log2n)
+	f.chirp = makeSplit(n)
+	f.kernel = makeSplit(m)
+	f.work = makeSplit(m)
+	f.tmp = makeSplit(n)
+	for k := 0; k < n; k++ {
+		// k² mod 2N keeps the angle exact for large k.
+		s, c := math.Sincos(math.Pi * float64(int64(k)*int64(k)%int64(2*n)) / float64(n))
+		f.chirp.Real[k], f.chirp.Imag[k] = float32(c), float32(s)
+		f.kernel.Real[k], f.kernel.Imag[k] = float32(c), float32(s)
+		if k > 0 {
+			f.kernel.Real[m-k], f.kernel.Imag[m-k] = float32(c), float32(s)
+		}
+	}
+	f.setup.Zip(f.kernel, 1, f.log2n, FFTDirectionForward)
+	scale := 1 / float32(m)
+	Vsmsa(f.kernel.Real, 1, scale, 0, f.kernel.Real, 1)
+	Vsmsa(f.kernel.Imag, 1, -scale, 0, f.kernel.Imag, 1)
+}
+
+// Len returns the number of elements the transform operates on.
+func (f *FFT) Len() int {
+	return f.n
+}
+
+// Destroy releases the underlying FFT setup.
+func (f *FFT) Destroy() {
+	if f.setup != nil {
+		f.setup.Destroy()
+	}
+}
+
+// Transform computes the unscaled DFT of the first Len() elements of input
+// into output like FFTSetup.Zop: the forward direction uses e^(-2πi/N) and
+// the inverse e^(+2πi/N) without dividing by N. input and output must not
+// overlap.
+func (f *FFT) Transform(input, output DSPSplitComplex, direction FFTDirection) {
+	n := f.n
+	if len(input.Real) < n || len(input.Imag) < n || len(output.Real) < n || len(output.Imag) < n {
+		panic(ErrLengthMismatch)
+	}
+	switch f.kind {
+	case fftKindTrivial:
+		output.Real[0], output.Imag[0] = input.Real[0], input.Imag[0]
+	case fftKindRadix2:
+		f.setup.Zop(input, 1, output, 1, f.log2n, direction)
+	case fftKindRadix3:
+		f.setup.Zop3(input, 1, output, 1, f.log2n, direction)
+	case fftKindRadix5:
+		f.setup.Zop5(input, 1, output, 1, f.log2n, direction)
+	case fftKindBluestein:
+		if direction == FFTDirectionInverse {
+			// The inverse is conj(DFT(conj(x))).
+			copy(f.tmp.Real, input.Real[:n])
+			Vneg(input.Imag[:n], 1, f.tmp.Imag, 1)
+			f.bluestein(f.tmp, output)
+			Vneg(output.Imag[:n], 1, output.Imag[:n], 1)
+		} else {
+			f.bluestein(input, output)
+		}
+	}
+}
+
+// bluestein computes the forward DFT as a convolution of the chirp
+// modulated input with the chirp filter.
+func (f *FFT) bluestein(input, output DSPSplitComplex) {
+	n := f.n
+	Vclr(f.work.Real[n:], 1)
+	Vclr(f.work.Imag[n:], 1)
+	Zvcmul(f.chirp, 1, input, 1, f.work, 1)
+	f.setup.Zip(f.work, 1, f.log2n, FFTDirectionForward)
+	Zvcmul(f.kernel, 1, f.work, 1, f.work, 1)
+	f.setup.Zip(f.work, 1, f.log2n, FFTDirectionInverse)
+	Zvcmul(f.chirp, 1, f.work, 1, output, 1)
+}
+
+// Forward computes the DFT of the Len() elements of src into dst, which is
+// allocated if it is too small. It returns dst resliced to Len().
+func (f *FFT) Forward(dst, src []complex64) []complex64 {
+	return f.run(dst, src, FFTDirectionForward, 1)
+}
+
+// Inverse computes the inverse DFT of src divided by Len() into dst so
+// that Inverse(Forward(x)) == x. dst is allocated if it is too small.
+func (f *FFT) Inverse(dst, src []complex64) []complex64 {
+	return f.run(dst, src, FFTDirectionInverse, 1/float32(f.n))
+}
+
+func (f *FFT) run(dst, src []complex64, direction FFTDirection, scale float32) []complex64 {
+	if len(src) != f.n {
+		panic(ErrLengthMismatch)
+	}
+	dst = growComplex64(dst, f.n)
+	Ctoz(src, 2, f.in, 1)
+	f.Transform(f.in, f.out, direction)
+	if scale != 1 {
+		Vsmsa(f.out.Real, 1, scale, 0, f.out.Real, 1)
+		Vsmsa(f.out.Imag, 1, scale, 0, f.out.Imag, 1)
+	}
+	Ztoc(f.out, 1, dst, 2)
+	return dst
+}
+
+func makeSplit(n int) DSPSplitComplex {
+	return DSPSplitComplex{Real: make([]float32, n), Imag: make([]float32, n)}
+}
@@ -0,0 +1,124 @@
+package accel
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestFFTAnyLength(t *testing.T) {
+	for _, n := range []int{1, 2, 7, 12, 16, 24, 40, 100, 160, 1000} {
+		fft, err := NewFFT(n)
+		if err != nil {
+			t.Fatalf("NewFFT(%d): %v", n, err)
+		}
+		input := make([]complex128, n)
+		src := make([]complex64, n)
+		for i := range input {
+			input[i] = complex(math.Sin(float64(i)*0.37)+0.2, math.Cos(float64(i)*1.3))
+			src[i] = complex64(input[i])
+		}
+		out := fft.Forward(nil, src)
+		expected := naiveDFT(input, -1)
+		tol := 1e-4 * float64(n)
+		for k, e := range expected {
+			if cmplx.Abs(complex128(out[k])-e) > tol {
+				t.Errorf("n=%d: bin %d = %v; want %v", n, k, out[k], e)
+			}
+		}
+		back := fft.Inverse(nil, out)
+		for i, x := range src {
+			if cmplx.Abs(complex128(back[i]-x)) > 1e-4 {
+				t.Errorf("n=%d: round trip [%d] = %v; want %v", n, i, back[i], x)
+			}
+		}
+		fft.Destroy()
+	}
+}
+
+func TestFFTOneSecondBlock(t *testing.T) {
+	const n = 48000
+	fft, err := NewFFT(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fft.Destroy()
+	src := make([]complex64, n)
+	for i := range src {
+		src[i] = complex(float32(math.Cos(2*math.Pi*440*float64(i)/n)), 0)
+	}
+	out := fft.Forward(nil, src)
+	for _, k := range []int{440, n - 440} {
+		if math.Abs(float64(real(out[k]))-n/2) > 1 {
+			t.Errorf("bin %d = %v; want %d", k, out[k], n/2)
+		}
+	}
+	if m := cmplx.Abs(complex128(out[1000])); m > 1 {
+		t.Errorf("bin 1000 = %v; want ~0", out[1000])
+	}
+	back := fft.Inverse(out, out)
+	for i := 0; i < n; i += 997 {
+		if cmplx.Abs(complex128(back[i]-src[i])) > 1e-3 {
+			t.Errorf("round trip [%d] = %v; want %v", i, back[i], src[i])
+		}
+	}
+}
+
+func TestFFTZop3Zop5(t *testing.T) {
+	const log2n = 3
+	for radix, fftRadix := range map[int]FFTRadix{3: FFTRadix3, 5: FFTRadix5} {
+		fft, err := CreateFFTSetup(log2n, fftRadix)
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := radix << log2n
+		input := make([]complex128, n)
+		in := makeSplit(n)
+		out := makeSplit(n)
+		for i := range input {
+			input[i] = complex(float64(i%7)-3, float64(i%3))
+			in.Real[i], in.Imag[i] = float32(real(input[i])), float32(imag(input[i]))
+		}
+		if radix == 3 {
+			fft.Zop3(in, 1, out, 1, log2n, FFTDirectionForward)
+		} else {
+			fft.Zop5(in, 1, out, 1, log2n, FFTDirectionForward)
+		}
+		for k, e := range naiveDFT(input, -1) {
+			if !almostEqual64(float64(out.Real[k]), real(e), 1e-3) || !almostEqual64(float64(out.Imag[k]), imag(e), 1e-3) {
+				t.Errorf("Zop%d [%d] = (%f,%f); want %v", radix, k, out.Real[k], out.Imag[k], e)
+			}
+		}
+		fft.Destroy()
+	}
+}
+
+func TestFFTZop3WrongRadix(t *testing.T) {
+	fft, err := genericBackend{}.CreateFFTSetup(3, FFTRadix2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fft.Destroy()
+	in, out := makeSplit(3<<3), makeSplit(3<<3)
+	defer func() {
+		if recover() == nil {
+			t.Error("Zop3 on a radix-2 setup did not panic")
+		}
+	}()
+	fft.Zop3(in, 1, out, 1, 3, FFTDirectionForward)
+}
+
+func BenchmarkFFTBluestein1000(b *testing.B) {
+	fft, err := NewFFT(1000)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer fft.Destroy()
+	src := make([]complex64, fft.Len())
+	dst := make([]complex64, fft.Len())
+	b.SetBytes(int64(len(src) * 8))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		fft.Forward(dst, src)
+	}
+}
@@ -0,0 +1,139 @@
+// Package filter designs linear-phase FIR filters.
+//
+// Frequencies are normalised to the sample rate: 0.5 is the Nyquist
+// frequency, so a 1 kHz cutoff at 48 kHz is 1000.0/48000. The designers
+// return symmetric taps as []float32 that can be passed straight to
+// accel.Desamp or accel.Conv (for a symmetric filter correlation and
+// convolution are the same).
+//
+// The windowed-sinc designers take the window as a slice of numTaps
+// values from the window package, or nil for a Hamming window. For a
+// specification in terms of stopband attenuation and transition width,
+// KaiserOrder gives the number of taps and Kaiser beta:
+//
+//	n, beta := filter.KaiserOrder(80, 0.01)
+//	taps, err := filter.LowPass(n, 0.1, window.Kaiser(make([]float64, n), beta, window.Symmetric))
+//
+// Remez designs equiripple filters with the Parks-McClellan algorithm.
+package filter
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+
+	"github.com/samuel/go-accelerate/accel/internal/freqz"
+	"github.com/samuel/go-accelerate/accel/window"
+)
+
+// ErrSpec is returned for an invalid number of taps, band edge, weight or
+// window length.
+var ErrSpec = errors.New("filter: invalid specification")
+
+// LowPass designs a low-pass filter with the given cutoff (0 < cutoff <
+// 0.5), scaled to unity gain at DC.
+func LowPass(numTaps int, cutoff float64, win []float64) ([]float32, error) {
+	if !validCutoff(cutoff) {
+		return nil, ErrSpec
+	}
+	return windowedSinc(numTaps, win, []float64{0, cutoff}, 0)
+}
+
+// HighPass designs a high-pass filter with the given cutoff, scaled to
+// unity gain at the Nyquist frequency. numTaps must be odd, since an even
+// length symmetric filter always has a zero at Nyquist.
+func HighPass(numTaps int, cutoff float64, win []float64) ([]float32, error) {
+	if !validCutoff(cutoff) || numTaps%2 == 0 {
+		return nil, ErrSpec
+	}
+	return windowedSinc(numTaps, win, []float64{cutoff, 0.5}, 0.5)
+}
+
+// BandPass designs a band-pass filter passing low to high, scaled to unity
+// gain at the centre of the band.
+func BandPass(numTaps int, low, high float64, win []float64) ([]float32, error) {
+	if !validCutoff(low) || !validCutoff(high) || low >= high {
+		return nil, ErrSpec
+	}
+	return windowedSinc(numTaps, win, []float64{low, high}, (low+high)/2)
+}
+
+// BandStop designs a band-stop filter rejecting low to high, scaled to
+// unity gain at DC. numTaps must be odd.
+func BandStop(numTaps int, low, high float64, win []float64) ([]float32, error) {
+	if !validCutoff(low) || !validCutoff(high) || low >= high || numTaps%2 == 0 {
+		return nil, ErrSpec
+	}
+	return windowedSinc(numTaps, win, []float64{0, low, high, 0.5}, 0)
+}
+
+// KaiserOrder estimates the number of taps and the Kaiser window beta for
+// a windowed-sinc filter with the given stopband attenuation in dB (also
+// the passband ripple, as they are equal for window designs) and
+// transition width, using Kaiser's formulas.
+func KaiserOrder(attenuation, width float64) (numTaps int, beta float64) {
+	switch {
+	case attenuation > 50:
+		beta = 0.1102 * (attenuation - 8.7)
+	case attenuation > 21:
+		beta = 0.5842*math.Pow(attenuation-21, 0.4) + 0.07886*(attenuation-21)
+	}
+	numTaps = int(math.Ceil((attenuation-7.95)/(2.285*2*math.Pi*width) + 1))
+	return max(numTaps, 1), beta
+}
+
+// Response returns the complex frequency response of taps at the
+// normalised frequency f, Σ h[n]·e^(-2πifn).
+func Response(taps []float32, f float64) complex128 {
+	b := make([]float64, len(taps))
+	for n, h := range taps {
+		b[n] = float64(h)
+	}
+	return freqz.At(b, nil, []float64{f})[0]
+}
+
+// Gain returns the magnitude of the frequency response of taps at the
+// normalised frequency f.
+func Gain(taps []float32, f float64) float64 {
+	return cmplx.Abs(Response(taps, f))
+}
+
+func validCutoff(f float64) bool {
+	return f > 0 && f < 0.5
+}
+
+// windowedSinc sums the ideal responses of the pass bands (pairs of edges
+// in bands), applies the window and scales the gain at scaleFreq to 1.
+func windowedSinc(numTaps int, win []float64, bands []float64, scaleFreq float64) ([]float32, error) {
+	if numTaps < 1 {
+		return nil, ErrSpec
+	}
+	if win == nil {
+		win = window.Hamming(make([]float64, numTaps), window.Symmetric)
+	}
+	if len(win) != numTaps {
+		return nil, ErrSpec
+	}
+	alpha := float64(numTaps-1) / 2
+	h := make([]float64, numTaps)
+	for n := range h {
+		m := float64(n) - alpha
+		for i := 0; i < len(bands); i += 2 {
+			h[n] += 2*bands[i+1]*sinc(2*bands[i+1]*m) - 2*bands[i]*sinc(2*bands[i]*m)
+		}
+		h[n] *= win[n]
+	}
+	scale := 1 / cmplx.Abs(freqz.At(h, nil, []float64{scaleFreq})[0])
+	taps := make([]float32, numTaps)
+	for n, v := range h {
+		taps[n] = float32(v * scale)
+	}
+	return taps, nil
+}
+
+func sinc(x float64) float64 {
+	if x == 0 {
+		return 1
+	}
+	return math.Sin(math.Pi*x) / (math.Pi * x)
+}
@@ -0,0 +1,190 @@
+package filter
+
+import (
+	"math"
+	"testing"
+
+	"github.com/samuel/go-accelerate/accel/window"
+)
+
+func checkSymmetric(t *testing.T, name string, taps []float32) {
+	t.Helper()
+	for i := range taps {
+		if taps[i] != taps[len(taps)-1-i] {
+			t.Errorf("%s: taps not symmetric at %d", name, i)
+			return
+		}
+	}
+}
+
+// maxGain returns the largest gain of taps between lo and hi.
+func maxGain(taps []float32, lo, hi float64) float64 {
+	g := 0.0
+	for f := lo; f <= hi; f += 0.0005 {
+		g = max(g, Gain(taps, f))
+	}
+	return g
+}
+
+// maxDeviation returns the largest distance of the gain from want between
+// lo and hi.
+func maxDeviation(taps []float32, lo, hi, want float64) float64 {
+	d := 0.0
+	for f := lo; f <= hi; f += 0.0005 {
+		d = max(d, math.Abs(Gain(taps, f)-want))
+	}
+	return d
+}
+
+func TestWindowedSinc(t *testing.T) {
+	lp, err := LowPass(101, 0.1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSymmetric(t, "LowPass", lp)
+	if g := Gain(lp, 0); math.Abs(g-1) > 1e-6 {
+		t.Errorf("LowPass DC gain %f; want 1", g)
+	}
+	if g := Gain(lp, 0.1); math.Abs(g-0.5) > 0.01 {
+		t.Errorf("LowPass gain at cutoff %f; want 0.5", g)
+	}
+	// A Hamming window gives about 53 dB of attenuation past the
+	// transition band of 3.3/N.
+	if g := maxGain(lp, 0.1+3.3/101, 0.5); g > math.Pow(10, -50.0/20) {
+		t.Errorf("LowPass stopband gain %g", g)
+	}
+
+	hp, err := HighPass(101, 0.2, window.Blackman(make([]float64, 101), window.Symmetric))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSymmetric(t, "HighPass", hp)
+	if g := Gain(hp, 0.5); math.Abs(g-1) > 1e-6 {
+		t.Errorf("HighPass Nyquist gain %f; want 1", g)
+	}
+	if g := maxGain(hp, 0, 0.2-5.5/101); g > 1e-3 {
+		t.Errorf("HighPass stopband gain %g", g)
+	}
+
+	bp, err := BandPass(129, 0.1, 0.2, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g := Gain(bp, 0.15); math.Abs(g-1) > 1e-6 {
+		t.Errorf("BandPass centre gain %f; want 1", g)
+	}
+	if g := maxGain(bp, 0, 0.1-3.3/129); g > 0.01 {
+		t.Errorf("BandPass lower stopband gain %g", g)
+	}
+	if g := maxGain(bp, 0.2+3.3/129, 0.5); g > 0.01 {
+		t.Errorf("BandPass upper stopband gain %g", g)
+	}
+
+	bs, err := BandStop(129, 0.1, 0.2, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g := Gain(bs, 0); math.Abs(g-1) > 1e-6 {
+		t.Errorf("BandStop DC gain %f; want 1", g)
+	}
+	if g := maxGain(bs, 0.1+3.3/129, 0.2-3.3/129); g > 0.01 {
+		t.Errorf("BandStop stopband gain %g", g)
+	}
+}
+
+func TestKaiserDesign(t *testing.T) {
+	n, beta := KaiserOrder(65, 0.01)
+	if n != 399 || math.Abs(beta-6.20426) > 1e-4 {
+		t.Errorf("KaiserOrder(65, 0.01) = %d, %f; want 399, 6.20426", n, beta)
+	}
+	const cutoff, width, atten = 0.2, 0.02, 70
+	n, beta = KaiserOrder(atten, width)
+	taps, err := LowPass(n, cutoff, window.Kaiser(make([]float64, n), beta, window.Symmetric))
+	if err != nil {
+		t.Fatal(err)
+	}
+	limit := math.Pow(10, -atten/20) * 1.1
+	if g := maxGain(taps, cutoff+width/2, 0.5); g > limit {
+		t.Errorf("stopband gain %g exceeds %g", g, limit)
+	}
+	if d := maxDeviation(taps, 0, cutoff-width/2, 1); d > limit {
+		t.Errorf("passband ripple %g exceeds %g", d, limit)
+	}
+}
+
+func TestRemez(t *testing.T) {
+	for _, numTaps := range []int{61, 60} {
+		taps, err := Remez(numTaps, []float64{0, 0.1, 0.15, 0.5}, []float64{1, 0}, nil)
+		if err != nil {
+			t.Fatalf("%d taps: %v", numTaps, err)
+		}
+		if len(taps) != numTaps {
+			t.Fatalf("%d taps: got %d", numTaps, len(taps))
+		}
+		checkSymmetric(t, "Remez", taps)
+		pass := maxDeviation(taps, 0, 0.1, 1)
+		stop := maxGain(taps, 0.15, 0.5)
+		// Equal weights give equal ripple in both bands.
+		if pass > 0.01 || math.Abs(pass-stop)/stop > 0.02 {
+			t.Errorf("%d taps: passband ripple %g, stopband gain %g", numTaps, pass, stop)
+		}
+	}
+
+	// Weighting the stopband ten times more divides its ripple by ten.
+	taps, err := Remez(75, []float64{0, 0.1, 0.15, 0.3, 0.35, 0.5}, []float64{0, 1, 0}, []float64{10, 1, 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pass := maxDeviation(taps, 0.15, 0.3, 1)
+	stop := max(maxGain(taps, 0, 0.1), maxGain(taps, 0.35, 0.5))
+	if math.Abs(pass/stop-10) > 0.3 {
+		t.Errorf("band-pass ripple ratio %f; want 10", pass/stop)
+	}
+
+	// The taps are ready for accel.Desamp, which computes
+	// output[i] = Σ lp[k]·input[2i+k]: a tone in the stopband is removed.
+	lp, _ := Remez(61, []float64{0, 0.1, 0.15, 0.5}, []float64{1, 0}, nil)
+	input := make([]float32, 400)
+	for i := range input {
+		input[i] = float32(math.Sin(2*math.Pi*0.3*float64(i)) + 1)
+	}
+	for i := 0; 2*i+len(lp) <= len(input); i++ {
+		var v float64
+		for k, h := range lp {
+			v += float64(h) * float64(input[2*i+k])
+		}
+		if math.Abs(v-1) > 0.01 {
+			t.Fatalf("Desamp output [%d] = %f; want 1", i, v)
+		}
+	}
+}
+
+func TestSpecErrors(t *testing.T) {
+	if _, err := LowPass(11, 0.5, nil); err != ErrSpec {
+		t.Errorf("LowPass cutoff 0.5 returned %v", err)
+	}
+	if _, err := HighPass(10, 0.2, nil); err != ErrSpec {
+		t.Errorf("HighPass with even taps returned %v", err)
+	}
+	if _, err := BandPass(11, 0.3, 0.2, nil); err != ErrSpec {
+		t.Errorf("BandPass with low > high returned %v", err)
+	}
+	if _, err := LowPass(11, 0.2, make([]float64, 10)); err != ErrSpec {
+		t.Errorf("LowPass with short window returned %v", err)
+	}
+	for _, c := range []struct {
+		n                       int
+		bands, desired, weights []float64
+	}{
+		{2, []float64{0, 0.1, 0.2, 0.5}, []float64{1, 0}, nil},
+		{31, []float64{0, 0.2, 0.1, 0.5}, []float64{1, 0}, nil},
+		{31, []float64{0, 0.1, 0.2, 0.6}, []float64{1, 0}, nil},
+		{31, []float64{0, 0.1, 0.2, 0.5}, []float64{1}, nil},
+		{31, []float64{0, 0.1, 0.2, 0.5}, []float64{1, 0}, []float64{1, 0}},
+		{30, []float64{0, 0.1, 0.2, 0.5}, []float64{0, 1}, nil},
+	} {
+		if _, err := Remez(c.n, c.bands, c.desired, c.weights); err != ErrSpec {
+			t.Errorf("Remez(%d, %v, %v, %v) returned %v; want %v", c.n, c.bands, c.desired, c.weights, err, ErrSpec)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+package filter
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrNoConvergence is returned by Remez when the exchange algorithm does
+// not converge, which usually means the specification cannot be met
+// with the given number of taps.
+var ErrNoConvergence = errors.New("filter: Remez exchange did not converge")
+
+const (
+	remezGridDensity   = 16
+	remezMaxIterations = 40
+)
+
+// Remez designs an equiripple linear-phase filter with the
+// Parks-McClellan algorithm. bands holds pairs of band edges in ascending
+// order between 0 and 0.5, desired the gain of each band and weights the
+// relative importance of its error (nil weighs all bands equally); the
+// gaps between bands are don't-care transition regions.
+//
+// For example a low-pass filter passing up to 0.1 and stopping from 0.15
+// with 60 taps is
+//
+//	taps, err := filter.Remez(60, []float64{0, 0.1, 0.15, 0.5}, []float64{1, 0}, nil)
+//
+// Even numTaps give a filter with a zero at the Nyquist frequency, so a
+// band reaching 0.5 must then have a desired gain of 0.
+func Remez(numTaps int, bands, desired, weights []float64) ([]float32, error) {
+	if numTaps < 3 || len(bands) == 0 || len(bands) != 2*len(desired) {
+		return nil, ErrSpec
+	}
+	if weights == nil {
+		weights = make([]float64, len(desired))
+		for i := range weights {
+			weights[i] = 1
+		}
+	}
+	if len(weights) != len(desired) {
+		return nil, ErrSpec
+	}
+	for i, f := range bands {
+		if f < 0 || f > 0.5 || (i > 0 && f < bands[i-1]) || (i%2 == 1 && f == bands[i-1]) {
+			return nil, ErrSpec
+		}
+	}
+	for _, w := range weights {
+		if !(w > 0) {
+			return nil, ErrSpec
+		}
+	}
+	if numTaps%2 == 0 && bands[len(bands)-1] == 0.5 && desired[len(desired)-1] != 0 {
+		return nil, ErrSpec
+	}
+
+	// r cosine functions span the amplitude response of the symmetric
+	// filter, which is matched at r+1 extremal frequencies.
+	r := numTaps / 2
+	if numTaps%2 == 1 {
+		r++
+	}
+	grid, d, w := remezGrid(r, numTaps, bands, desired, weights)
+	if len(grid) < r+1 {
+		return nil, ErrSpec
+	}
+	if numTaps%2 == 0 {
+		// An even length filter is cos(πf) times a cosine polynomial;
+		// design that polynomial instead.
+		for i, f := range grid {
+			c := math.Cos(math.Pi * f)
+			d[i] /= c
+			w[i] *= c
+		}
+	}
+
+	ext := make([]int, r+1)
+	for i := range ext {
+		ext[i] = i * (len(grid) - 1) / r
+	}
+	p := remezParams{
+		ad: make([]float64, r+1),
+		x:  make([]float64, r+1),
+		y:  make([]float64, r+1),
+	}
+	e := make([]float64, len(grid))
+	converged := false
+	for iter := 0; iter < remezMaxIterations && !converged; iter++ {
+		p.calc(ext, grid, d, w)
+		for i, f := range grid {
+			e[i] = w[i] * (d[i] - p.amplitude(f))
+		}
+		if !remezSearch(ext, e) {
+			break
+		}
+		converged = remezDone(ext, e)
+	}
+	if !converged {
+		return nil, ErrNoConvergence
+	}
+	p.calc(ext, grid, d, w)
+
+	// Sample the amplitude response at f = k/numTaps and recover the taps
+	// with the inverse DFT of a real symmetric sequence.
+	a := make([]float64, numTaps/2+1)
+	for k := range a {
+		c := 1.0
+		if numTaps%2 == 0 {
+			c = math.Cos(math.Pi * float64(k) / float64(numTaps))
+		}
+		a[k] = p.amplitude(float64(k)/float64(numTaps)) * c
+	}
+	m := float64(numTaps-1) / 2
+	last := (numTaps - 1) / 2
+	if numTaps%2 == 0 {
+		last = numTaps/2 - 1
+	}
+	taps := make([]float32, numTaps)
+	for n := range taps {
+		val := a[0]
+		x := 2 * math.Pi * (float64(n) - m) / float64(numTaps)
+		for k := 1; k <= last; k++ {
+			val += 2 * a[k] * math.Cos(x*float64(k))
+		}
+		taps[n] = float32(val / float64(numTaps))
+	}
+	return taps, nil
+}
+
+// remezGrid builds the dense frequency grid over the bands with the
+// desired gain and weight at each point.
+func remezGrid(r, numTaps int, bands, desired, weights []float64) (grid, d, w []float64) {
+	delf := 0.5 / float64(remezGridDensity*r)
+	for b := range desired {
+		low, high := bands[2*b], bands[2*b+1]
+		k := max(int((high-low)/delf+0.5), 1)
+		for i := 0; i < k; i++ {
+			grid = append(grid, low+float64(i)*delf)
+			d = append(d, desired[b])
+			w = append(w, weights[b])
+		}
+		grid[len(grid)-1] = high
+	}
+	// cos(πf) vanishes at Nyquist for even lengths.
+	if numTaps%2 == 0 && grid[len(grid)-1] > 0.5-delf {
+		grid[len(grid)-1] = 0.5 - delf
+	}
+	return grid, d, w
+}
+
+// remezParams holds the barycentric interpolation of the current
+// amplitude response through the extremal frequencies.
+type remezParams struct {
+	ad, x, y []float64
+}
+
+func (p *remezParams) calc(ext []int, grid, d, w []float64) {
+	r := len(ext) - 1
+	for i, e := range ext {
+		p.x[i] = math.Cos(2 * math.Pi * grid[e])
+	}
+	// Interleave the products to avoid overflow and underflow.
+	ld := (r-1)/15 + 1
+	for i := range ext {
+		denom := 1.0
+		for j := 0; j < ld; j++ {
+			for k := j; k <= r; k += ld {
+				if k != i {
+					denom *= 2 * (p.x[i] - p.x[k])
+				}
+			}
+		}
+		if math.Abs(denom) < 1e-5 {
+			denom = 1e-5
+		}
+		p.ad[i] = 1 / denom
+	}
+	var numer, denom float64
+	sign := 1.0
+	for i, e := range ext {
+		numer += p.ad[i] * d[e]
+		denom += sign * p.ad[i] / w[e]
+		sign = -sign
+	}
+	delta := numer / denom
+	sign = 1
+	for i, e := range ext {
+		p.y[i] = d[e] - sign*delta/w[e]
+		sign = -sign
+	}
+}
+
+// amplitude evaluates the interpolated amplitude response at f.
+func (p *remezParams) amplitude(f float64) float64 {
+	xc := math.Cos(2 * math.Pi * f)
+	var numer, denom float64
+	for i, x := range p.x {
+		c := xc - x
+		if math.Abs(c) < 1e-7 {
+			return p.y[i]
+		}
+		c = p.ad[i] / c
+		denom += c
+		numer += c * p.y[i]
+	}
+	return numer / denom
+}
+
+// remezSearch replaces ext with the extrema of the error e, dropping
+// extra ones so they alternate in sign. It reports false if too few
+// extrema were found.
+func remezSearch(ext []int, e []float64) bool {
+	n := len(e)
+	var found []int
+	if (e[0] > 0 && e[0] > e[1]) || (e[0] < 0 && e[0] < e[1]) {
+		found = append(found, 0)
+	}
+	for i := 1; i < n-1; i++ {
+		if (e[i] >= e[i-1] && e[i] > e[i+1] && e[i] > 0) || (e[i] <= e[i-1] && e[i] < e[i+1] && e[i] < 0) {
+			found = append(found, i)
+		}
+	}
+	if j := n - 1; (e[j] > 0 && e[j] > e[j-1]) || (e[j] < 0 && e[j] < e[j-1]) {
+		found = append(found, j)
+	}
+	for extra := len(found) - len(ext); extra > 0; extra-- {
+		up := e[found[0]] > 0
+		l := 0
+		alternating := true
+		for j := 1; j < len(found); j++ {
+			if math.Abs(e[found[j]]) < math.Abs(e[found[l]]) {
+				l = j
+			}
+			if up && e[found[j]] < 0 {
+				up = false
+			} else if !up && e[found[j]] > 0 {
+				up = true
+			} else {
+				alternating = false
+				break
+			}
+		}
+		// With one extremum too many and all alternating, drop the
+		// smaller of the two ends.
+		if alternating && extra == 1 {
+			if math.Abs(e[found[len(found)-1]]) < math.Abs(e[found[0]]) {
+				l = len(found) - 1
+			} else {
+				l = 0
+			}
+		}
+		found = append(found[:l], found[l+1:]...)
+	}
+	if len(found) < len(ext) {
+		return false
+	}
+	copy(ext, found)
+	return true
+}
+
+// remezDone reports whether the error has equal magnitude at every
+// extremal frequency.
+func remezDone(ext []int, e []float64) bool {
+	lo, hi := math.Inf(1), 0.0
+	for _, i := range ext {
+		v := math.Abs(e[i])
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+	return hi == 0 || (hi-lo)/hi < 1e-4
+}
@@ -0,0 +1,87 @@
+package accel
+
+// FIRFilter applies a fixed set of FIR taps to a stream delivered in
+// blocks of any size. It keeps the last len(taps)-1 input samples between
+// calls, so filtering a signal in chunks gives bit-identical output to
+// filtering it in one call, with no discontinuities at block edges. The
+// stream starts from zero history.
+//
+// Each output sample is y[n] = Σ taps[k]·x[n-k], so the filter has a
+// delay of (len(taps)-1)/2 samples for symmetric taps such as those from
+// the filter package.
+//
+// A FIRFilter holds scratch buffers and must not be used concurrently.
+type FIRFilter struct {
+	taps   []float32
+	hist   []float32 // history of Process
+	histRe []float32 // history of the real part for ProcessComplex
+	histIm []float32
+	buf    []float32
+}
+
+// NewFIRFilter returns a filter with a copy of taps. It panics with
+// ErrLengthMismatch if taps is empty.
+func NewFIRFilter(taps []float32) *FIRFilter {
+	if len(taps) == 0 {
+		panic(ErrLengthMismatch)
+	}
+	return &FIRFilter{
+		taps:   append([]float32(nil), taps...),
+		hist:   make([]float32, len(taps)-1),
+		histRe: make([]float32, len(taps)-1),
+		histIm: make([]float32, len(taps)-1),
+	}
+}
+
+// Taps returns the filter's taps. The slice must not be modified.
+func (f *FIRFilter) Taps() []float32 {
+	return f.taps
+}
+
+// Reset clears the history, as if the stream started again.
+func (f *FIRFilter) Reset() {
+	clear(f.hist)
+	clear(f.histRe)
+	clear(f.histIm)
+}
+
+// Process filters the samples in in and stores the result in the first
+// len(in) elements of out, which may be in itself. It panics with
+// ErrLengthMismatch if out is shorter than in.
+func (f *FIRFilter) Process(in, out []float32) {
+	if len(out) < len(in) {
+		panic(ErrLengthMismatch)
+	}
+	f.process(f.hist, in, out)
+}
+
+// ProcessComplex filters the complex samples in in with the real taps,
+// storing the result in the first len(in.Real) elements of out, which may
+// be in itself. The real and imaginary parts have their own history,
+// which is separate from the one used by Process, so a real and a complex
+// stream can be interleaved on one filter. It panics with ErrLengthMismatch if
+// the parts of in differ in length or out is shorter than in.
+func (f *FIRFilter) ProcessComplex(in, out DSPSplitComplex) {
+	n := len(in.Real)
+	if len(in.Imag) != n || len(out.Real) < n || len(out.Imag) < n {
+		panic(ErrLengthMismatch)
+	}
+	f.process(f.histRe, in.Real, out.Real)
+	f.process(f.histIm, in.Imag, out.Imag)
+}
+
+// process convolves the taps with hist followed by in, which yields one
+// output per input sample, and keeps the last len(hist) samples as the
+// history for the next call.
+func (f *FIRFilter) process(hist, in, out []float32) {
+	n := len(in)
+	if n == 0 {
+		return
+	}
+	m := len(hist)
+	f.buf = growFloat32(f.buf, m+n)
+	copy(f.buf, hist)
+	copy(f.buf[m:], in)
+	Conv(f.buf, 1, f.taps, -1, out[:n], 1)
+	copy(hist, f.buf[n:])
+}
@@ -0,0 +1,152 @@
+package accel
+
+import (
+	"math"
+	"testing"
+
+	"github.com/samuel/go-accelerate/accel/internal/testsignal"
+)
+
+func firTestTaps(n int) []float32 {
+	taps := make([]float32, n)
+	for i := range taps {
+		taps[i] = float32(math.Cos(float64(i)*0.3)) / float32(n)
+	}
+	return taps
+}
+
+// firChunks cuts n samples into blocks of awkward sizes, including empty
+// blocks and blocks shorter than the filter.
+func firChunks(n int) [][2]int {
+	sizes := []int{1, 0, 7, 64, 3, 0, 100, 36, 5, 250}
+	var chunks [][2]int
+	for i, start := 0, 0; start < n; i++ {
+		end := min(start+sizes[i%len(sizes)], n)
+		chunks = append(chunks, [2]int{start, end})
+		start = end
+	}
+	return chunks
+}
+
+func TestFIRFilterChunked(t *testing.T) {
+	taps := firTestTaps(37)
+	x := testsignal.Make(1000, 0.07)
+
+	whole := make([]float32, len(x))
+	NewFIRFilter(taps).Process(x, whole)
+
+	// The first outputs are the start of the full convolution.
+	want := Convolve(nil, x, taps, ConvFull)
+	for i := range whole {
+		if !almostEqual32(whole[i], want[i], 1e-5) {
+			t.Fatalf("output [%d] = %f; want %f", i, whole[i], want[i])
+		}
+	}
+
+	f := NewFIRFilter(taps)
+	chunked := make([]float32, len(x))
+	for _, c := range firChunks(len(x)) {
+		f.Process(x[c[0]:c[1]], chunked[c[0]:c[1]])
+	}
+	for i := range whole {
+		if chunked[i] != whole[i] {
+			t.Fatalf("chunked output [%d] = %g; want %g", i, chunked[i], whole[i])
+		}
+	}
+
+	// In place, after a reset.
+	f.Reset()
+	inPlace := append([]float32(nil), x...)
+	for _, c := range firChunks(len(x)) {
+		f.Process(inPlace[c[0]:c[1]], inPlace[c[0]:c[1]])
+	}
+	for i := range whole {
+		if inPlace[i] != whole[i] {
+			t.Fatalf("in-place output [%d] = %g; want %g", i, inPlace[i], whole[i])
+		}
+	}
+}
+
+func TestFIRFilterComplexChunked(t *testing.T) {
+	taps := firTestTaps(20)
+	re := testsignal.Make(500, 0.07)
+	im := testsignal.Make(500, 0.11)
+	in := DSPSplitComplex{Real: re, Imag: im}
+
+	whole := makeSplit(len(re))
+	NewFIRFilter(taps).ProcessComplex(in, whole)
+
+	wantRe := make([]float32, len(re))
+	wantIm := make([]float32, len(im))
+	NewFIRFilter(taps).Process(re, wantRe)
+	NewFIRFilter(taps).Process(im, wantIm)
+
+	f := NewFIRFilter(taps)
+	chunked := makeSplit(len(re))
+	for _, c := range firChunks(len(re)) {
+		f.ProcessComplex(
+			DSPSplitComplex{Real: re[c[0]:c[1]], Imag: im[c[0]:c[1]]},
+			DSPSplitComplex{Real: chunked.Real[c[0]:c[1]], Imag: chunked.Imag[c[0]:c[1]]})
+	}
+	for i := range re {
+		if whole.Real[i] != wantRe[i] || whole.Imag[i] != wantIm[i] {
+			t.Fatalf("output [%d] = %g%+gi; want %g%+gi", i, whole.Real[i], whole.Imag[i], wantRe[i], wantIm[i])
+		}
+		if chunked.Real[i] != whole.Real[i] || chunked.Imag[i] != whole.Imag[i] {
+			t.Fatalf("chunked output [%d] = %g%+gi; want %g%+gi", i, chunked.Real[i], chunked.Imag[i], whole.Real[i], whole.Imag[i])
+		}
+	}
+}
+
+// TestFIRFilterInterleaved alternates real and complex blocks on one
+// filter; each stream must come out as if it had a filter of its own.
+func TestFIRFilterInterleaved(t *testing.T) {
+	taps := firTestTaps(15)
+	x := testsignal.Make(400, 0.07)
+	re := testsignal.Make(400, 0.11)
+	im := testsignal.Make(400, 0.13)
+
+	wantX := make([]float32, len(x))
+	NewFIRFilter(taps).Process(x, wantX)
+	want := makeSplit(len(re))
+	NewFIRFilter(taps).ProcessComplex(DSPSplitComplex{Real: re, Imag: im}, want)
+
+	f := NewFIRFilter(taps)
+	gotX := make([]float32, len(x))
+	got := makeSplit(len(re))
+	for _, c := range firChunks(len(x)) {
+		f.Process(x[c[0]:c[1]], gotX[c[0]:c[1]])
+		f.ProcessComplex(
+			DSPSplitComplex{Real: re[c[0]:c[1]], Imag: im[c[0]:c[1]]},
+			DSPSplitComplex{Real: got.Real[c[0]:c[1]], Imag: got.Imag[c[0]:c[1]]})
+	}
+	for i := range x {
+		if gotX[i] != wantX[i] {
+			t.Fatalf("real output [%d] = %g; want %g", i, gotX[i], wantX[i])
+		}
+		if got.Real[i] != want.Real[i] || got.Imag[i] != want.Imag[i] {
+			t.Fatalf("complex output [%d] = %g%+gi; want %g%+gi", i, got.Real[i], got.Imag[i], want.Real[i], want.Imag[i])
+		}
+	}
+}
+
+func TestFIRFilterSingleTap(t *testing.T) {
+	f := NewFIRFilter([]float32{2})
+	out := make([]float32, 3)
+	f.Process([]float32{1, 2, 3}, out)
+	for i, want := range []float32{2, 4, 6} {
+		if out[i] != want {
+			t.Errorf("output [%d] = %f; want %f", i, out[i], want)
+		}
+	}
+}
+
+func BenchmarkFIRFilter(b *testing.B) {
+	f := NewFIRFilter(firTestTaps(64))
+	x := testsignal.Make(256, 0.07)
+	out := make([]float32, len(x))
+	b.SetBytes(int64(len(x) * 4))
+	for i := 0; i < b.N; i++ {
+		f.Process(x, out)
+	}
+}
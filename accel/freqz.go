@@ -0,0 +1,98 @@
+package accel
+
+import (
+	"math"
+	"math/bits"
+	"math/cmplx"
+
+	"github.com/samuel/go-accelerate/accel/internal/freqz"
+)
+
+// Freqz evaluates the frequency response of the filter with numerator b
+// and denominator a (empty for an FIR filter),
+//
+//	H(f) = Σ b[k]·e^(-2πifk) / Σ a[k]·e^(-2πifk),
+//
+// at the n frequencies f = k/(2n), k in [0, n), which cover DC up to just
+// below the Nyquist frequency of 0.5 cycles per sample. It returns the
+// frequencies and the responses.
+//
+// When 2n is a power of two at least as long as b and a, both
+// polynomials are evaluated with one double-precision FFT from the shared
+// plan cache; otherwise they are evaluated directly with FreqzAt.
+func Freqz(b, a []float64, n int) (freqs []float64, h []complex128) {
+	if n < 1 {
+		return nil, nil
+	}
+	freqs = make([]float64, n)
+	for k := range freqs {
+		freqs[k] = float64(k) / float64(2*n)
+	}
+	size := 2 * n
+	if size&(size-1) != 0 || len(b) > size || len(a) > size {
+		return freqs, FreqzAt(b, a, freqs)
+	}
+	log2n := bits.TrailingZeros(uint(size))
+	setup, err := FFTPlanForD(log2n, FFTRadix2)
+	if err != nil {
+		return freqs, FreqzAt(b, a, freqs)
+	}
+	num := freqzFFT(setup, b, size, log2n)
+	h = make([]complex128, n)
+	if len(a) == 0 {
+		copy(h, num)
+		return freqs, h
+	}
+	den := freqzFFT(setup, a, size, log2n)
+	for k := range h {
+		h[k] = num[k] / den[k]
+	}
+	return freqs, h
+}
+
+// freqzFFT returns the first size/2 bins of the zero-padded DFT of c.
+func freqzFFT(setup *FFTSetupD, c []float64, size, log2n int) []complex128 {
+	in := DSPDoubleSplitComplex{Real: make([]float64, size), Imag: make([]float64, size)}
+	out := DSPDoubleSplitComplex{Real: make([]float64, size), Imag: make([]float64, size)}
+	copy(in.Real, c)
+	setup.Zop(in, 1, out, 1, log2n, FFTDirectionForward)
+	bins := make([]complex128, size/2)
+	for k := range bins {
+		bins[k] = complex(out.Real[k], out.Imag[k])
+	}
+	return bins
+}
+
+// FreqzAt evaluates the frequency response of the filter with numerator b
+// and denominator a (empty for an FIR filter) at arbitrary frequencies in
+// cycles per sample.
+func FreqzAt(b, a []float64, freqs []float64) []complex128 {
+	return freqz.At(b, a, freqs)
+}
+
+// GroupDelay returns the group delay in samples, -dφ/dω, of the filter with
+// numerator b and denominator a (empty for an FIR filter) at frequencies in
+// cycles per sample. At a zero of the response the delay is undefined and
+// NaN or ±Inf is returned.
+func GroupDelay(b, a []float64, freqs []float64) []float64 {
+	gd := make([]float64, len(freqs))
+	for i, f := range freqs {
+		z := cmplx.Exp(complex(0, -2*math.Pi*f))
+		gd[i] = polyDelay(b, z)
+		if len(a) != 0 {
+			gd[i] -= polyDelay(a, z)
+		}
+	}
+	return gd
+}
+
+// polyDelay returns the group delay of the polynomial Σ c[k]·z^k on the
+// unit circle, Re(Σ k·c[k]·z^k / Σ c[k]·z^k).
+func polyDelay(c []float64, z complex128) float64 {
+	var num, den complex128
+	for k := len(c) - 1; k >= 0; k-- {
+		num = num*z + complex(float64(k)*c[k], 0)
+		den = den*z + complex(c[k], 0)
+	}
+	return real(num / den)
+}
@@ -0,0 +1,102 @@
+package accel
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestUnwrap(t *testing.T) {
+	phase := []float32{3, -3, -0.5, 2.5, -3.5, 3}
+	Unwrap(phase, math.Pi)
+	want := []float64{3, 2*math.Pi - 3, 2*math.Pi - 0.5, 2*math.Pi + 2.5, 4*math.Pi - 3.5, 2*math.Pi + 3}
+	for i := range want {
+		if !almostEqual64(float64(phase[i]), want[i], 1e-5) {
+			t.Errorf("unwrapped %v; want %v", phase, want)
+			break
+		}
+	}
+
+	// Jumps below discont are left alone.
+	phase = []float32{0, 4, 0}
+	Unwrap(phase, 5)
+	if phase[1] != 4 || phase[2] != 0 {
+		t.Errorf("unwrapped with discont 5 = %v; want [0 4 0]", phase)
+	}
+
+	// A long ramp wrapped by Zvphas comes back without drift.
+	const n = 100000
+	split := makeSplit(n)
+	for i := range split.Real {
+		s, c := math.Sincos(0.1 * float64(i))
+		split.Real[i], split.Imag[i] = float32(c), float32(s)
+	}
+	ramp := make([]float32, n)
+	Zvphas(split, 1, ramp, 1)
+	Unwrap(ramp, math.Pi)
+	if got, want := float64(ramp[n-1]), 0.1*(n-1); math.Abs(got-want) > 1e-2 {
+		t.Errorf("end of unwrapped ramp = %f; want %f", got, want)
+	}
+}
+
+func TestFreqz(t *testing.T) {
+	b := []float64{0.1, 0.2, 0.4, 0.2, 0.1}
+	a := []float64{1, -0.5, 0.25}
+	for _, n := range []int{64, 50} {
+		freqs, h := Freqz(b, a, n)
+		if len(freqs) != n || len(h) != n {
+			t.Fatalf("Freqz returned %d and %d points; want %d", len(freqs), len(h), n)
+		}
+		want := FreqzAt(b, a, freqs)
+		for k := range h {
+			if freqs[k] != float64(k)/float64(2*n) {
+				t.Fatalf("freqs[%d] = %f; want %f", k, freqs[k], float64(k)/float64(2*n))
+			}
+			if cmplx.Abs(h[k]-want[k]) > 1e-12 {
+				t.Fatalf("n=%d: H[%d] = %v; want %v", n, k, h[k], want[k])
+			}
+		}
+	}
+	if _, h := Freqz(b, nil, 4); cmplx.Abs(h[0]-1) > 1e-12 {
+		t.Errorf("FIR DC response = %v; want 1", h[0])
+	}
+	// An empty denominator is an FIR filter too.
+	if _, h := Freqz(b, []float64{}, 4); cmplx.Abs(h[0]-1) > 1e-12 {
+		t.Errorf("FIR DC response with an empty denominator = %v; want 1", h[0])
+	}
+	if h := FreqzAt(b, []float64{}, []float64{0}); cmplx.Abs(h[0]-1) > 1e-12 {
+		t.Errorf("FreqzAt DC response with an empty denominator = %v; want 1", h[0])
+	}
+
+	// A peaking EQ reaches its gain at the centre frequency.
+	pb, pa := BiquadPeaking(48000, 3000, 2, 9).Polynomials()
+	h := FreqzAt(pb, pa, []float64{3000.0 / 48000})
+	if g := 20 * math.Log10(cmplx.Abs(h[0])); math.Abs(g-9) > 1e-9 {
+		t.Errorf("peaking gain %f dB; want 9", g)
+	}
+}
+
+func TestGroupDelay(t *testing.T) {
+	// A symmetric FIR filter delays every frequency by (N-1)/2.
+	fir := []float64{0.05, 0.1, 0.2, 0.3, 0.2, 0.1, 0.05}
+	for i, d := range GroupDelay(fir, nil, []float64{0, 0.1, 0.2, 0.3}) {
+		if math.Abs(d-3) > 1e-9 {
+			t.Errorf("FIR group delay [%d] = %f; want 3", i, d)
+		}
+	}
+	if d := GroupDelay(fir, []float64{}, []float64{0.1})[0]; math.Abs(d-3) > 1e-9 {
+		t.Errorf("FIR group delay with an empty denominator = %f; want 3", d)
+	}
+
+	// For a biquad compare with the derivative of the unwrapped phase.
+	b, a := BiquadLowPass(48000, 2000, 0.9).Polynomials()
+	const df = 1e-6
+	for _, f := range []float64{0.01, 0.04, 0.1, 0.3} {
+		h := FreqzAt(b, a, []float64{f - df, f + df})
+		dphi := cmplx.Phase(h[1] / h[0])
+		want := -dphi / (2 * math.Pi * 2 * df)
+		if got := GroupDelay(b, a, []float64{f})[0]; math.Abs(got-want) > 1e-4 {
+			t.Errorf("biquad group delay at %f = %f; want %f", f, got, want)
+		}
+	}
+}
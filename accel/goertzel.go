@@ -0,0 +1,132 @@
+package accel
+
+import "math"
+
+// Goertzel returns the DFT of samples at a single frequency,
+// Σ x[n]·e^(-2πi·targetHz·n/sampleRate), using the Goertzel recurrence. It
+// costs one multiply-add per sample, much less than a full FFT when only a
+// few frequencies matter, and targetHz need not fall on a bin of the
+// len(samples)-point DFT.
+func Goertzel(samples []float32, targetHz, sampleRate float64) complex128 {
+	if len(samples) == 0 {
+		return 0
+	}
+	w := 2 * math.Pi * targetHz / sampleRate
+	s1, s2 := goertzel(samples, 2*math.Cos(w))
+	// s1 - e^(-iw)·s2 is the DFT rotated by e^(iw(N-1)).
+	sin, cos := math.Sincos(w)
+	y := complex(s1-cos*s2, sin*s2)
+	rs, rc := math.Sincos(-w * float64(len(samples)-1))
+	return y * complex(rc, rs)
+}
+
+// GoertzelPower returns the squared magnitude of Goertzel(samples,
+// targetHz, sampleRate), skipping the final phase correction.
+func GoertzelPower(samples []float32, targetHz, sampleRate float64) float64 {
+	coeff := 2 * math.Cos(2*math.Pi*targetHz/sampleRate)
+	s1, s2 := goertzel(samples, coeff)
+	return s1*s1 + s2*s2 - coeff*s1*s2
+}
+
+// goertzel runs s[n] = x[n] + coeff·s[n-1] - s[n-2] over samples and
+// returns the last two states.
+func goertzel(samples []float32, coeff float64) (s1, s2 float64) {
+	for _, x := range samples {
+		s1, s2 = float64(x)+coeff*s1-s2, s1
+	}
+	return s1, s2
+}
+
+// SlidingDFT tracks a set of bins of the N-point DFT of the last N samples
+// of a stream, updating them in constant time per sample and bin. Bin k is
+// Σ x[n]·e^(-2πikn/N) with n counted from the oldest sample in the window.
+//
+// To stop rounding errors from accumulating, the bins are recomputed
+// exactly from the window every N samples, which does not change the
+// cost per sample. Until N samples have been seen the window is padded
+// with leading zeros.
+type SlidingDFT struct {
+	n       int
+	bins    []int
+	twiddle []complex128 // e^(2πik/N) for every bin
+	values  []complex128
+	window  []float32 // the last n samples, oldest at pos
+	pos     int
+}
+
+// NewSlidingDFT returns a sliding DFT of n points tracking the given bins.
+// It panics with ErrLengthMismatch if n is less than 1 or a bin is outside
+// [0, n).
+func NewSlidingDFT(n int, bins []int) *SlidingDFT {
+	if n < 1 {
+		panic(ErrLengthMismatch)
+	}
+	d := &SlidingDFT{
+		n:       n,
+		bins:    append([]int(nil), bins...),
+		twiddle: make([]complex128, len(bins)),
+		values:  make([]complex128, len(bins)),
+		window:  make([]float32, n),
+	}
+	for i, k := range bins {
+		if k < 0 || k >= n {
+			panic(ErrLengthMismatch)
+		}
+		s, c := math.Sincos(2 * math.Pi * float64(k) / float64(n))
+		d.twiddle[i] = complex(c, s)
+	}
+	return d
+}
+
+// Len returns the number of points N.
+func (d *SlidingDFT) Len() int {
+	return d.n
+}
+
+// Reset empties the window.
+func (d *SlidingDFT) Reset() {
+	clear(d.window)
+	clear(d.values)
+	d.pos = 0
+}
+
+// Update slides the window forward by one sample.
+func (d *SlidingDFT) Update(x float32) {
+	delta := complex(float64(x)-float64(d.window[d.pos]), 0)
+	d.window[d.pos] = x
+	d.pos++
+	if d.pos == d.n {
+		d.pos = 0
+		d.resync()
+		return
+	}
+	for i, v := range d.values {
+		d.values[i] = (v + delta) * d.twiddle[i]
+	}
+}
+
+// Process slides the window over every sample in samples.
+func (d *SlidingDFT) Process(samples []float32) {
+	for _, x := range samples {
+		d.Update(x)
+	}
+}
+
+// Bin returns the current value of the i-th tracked bin.
+func (d *SlidingDFT) Bin(i int) complex128 {
+	return d.values[i]
+}
+
+// Power returns the squared magnitude of the i-th tracked bin.
+func (d *SlidingDFT) Power(i int) float64 {
+	v := d.values[i]
+	return real(v)*real(v) + imag(v)*imag(v)
+}
+
+// resync recomputes every bin from the window, which is in order when pos
+// is 0.
+func (d *SlidingDFT) resync() {
+	for i, k := range d.bins {
+		d.values[i] = Goertzel(d.window, float64(k), float64(d.n))
+	}
+}
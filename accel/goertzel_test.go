@@ -0,0 +1,110 @@
+package accel
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/samuel/go-accelerate/accel/internal/testsignal"
+)
+
+func TestGoertzel(t *testing.T) {
+	const n = 256
+	x := testsignal.Make(n, 2*math.Pi*12/n)
+	fft, err := NewRealFFT(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fft.Destroy()
+	spectrum := fft.Forward(nil, x)
+	for _, k := range []int{0, 1, 12, 77, 128} {
+		got := Goertzel(x, float64(k), n)
+		want := complex128(spectrum[k])
+		if cmplx.Abs(got-want) > 1e-3*math.Max(1, cmplx.Abs(want)) {
+			t.Errorf("bin %d = %v; FFT gave %v", k, got, want)
+		}
+		if p := GoertzelPower(x, float64(k), n); math.Abs(p-real(want*cmplx.Conj(want))) > 1e-3*math.Max(1, p) {
+			t.Errorf("bin %d power = %f; FFT gave %f", k, p, real(want*cmplx.Conj(want)))
+		}
+	}
+
+	// A frequency between bins against a direct DFT.
+	var want complex128
+	for i, v := range x {
+		want += complex(float64(v), 0) * cmplx.Exp(complex(0, -2*math.Pi*12.3*float64(i)/n))
+	}
+	if got := Goertzel(x, 12.3, n); cmplx.Abs(got-want) > 1e-3 {
+		t.Errorf("bin 12.3 = %v; want %v", got, want)
+	}
+	if got := Goertzel(nil, 1, 2); got != 0 {
+		t.Errorf("Goertzel of no samples = %v; want 0", got)
+	}
+}
+
+func TestGoertzelDTMF(t *testing.T) {
+	const fs, n = 8000, 205
+	rows := []float64{697, 770, 852, 941}
+	cols := []float64{1209, 1336, 1477, 1633}
+	// The digit 5.
+	x := make([]float32, n)
+	for i := range x {
+		x[i] = float32(math.Sin(2*math.Pi*770*float64(i)/fs) + math.Sin(2*math.Pi*1336*float64(i)/fs))
+	}
+	loudest := func(freqs []float64) float64 {
+		best, bestPower := 0.0, 0.0
+		for _, f := range freqs {
+			if p := GoertzelPower(x, f, fs); p > bestPower {
+				best, bestPower = f, p
+			}
+		}
+		return best
+	}
+	if r, c := loudest(rows), loudest(cols); r != 770 || c != 1336 {
+		t.Errorf("detected %v Hz and %v Hz; want 770 Hz and 1336 Hz", r, c)
+	}
+}
+
+func TestSlidingDFT(t *testing.T) {
+	const n = 64
+	bins := []int{0, 3, 12, 32, 63}
+	x := testsignal.Make(1000, 0.3)
+	d := NewSlidingDFT(n, bins)
+	fft, err := NewRealFFT(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fft.Destroy()
+	var spectrum []complex64
+	fed := 0
+	for _, size := range []int{10, 54, 1, 100, 63, 64, 500, 208} {
+		d.Process(x[fed : fed+size])
+		fed += size
+		window := make([]float32, n)
+		copy(window[max(n-fed, 0):], x[max(fed-n, 0):fed])
+		spectrum = fft.Forward(spectrum, window)
+		for i, k := range bins {
+			want := complex128(spectrum[min(k, n-k)])
+			if k > n/2 {
+				want = cmplx.Conj(want)
+			}
+			if got := d.Bin(i); cmplx.Abs(got-want) > 1e-3 {
+				t.Fatalf("after %d samples bin %d = %v; FFT gave %v", fed, k, got, want)
+			}
+			if p := d.Power(i); math.Abs(p-real(want*cmplx.Conj(want))) > 1e-2 {
+				t.Fatalf("after %d samples bin %d power = %f; want %f", fed, k, p, real(want*cmplx.Conj(want)))
+			}
+		}
+	}
+	d.Reset()
+	if d.Bin(1) != 0 {
+		t.Errorf("bin after Reset = %v; want 0", d.Bin(1))
+	}
+}
+
+func BenchmarkGoertzel(b *testing.B) {
+	x := testsignal.Make(4096, 0.3)
+	b.SetBytes(int64(len(x) * 4))
+	for i := 0; i < b.N; i++ {
+		GoertzelPower(x, 1000, 48000)
+	}
+}
@@ -0,0 +1,86 @@
+package accel
+
+import "math"
+
+// Hilbert returns the analytic signal of x, x + i·H(x) where H is the
+// Hilbert transform, computed by zeroing the negative frequencies of the
+// DFT of x. The real part equals x. Any length is accepted.
+//
+// The DFT treats x as periodic, so a signal that does not contain a whole
+// number of cycles shows ripples near its ends.
+func Hilbert(x []float32) DSPSplitComplex {
+	n := len(x)
+	out := makeSplit(n)
+	if n == 0 {
+		return out
+	}
+	fft, err := NewFFT(n)
+	if err != nil {
+		panic(err)
+	}
+	defer fft.Destroy()
+	spec := makeSplit(n)
+	copy(out.Real, x)
+	fft.Transform(out, spec, FFTDirectionForward)
+	// Keep DC (and Nyquist for even n), double the positive frequencies
+	// and clear the negative ones, folding in the 1/n of the inverse.
+	half := (n + 1) / 2
+	scale := float32(1) / float32(n)
+	for _, part := range [][]float32{spec.Real, spec.Imag} {
+		part[0] *= scale
+		if n%2 == 0 {
+			part[n/2] *= scale
+		}
+		if half > 1 {
+			Vsmsa(part[1:half], 1, 2*scale, 0, part[1:half], 1)
+		}
+		if n/2+1 < n {
+			Vclr(part[n/2+1:], 1)
+		}
+	}
+	fft.Transform(spec, out, FFTDirectionInverse)
+	return out
+}
+
+// Envelope returns the magnitude of the analytic signal of x, the
+// amplitude envelope that an AM demodulator recovers.
+func Envelope(x []float32) []float32 {
+	a := Hilbert(x)
+	env := make([]float32, len(x))
+	if len(x) > 0 {
+		Zvabs(a, 1, env, 1)
+	}
+	return env
+}
+
+// InstantaneousPhase returns the unwrapped phase of the analytic signal of
+// x in radians.
+func InstantaneousPhase(x []float32) []float32 {
+	a := Hilbert(x)
+	phase := make([]float32, len(x))
+	if len(x) > 0 {
+		Zvphas(a, 1, phase, 1)
+	}
+	Unwrap(phase, math.Pi)
+	return phase
+}
+
+// InstantaneousFrequency returns the frequency of x between successive
+// samples, in the unit of sampleRate: element i is the phase advance of
+// the analytic signal from sample i to i+1 scaled to a frequency, so the
+// result has len(x)-1 elements.
+func InstantaneousFrequency(x []float32, sampleRate float64) []float32 {
+	if len(x) < 2 {
+		return nil
+	}
+	a := Hilbert(x)
+	n := len(x) - 1
+	// conj(a[i])·a[i+1] has the phase advance as its argument, which
+	// needs no unwrapping.
+	prod := makeSplit(n)
+	Zvcmul(DSPSplitComplex{Real: a.Real[:n], Imag: a.Imag[:n]}, 1, DSPSplitComplex{Real: a.Real[1:], Imag: a.Imag[1:]}, 1, prod, 1)
+	freq := make([]float32, n)
+	Zvphas(prod, 1, freq, 1)
+	Vsmsa(freq, 1, float32(sampleRate/(2*math.Pi)), 0, freq, 1)
+	return freq
+}
@@ -0,0 +1,82 @@
+package accel
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHilbert(t *testing.T) {
+	for _, n := range []int{64, 100, 77} {
+		// Whole cycles of a cosine give the sine as the imaginary part.
+		x := make([]float32, n)
+		for i := range x {
+			x[i] = float32(math.Cos(2 * math.Pi * 5 * float64(i) / float64(n)))
+		}
+		a := Hilbert(x)
+		for i := range x {
+			want := math.Sin(2 * math.Pi * 5 * float64(i) / float64(n))
+			if !almostEqual32(a.Real[i], x[i], 1e-5) || !almostEqual64(float64(a.Imag[i]), want, 1e-5) {
+				t.Fatalf("n=%d: sample %d = %f%+fi; want %f%+fi", n, i, a.Real[i], a.Imag[i], x[i], want)
+			}
+		}
+	}
+	if a := Hilbert(nil); len(a.Real) != 0 {
+		t.Errorf("Hilbert(nil) has %d samples", len(a.Real))
+	}
+}
+
+func TestEnvelopeAM(t *testing.T) {
+	const fs, fc, fm, n = 8000.0, 1000.0, 50.0, 8000
+	x := make([]float32, n)
+	for i := range x {
+		tt := float64(i) / fs
+		x[i] = float32((1 + 0.5*math.Cos(2*math.Pi*fm*tt)) * math.Cos(2*math.Pi*fc*tt))
+	}
+	env := Envelope(x)
+	for i, v := range env {
+		want := 1 + 0.5*math.Cos(2*math.Pi*fm*float64(i)/fs)
+		if !almostEqual64(float64(v), want, 1e-4) {
+			t.Fatalf("envelope [%d] = %f; want %f", i, v, want)
+		}
+	}
+}
+
+func TestInstantaneousPhase(t *testing.T) {
+	const n, cycles = 400, 37
+	x := make([]float32, n)
+	for i := range x {
+		x[i] = float32(math.Cos(2*math.Pi*cycles*float64(i)/n + 0.3))
+	}
+	phase := InstantaneousPhase(x)
+	for i, p := range phase {
+		want := 2*math.Pi*cycles*float64(i)/n + 0.3
+		if !almostEqual64(float64(p), want, 1e-3) {
+			t.Fatalf("phase [%d] = %f; want %f", i, p, want)
+		}
+	}
+}
+
+func TestInstantaneousFrequencyChirp(t *testing.T) {
+	// A linear chirp from 200 Hz to 1800 Hz over one second.
+	const fs, f0, f1, n = 8000.0, 200.0, 1800.0, 8000
+	k := (f1 - f0) / (n / fs)
+	x := make([]float32, n)
+	for i := range x {
+		tt := float64(i) / fs
+		x[i] = float32(math.Cos(2 * math.Pi * (f0*tt + k*tt*tt/2)))
+	}
+	freq := InstantaneousFrequency(x, fs)
+	if len(freq) != n-1 {
+		t.Fatalf("%d frequencies; want %d", len(freq), n-1)
+	}
+	// The ends suffer from the periodic extension; check the middle.
+	for i := n / 10; i < n-n/10; i++ {
+		want := f0 + k*(float64(i)+0.5)/fs
+		if math.Abs(float64(freq[i])-want) > 2 {
+			t.Fatalf("frequency [%d] = %f Hz; want %f Hz", i, freq[i], want)
+		}
+	}
+	if f := InstantaneousFrequency(x[:1], fs); f != nil {
+		t.Errorf("InstantaneousFrequency of one sample = %v; want nil", f)
+	}
+}
@@ -0,0 +1,33 @@
+// Package freqz evaluates transfer functions on the unit circle. It backs
+// accel.FreqzAt and is shared with the filter package, which accel imports
+// and which therefore cannot import accel.
+package freqz
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// At evaluates the frequency response of the filter with numerator b and
+// denominator a (empty for an FIR filter) at frequencies in cycles per
+// sample.
+func At(b, a []float64, freqs []float64) []complex128 {
+	h := make([]complex128, len(freqs))
+	for i, f := range freqs {
+		z := cmplx.Exp(complex(0, -2*math.Pi*f))
+		h[i] = Polyval(b, z)
+		if len(a) != 0 {
+			h[i] /= Polyval(a, z)
+		}
+	}
+	return h
+}
+
+// Polyval returns Σ c[k]·z^k by Horner's rule.
+func Polyval(c []float64, z complex128) complex128 {
+	var sum complex128
+	for k := len(c) - 1; k >= 0; k-- {
+		sum = sum*z + complex(c[k], 0)
+	}
+	return sum
+}
@@ -0,0 +1,22 @@
+// Package testsignal generates the reproducible signals shared by the tests
+// of accel and its subpackages.
+package testsignal
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Make returns n samples of a unit sine at freq radians per sample mixed
+// with a weaker cosine at 0.9 radians per sample, a DC offset of 0.2 and
+// uniform noise of amplitude 0.05. The noise comes from a fixed seed, so
+// equal arguments give equal signals.
+func Make(n int, freq float64) []float32 {
+	rnd := rand.New(rand.NewSource(1))
+	x := make([]float32, n)
+	for i := range x {
+		t := float64(i)
+		x[i] = float32(math.Sin(freq*t) + 0.3*math.Cos(0.9*t) + 0.2 + 0.1*(rnd.Float64()-0.5))
+	}
+	return x
+}
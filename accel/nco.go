@@ -0,0 +1,86 @@
+package accel
+
+import "math"
+
+// NCO is a numerically controlled oscillator producing the complex
+// exponential e^(iφ[n]) with φ advancing by 2π·freq/sampleRate per
+// sample. Its phase carries over from one call to the next, so a stream
+// mixed or shifted block by block has no phase jumps at block edges.
+//
+// The phase is kept in float64 and wrapped every block, so it does not
+// drift over long streams. An NCO holds a scratch buffer and must not be
+// used concurrently.
+type NCO struct {
+	phase float64 // in cycles, in [0, 1)
+	step  float64 // in cycles per sample
+	buf   DSPSplitComplex
+}
+
+// NewNCO returns an oscillator at freq (negative for a clockwise rotation)
+// for the given sample rate, starting at phase 0.
+func NewNCO(freq, sampleRate float64) *NCO {
+	o := &NCO{}
+	o.SetFrequency(freq, sampleRate)
+	return o
+}
+
+// SetFrequency changes the frequency without disturbing the phase.
+func (o *NCO) SetFrequency(freq, sampleRate float64) {
+	o.step = freq / sampleRate
+}
+
+// NormalizedFrequency returns the frequency in cycles per sample, that is
+// the freq passed to SetFrequency divided by its sampleRate.
+func (o *NCO) NormalizedFrequency() float64 {
+	return o.step
+}
+
+// Phase returns the phase of the next sample in radians, in [0, 2π).
+func (o *NCO) Phase() float64 {
+	return 2 * math.Pi * o.phase
+}
+
+// SetPhase sets the phase of the next sample in radians.
+func (o *NCO) SetPhase(phase float64) {
+	o.phase = wrapCycles(phase / (2 * math.Pi))
+}
+
+// Generate fills dst with the next len(dst.Real) samples of the
+// oscillator.
+func (o *NCO) Generate(dst DSPSplitComplex) {
+	n := minLenGeneric(len(dst.Real), len(dst.Imag))
+	for i := 0; i < n; i++ {
+		s, c := math.Sincos(2 * math.Pi * (o.phase + float64(i)*o.step))
+		dst.Real[i] = float32(c)
+		dst.Imag[i] = float32(s)
+	}
+	o.phase = wrapCycles(o.phase + float64(n)*o.step)
+}
+
+// Mix multiplies data in place by the next len(data.Real) samples of the
+// oscillator, shifting its spectrum up by the oscillator's frequency.
+func (o *NCO) Mix(data DSPSplitComplex) {
+	n := len(data.Real)
+	if len(data.Imag) != n {
+		panic(ErrLengthMismatch)
+	}
+	if n == 0 {
+		return
+	}
+	o.buf.Real = growFloat32(o.buf.Real, n)
+	o.buf.Imag = growFloat32(o.buf.Imag, n)
+	o.Generate(o.buf)
+	Zvmul(o.buf, 1, data, 1, data, 1, false)
+}
+
+// FreqShift shifts the spectrum of data in place by hz, which may be
+// negative, at the given sample rate. Successive calls continue the phase
+// of the previous one, also when hz changes between them.
+func (o *NCO) FreqShift(data DSPSplitComplex, hz, sampleRate float64) {
+	o.SetFrequency(hz, sampleRate)
+	o.Mix(data)
+}
+
+func wrapCycles(c float64) float64 {
+	return c - math.Floor(c)
+}
@@ -0,0 +1,62 @@
+package accel
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestNCOGenerate(t *testing.T) {
+	const fs, freq = 48000.0, 1234.5
+	o := NewNCO(freq, fs)
+	out := makeSplit(1000)
+	// Blocks of different sizes continue the phase.
+	for start, size := 0, 1; start < len(out.Real); start, size = start+size, size*2 {
+		end := min(start+size, len(out.Real))
+		o.Generate(DSPSplitComplex{Real: out.Real[start:end], Imag: out.Imag[start:end]})
+	}
+	for i := range out.Real {
+		want := cmplx.Exp(complex(0, 2*math.Pi*freq*float64(i)/fs))
+		if !almostEqual32(out.Real[i], float32(real(want)), 1e-6) || !almostEqual32(out.Imag[i], float32(imag(want)), 1e-6) {
+			t.Fatalf("sample %d = %f%+fi; want %v", i, out.Real[i], out.Imag[i], want)
+		}
+	}
+	want := math.Mod(2*math.Pi*freq*1000/fs, 2*math.Pi)
+	if p := o.Phase(); math.Abs(p-want) > 1e-9 {
+		t.Errorf("Phase() = %f; want %f", p, want)
+	}
+	o.SetPhase(-math.Pi / 2)
+	if p := o.Phase(); math.Abs(p-3*math.Pi/2) > 1e-12 {
+		t.Errorf("Phase() after SetPhase(-π/2) = %f; want 3π/2", p)
+	}
+}
+
+func TestFreqShift(t *testing.T) {
+	const fs, n = 1000.0, 4096
+	// A tone at 100 Hz shifted by -150 Hz in blocks ends up at -50 Hz.
+	data := makeSplit(n)
+	NewNCO(100, fs).Generate(data)
+	o := NewNCO(0, fs)
+	for start := 0; start < n; start += 333 {
+		end := min(start+333, n)
+		o.FreqShift(DSPSplitComplex{Real: data.Real[start:end], Imag: data.Imag[start:end]}, -150, fs)
+	}
+	for i := range data.Real {
+		want := cmplx.Exp(complex(0, -2*math.Pi*50*float64(i)/fs))
+		if !almostEqual32(data.Real[i], float32(real(want)), 1e-5) || !almostEqual32(data.Imag[i], float32(imag(want)), 1e-5) {
+			t.Fatalf("sample %d = %f%+fi; want %v", i, data.Real[i], data.Imag[i], want)
+		}
+	}
+	if f := o.NormalizedFrequency(); f != -0.15 {
+		t.Errorf("NormalizedFrequency() = %f; want -0.15", f)
+	}
+}
+
+func BenchmarkNCOMix(b *testing.B) {
+	o := NewNCO(12345, 2400000)
+	data := makeSplit(4096)
+	b.SetBytes(int64(len(data.Real) * 8))
+	for i := 0; i < b.N; i++ {
+		o.Mix(data)
+	}
+}
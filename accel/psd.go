@@ -0,0 +1,138 @@
+package accel
+
+// PSDSides selects a one-sided or two-sided power spectral density.
+type PSDSides int
+
+const (
+	// PSDOneSided returns the non-negative frequencies of a real signal
+	// with the power of the negative ones folded in, so integrating the
+	// density from 0 to sampleRate/2 gives the signal power.
+	PSDOneSided PSDSides = iota
+	// PSDTwoSided returns every frequency from -sampleRate/2 upwards in
+	// ascending order.
+	PSDTwoSided
+)
+
+// WelchPSD estimates the power spectral density of a real signal with
+// Welch's method: the signal is cut into segments of len(window) samples
+// overlapping by overlap samples, each segment is multiplied by window and
+// transformed, and the squared magnitudes are averaged. Segments that
+// would run past the end of the signal are dropped. The accel/window
+// package generates the usual windows, for example
+//
+//	w := window.Hann(make([]float32, 256), window.Periodic)
+//
+// The density is in units² per Hz: it is divided by sampleRate and by the
+// window's power Σw[n]², so white noise of variance σ² has a one-sided
+// density of 2σ²/sampleRate regardless of the window. freqs holds the
+// frequency of every bin in Hz. Use PSDToDecibels for dB/Hz.
+func WelchPSD(samples []float32, sampleRate float32, window []float32, overlap int, sides PSDSides) (freqs, psd []float32, err error) {
+	segmentLen := len(window)
+	buf := make([]complex64, segmentLen)
+	acc, err := welch(len(samples), sampleRate, window, overlap, func(start int, w []float32) []complex64 {
+		for i, v := range samples[start : start+segmentLen] {
+			buf[i] = complex(v*w[i], 0)
+		}
+		return buf
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if sides == PSDTwoSided {
+		freqs, psd = twoSided(acc, sampleRate)
+		return freqs, psd, nil
+	}
+	bins := segmentLen/2 + 1
+	freqs = make([]float32, bins)
+	psd = make([]float32, bins)
+	for k := range psd {
+		freqs[k] = float32(k) * sampleRate / float32(segmentLen)
+		psd[k] = float32(acc[k])
+		// Fold in the matching negative frequency; DC and the Nyquist bin
+		// of an even length have none.
+		if k != 0 && 2*k != segmentLen {
+			psd[k] *= 2
+		}
+	}
+	return freqs, psd, nil
+}
+
+// WelchPSDComplex is the complex counterpart of WelchPSD. The density of a
+// complex signal is not symmetric, so it is always two-sided.
+func WelchPSDComplex(samples []complex64, sampleRate float32, window []float32, overlap int) (freqs, psd []float32, err error) {
+	segmentLen := len(window)
+	buf := make([]complex64, segmentLen)
+	acc, err := welch(len(samples), sampleRate, window, overlap, func(start int, w []float32) []complex64 {
+		for i, v := range samples[start : start+segmentLen] {
+			buf[i] = v * complex(w[i], 0)
+		}
+		return buf
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	freqs, psd = twoSided(acc, sampleRate)
+	return freqs, psd, nil
+}
+
+// PSDToDecibels converts a power spectral density to dB/Hz relative to one
+// unit² per Hz in place and returns it.
+func PSDToDecibels(psd []float32) []float32 {
+	Vdbcon(psd, 1, 1, psd, 1, DBFlagPower)
+	return psd
+}
+
+// welch averages the scaled periodograms of the windowed segments that
+// segment returns and gives the two-sided density in FFT order.
+func welch(n int, sampleRate float32, w []float32, overlap int, segment func(start int, w []float32) []complex64) ([]float64, error) {
+	segmentLen := len(w)
+	if segmentLen < 1 || overlap < 0 || overlap >= segmentLen || n < segmentLen || !(sampleRate > 0) {
+		return nil, ErrPSDParameters
+	}
+	var power float64
+	for _, v := range w {
+		power += float64(v) * float64(v)
+	}
+	if power == 0 {
+		return nil, ErrPSDParameters
+	}
+	fft, err := NewFFT(segmentLen)
+	if err != nil {
+		return nil, err
+	}
+	defer fft.Destroy()
+
+	acc := make([]float64, segmentLen)
+	var spectrum []complex64
+	step := segmentLen - overlap
+	count := 0
+	for start := 0; start+segmentLen <= n; start += step {
+		spectrum = fft.Forward(spectrum, segment(start, w))
+		for k, v := range spectrum {
+			acc[k] += float64(real(v))*float64(real(v)) + float64(imag(v))*float64(imag(v))
+		}
+		count++
+	}
+	scale := 1 / (float64(sampleRate) * power * float64(count))
+	for k := range acc {
+		acc[k] *= scale
+	}
+	return acc, nil
+}
+
+// twoSided reorders a density in FFT order to ascending frequency.
+func twoSided(acc []float64, sampleRate float32) (freqs, psd []float32) {
+	n := len(acc)
+	shift := (n + 1) / 2
+	freqs = make([]float32, n)
+	psd = make([]float32, n)
+	for i := range psd {
+		k := (i + shift) % n
+		psd[i] = float32(acc[k])
+		if k >= shift {
+			k -= n
+		}
+		freqs[i] = float32(k) * sampleRate / float32(n)
+	}
+	return freqs, psd
+}
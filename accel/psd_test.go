@@ -0,0 +1,150 @@
+package accel
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/samuel/go-accelerate/accel/window"
+)
+
+func TestWelchPSDSine(t *testing.T) {
+	const (
+		fs     = 1000
+		n      = 8192
+		amp    = 2.0
+		freq   = 125.0
+		segLen = 256
+	)
+	x := make([]float32, n)
+	for i := range x {
+		x[i] = float32(amp * math.Sin(2*math.Pi*freq*float64(i)/fs))
+	}
+	for name, w := range map[string][]float32{
+		"hann":        window.Hann(make([]float32, segLen), window.Periodic),
+		"hamming":     window.Hamming(make([]float32, segLen), window.Periodic),
+		"blackman":    window.Blackman(make([]float32, segLen), window.Periodic),
+		"rectangular": window.Rectangular(make([]float32, segLen)),
+	} {
+		freqs, psd, err := WelchPSD(x, fs, w, segLen/2, PSDOneSided)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(psd) != segLen/2+1 || freqs[1] != float32(fs)/segLen || freqs[segLen/2] != fs/2 {
+			t.Fatalf("%s: unexpected bins %d, df %f, last %f", name, len(psd), freqs[1], freqs[len(freqs)-1])
+		}
+		peak := 0
+		var total float64
+		for k, v := range psd {
+			if v > psd[peak] {
+				peak = k
+			}
+			total += float64(v)
+		}
+		if freqs[peak] != freq {
+			t.Errorf("%s: peak at %f Hz; want %f", name, freqs[peak], freq)
+		}
+		// Integrating the density gives the signal power A²/2 whatever
+		// the window.
+		if power := total * fs / segLen; math.Abs(power-amp*amp/2) > 1e-2 {
+			t.Errorf("%s: integrated power %f; want %f", name, power, amp*amp/2)
+		}
+	}
+}
+
+func TestWelchPSDWhiteNoise(t *testing.T) {
+	const fs, n = 48000, 1 << 16
+	// A deterministic pseudo-random sequence with variance 1/3.
+	x := make([]float32, n)
+	seed := uint32(1)
+	for i := range x {
+		seed = seed*1664525 + 1013904223
+		x[i] = float32(seed)/float32(math.MaxUint32)*2 - 1
+	}
+	_, psd, err := WelchPSD(x, fs, window.Hann(make([]float32, 512), window.Periodic), 256, PSDOneSided)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mean float64
+	for _, v := range psd[1 : len(psd)-1] {
+		mean += float64(v)
+	}
+	mean /= float64(len(psd) - 2)
+	if want := 2.0 / 3 / fs; math.Abs(mean-want)/want > 0.05 {
+		t.Errorf("mean density %g; want %g", mean, want)
+	}
+}
+
+func TestWelchPSDTwoSided(t *testing.T) {
+	const fs, segLen = 100, 10
+	x := make([]float32, 200)
+	for i := range x {
+		x[i] = float32(math.Cos(float64(i)*0.7) + 0.3)
+	}
+	hann := window.Hann(make([]float32, segLen), window.Periodic)
+	_, one, err := WelchPSD(x, fs, hann, 5, PSDOneSided)
+	if err != nil {
+		t.Fatal(err)
+	}
+	freqs, two, err := WelchPSD(x, fs, hann, 5, PSDTwoSided)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if freqs[0] != -50 || freqs[5] != 0 || freqs[9] != 40 {
+		t.Errorf("two-sided freqs = %v", freqs)
+	}
+	var sumOne, sumTwo float64
+	for _, v := range one {
+		sumOne += float64(v)
+	}
+	for _, v := range two {
+		sumTwo += float64(v)
+	}
+	if math.Abs(sumOne-sumTwo) > 1e-6 {
+		t.Errorf("one-sided total %f != two-sided total %f", sumOne, sumTwo)
+	}
+
+	// A complex exponential only has power at its positive frequency.
+	c := make([]complex64, 256)
+	for i := range c {
+		c[i] = complex64(cmplx.Exp(complex(0, 2*math.Pi*20*float64(i)/fs)))
+	}
+	freqs, psd, err := WelchPSDComplex(c, fs, window.Rectangular(make([]float32, 20)), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, f := range freqs {
+		if f == 20 {
+			if want := float32(1.0 / 5); math.Abs(float64(psd[i]-want)) > 1e-5 {
+				t.Errorf("density at 20 Hz = %f; want %f", psd[i], want)
+			}
+		} else if psd[i] > 1e-6 {
+			t.Errorf("density at %f Hz = %g; want 0", f, psd[i])
+		}
+	}
+	if db := PSDToDecibels([]float32{100, 1}); math.Abs(float64(db[0]-20)) > 1e-4 || db[1] != 0 {
+		t.Errorf("PSDToDecibels = %v; want [20 0]", db)
+	}
+}
+
+func TestWelchPSDErrors(t *testing.T) {
+	x := make([]float32, 100)
+	for _, c := range []struct {
+		fs              float32
+		segLen, overlap int
+	}{
+		{1, 0, 0},
+		{1, 10, 10},
+		{1, 10, -1},
+		{1, 101, 0},
+		{0, 10, 0},
+	} {
+		w := window.Hann(make([]float32, c.segLen), window.Periodic)
+		if _, _, err := WelchPSD(x, c.fs, w, c.overlap, PSDOneSided); err != ErrPSDParameters {
+			t.Errorf("WelchPSD(%+v) returned %v; want %v", c, err, ErrPSDParameters)
+		}
+	}
+	if _, _, err := WelchPSD(x, 1, make([]float32, 10), 0, PSDOneSided); err != ErrPSDParameters {
+		t.Errorf("WelchPSD with an all-zero window returned %v; want %v", err, ErrPSDParameters)
+	}
+}
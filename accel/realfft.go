@@ -0,0 +1,107 @@
+package accel
+
+import "math/bits"
+
+// RealFFT computes the discrete Fourier transform of real signals of a
+// fixed length. It hides vDSP's packed split-complex format: the forward
+// transform returns the N/2+1 non-negative frequency bins as complex
+// values, and the inverse takes them back to N real samples.
+//
+// The forward transform is unnormalised (X[k] = Σ x[n]·e^(-2πikn/N)) and
+// the inverse divides by N, so Inverse(Forward(x)) == x.
+//
+// A RealFFT holds scratch buffers and must not be used concurrently.
+type RealFFT struct {
+	n     int
+	log2n int
+	setup *FFTSetup
+	buf   DSPSplitComplex
+}
+
+// NewRealFFT returns a RealFFT for signals of n samples. n must be a power
+// of two of at least 2.
+func NewRealFFT(n int) (*RealFFT, error) {
+	if n < 2 || n&(n-1) != 0 {
+		return nil, ErrFFTLength
+	}
+	log2n := bits.TrailingZeros(uint(n))
+	setup, err := CreateFFTSetup(log2n, FFTRadix2)
+	if err != nil {
+		return nil, err
+	}
+	return &RealFFT{
+		n:     n,
+		log2n: log2n,
+		setup: setup,
+		buf:   DSPSplitComplex{Real: make([]float32, n/2), Imag: make([]float32, n/2)},
+	}, nil
+}
+
+// Len returns the number of real samples the transform operates on.
+func (f *RealFFT) Len() int {
+	return f.n
+}
+
+// Bins returns the number of complex bins produced by Forward (Len()/2+1).
+func (f *RealFFT) Bins() int {
+	return f.n/2 + 1
+}
+
+// Destroy releases the underlying FFT setup.
+func (f *RealFFT) Destroy() {
+	f.setup.Destroy()
+}
+
+// Forward transforms the Len() samples in src and stores the Bins() complex
+// bins in dst, which is allocated if it is too small. It returns dst
+// resliced to Bins().
+func (f *RealFFT) Forward(dst []complex64, src []float32) []complex64 {
+	if len(src) != f.n {
+		panic(ErrLengthMismatch)
+	}
+	dst = growComplex64(dst, f.Bins())
+	half := f.n / 2
+	Ctoz_float(src, 2, f.buf, 1)
+	f.setup.Zrip(f.buf, 1, f.log2n, FFTDirectionForward)
+	// vDSP scales the forward real transform by 2.
+	Vsmsa(f.buf.Real, 1, 0.5, 0, f.buf.Real, 1)
+	Vsmsa(f.buf.Imag, 1, 0.5, 0, f.buf.Imag, 1)
+	Ztoc(f.buf, 1, dst, 2)
+	dst[0] = complex(f.buf.Real[0], 0)
+	dst[half] = complex(f.buf.Imag[0], 0)
+	return dst
+}
+
+// Inverse transforms the Bins() complex bins in src back to Len() real
+// samples stored in dst, which is allocated if it is too small. The
+// imaginary parts of the DC and Nyquist bins are ignored. It returns dst
+// resliced to Len().
+func (f *RealFFT) Inverse(dst []float32, src []complex64) []float32 {
+	if len(src) != f.Bins() {
+		panic(ErrLengthMismatch)
+	}
+	dst = growFloat32(dst, f.n)
+	half := f.n / 2
+	Ctoz(src[:half], 2, f.buf, 1)
+	f.buf.Imag[0] = real(src[half])
+	f.setup.Zrip(f.buf, 1, f.log2n, FFTDirectionInverse)
+	scale := 1 / float32(f.n)
+	Vsmsa(f.buf.Real, 1, scale, 0, f.buf.Real, 1)
+	Vsmsa(f.buf.Imag, 1, scale, 0, f.buf.Imag, 1)
+	Ztoc_float(f.buf, 1, dst, 2)
+	return dst
+}
+
+func growComplex64(s []complex64, n int) []complex64 {
+	if cap(s) < n {
+		return make([]complex64, n)
+	}
+	return s[:n]
+}
+
+func growFloat32(s []float32, n int) []float32 {
+	if cap(s) < n {
+		return make([]float32, n)
+	}
+	return s[:n]
+}
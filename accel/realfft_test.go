@@ -0,0 +1,64 @@
+package accel
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRealFFT(t *testing.T) {
+	for _, n := range []int{2, 4, 32, 256} {
+		fft, err := NewRealFFT(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		signal := make([]float32, n)
+		input := make([]complex128, n)
+		for i := range signal {
+			signal[i] = float32(math.Sin(float64(i)*0.4) + 0.3*math.Cos(float64(i)*1.7) + 0.1)
+			input[i] = complex(float64(signal[i]), 0)
+		}
+		spectrum := fft.Forward(nil, signal)
+		if len(spectrum) != n/2+1 {
+			t.Fatalf("n=%d: Forward returned %d bins; want %d", n, len(spectrum), n/2+1)
+		}
+		expected := naiveDFT(input, -1)
+		tol := 1e-4 * float64(n)
+		for k, c := range spectrum {
+			e := expected[k]
+			if !almostEqual64(float64(real(c)), real(e), tol) || !almostEqual64(float64(imag(c)), imag(e), tol) {
+				t.Errorf("n=%d: bin %d = %v; want %v", n, k, c, e)
+			}
+		}
+
+		out := fft.Inverse(make([]float32, 0, n), spectrum)
+		for i, x := range signal {
+			if !almostEqual32(out[i], x, 1e-4) {
+				t.Errorf("n=%d: round trip [%d] = %f; want %f", n, i, out[i], x)
+			}
+		}
+		fft.Destroy()
+	}
+}
+
+func TestRealFFTLength(t *testing.T) {
+	for _, n := range []int{0, 1, 3, 12} {
+		if _, err := NewRealFFT(n); err != ErrFFTLength {
+			t.Errorf("NewRealFFT(%d) returned %v; want %v", n, err, ErrFFTLength)
+		}
+	}
+}
+
+func BenchmarkRealFFTForward(b *testing.B) {
+	fft, err := NewRealFFT(4096)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer fft.Destroy()
+	signal := make([]float32, fft.Len())
+	spectrum := make([]complex64, fft.Bins())
+	b.SetBytes(int64(len(signal) * 4))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		fft.Forward(spectrum, signal)
+	}
+}
@@ -0,0 +1,190 @@
+package accel
+
+import (
+	"github.com/samuel/go-accelerate/accel/filter"
+	"github.com/samuel/go-accelerate/accel/window"
+)
+
+// resamplerAttenuation is the stopband attenuation in dB of the
+// anti-aliasing filter designed by NewResampler.
+const resamplerAttenuation = 80
+
+// Resampler converts a real or split-complex stream between two sample
+// rates whose ratio is rational, such as 44100 to 48000 Hz or 2.4 MS/s to
+// 48 kS/s. It upsamples by L, low-pass filters and downsamples by M
+// (L/M being the reduced ratio of the rates) in a single polyphase pass,
+// with every branch of the filter applied by Desamp or Zrdesamp.
+//
+// The anti-aliasing filter is a Kaiser-windowed sinc with 80 dB of
+// stopband attenuation. Its stopband starts at the lower of the two
+// Nyquist frequencies and its passband ends at 80% of it.
+//
+// A Resampler keeps the tail of the input and its phase between calls, so
+// a stream can be fed in blocks of any size with the same result as in
+// one call. It must not be used concurrently.
+type Resampler struct {
+	up, down int
+	// phases[p] is branch p of the filter, reversed for Desamp.
+	phases [][]float32
+	// t is the position of the next output in upsampled samples from the
+	// start of the next block.
+	t int
+	// hist holds the last len(phases[0])-1 input samples.
+	hist    DSPSplitComplex
+	buf     DSPSplitComplex
+	scratch DSPSplitComplex
+}
+
+// NewResampler returns a resampler from inRate to outRate samples per
+// second.
+func NewResampler(inRate, outRate int) (*Resampler, error) {
+	if inRate <= 0 || outRate <= 0 {
+		return nil, ErrResampleRate
+	}
+	g := gcd(inRate, outRate)
+	up, down := outRate/g, inRate/g
+	taps, err := resamplerFilter(up, down)
+	if err != nil {
+		return nil, err
+	}
+	k := len(taps) / up
+	phases := make([][]float32, up)
+	for p := range phases {
+		phases[p] = make([]float32, k)
+		for j := 0; j < k; j++ {
+			phases[p][k-1-j] = taps[p+j*up]
+		}
+	}
+	return &Resampler{
+		up:     up,
+		down:   down,
+		phases: phases,
+		hist:   makeSplit(k - 1),
+	}, nil
+}
+
+// resamplerFilter designs the prototype filter at the upsampled rate with
+// a gain of up, padded with zeros to a multiple of up taps.
+func resamplerFilter(up, down int) ([]float32, error) {
+	if up == 1 && down == 1 {
+		return []float32{1}, nil
+	}
+	stop := 0.5 / float64(max(up, down))
+	width := 0.2 * stop
+	n, beta := filter.KaiserOrder(resamplerAttenuation, width)
+	n = (n + up - 1) / up * up
+	taps, err := filter.LowPass(n, stop-width/2, window.Kaiser(make([]float64, n), beta, window.Symmetric))
+	if err != nil {
+		return nil, err
+	}
+	Vsmul(taps, 1, float32(up), taps, 1)
+	return taps, nil
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// Ratio returns the reduced upsampling and downsampling factors.
+func (r *Resampler) Ratio() (up, down int) {
+	return r.up, r.down
+}
+
+// Delay returns the delay of the filter in output samples.
+func (r *Resampler) Delay() float64 {
+	return float64(r.up*len(r.phases[0])-1) / 2 / float64(r.down)
+}
+
+// OutputLen returns the number of samples the next call to Process or
+// ProcessComplex produces for n input samples.
+func (r *Resampler) OutputLen(n int) int {
+	return max((r.up*n-r.t+r.down-1)/r.down, 0)
+}
+
+// Reset clears the stored input and phase, as if the stream started
+// again.
+func (r *Resampler) Reset() {
+	clear(r.hist.Real)
+	clear(r.hist.Imag)
+	r.t = 0
+}
+
+// Process resamples the samples in src and stores OutputLen(len(src))
+// samples in dst, which is allocated if it is too small. It returns dst
+// resliced to the output length.
+func (r *Resampler) Process(dst, src []float32) []float32 {
+	n := r.OutputLen(len(src))
+	dst = growFloat32(dst, n)
+	r.buf.Real = r.load(r.buf.Real, r.hist.Real, src)
+	r.scratch.Real = growFloat32(r.scratch.Real, (n+r.up-1)/r.up)
+	r.run(n, func(p, start, count int) {
+		Desamp(r.buf.Real[start:], r.down, r.phases[p], r.scratch.Real[:count])
+	}, func(i, j int) {
+		dst[i] = r.scratch.Real[j]
+	})
+	r.advance(len(src), n)
+	return dst
+}
+
+// ProcessComplex is the split-complex counterpart of Process. A
+// Resampler should carry either a real or a complex stream, as both share
+// the stored input and phase.
+func (r *Resampler) ProcessComplex(dst, src DSPSplitComplex) DSPSplitComplex {
+	if len(src.Imag) != len(src.Real) {
+		panic(ErrLengthMismatch)
+	}
+	n := r.OutputLen(len(src.Real))
+	dst.Real = growFloat32(dst.Real, n)
+	dst.Imag = growFloat32(dst.Imag, n)
+	r.buf.Real = r.load(r.buf.Real, r.hist.Real, src.Real)
+	r.buf.Imag = r.load(r.buf.Imag, r.hist.Imag, src.Imag)
+	count := (n + r.up - 1) / r.up
+	r.scratch.Real = growFloat32(r.scratch.Real, count)
+	r.scratch.Imag = growFloat32(r.scratch.Imag, count)
+	r.run(n, func(p, start, count int) {
+		in := DSPSplitComplex{Real: r.buf.Real[start:], Imag: r.buf.Imag[start:]}
+		out := DSPSplitComplex{Real: r.scratch.Real[:count], Imag: r.scratch.Imag[:count]}
+		Zrdesamp(in, r.down, r.phases[p], out)
+	}, func(i, j int) {
+		dst.Real[i] = r.scratch.Real[j]
+		dst.Imag[i] = r.scratch.Imag[j]
+	})
+	r.advance(len(src.Real), n)
+	return dst
+}
+
+// load returns buf holding hist followed by src and keeps the last
+// len(hist) samples of that in hist.
+func (r *Resampler) load(buf, hist, src []float32) []float32 {
+	m := len(hist)
+	buf = growFloat32(buf, m+len(src))
+	copy(buf, hist)
+	copy(buf[m:], src)
+	copy(hist, buf[len(src):])
+	return buf
+}
+
+// run computes the n outputs of the current block. Outputs i, i+L, i+2L,
+// ... share a branch of the filter and are M input samples apart, so each
+// of the first L outputs starts a Desamp run over the buffer; filter
+// computes count outputs of branch p from buf[start:] into the scratch
+// vector and store moves scratch[j] to output i.
+func (r *Resampler) run(n int, filter func(p, start, count int), store func(i, j int)) {
+	for i := 0; i < min(r.up, n); i++ {
+		t := r.t + i*r.down
+		count := (n - i + r.up - 1) / r.up
+		filter(t%r.up, t/r.up, count)
+		for j := 0; j < count; j++ {
+			store(i+j*r.up, j)
+		}
+	}
+}
+
+// advance moves the phase past a block of in input samples that
+// produced out outputs.
+func (r *Resampler) advance(in, out int) {
+	r.t += out*r.down - r.up*in
+}
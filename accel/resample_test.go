@@ -0,0 +1,135 @@
+package accel
+
+import (
+	"math"
+	"testing"
+)
+
+func TestResamplerRatio(t *testing.T) {
+	for _, c := range []struct{ in, out, up, down int }{
+		{44100, 48000, 160, 147},
+		{2400000, 48000, 1, 50},
+		{8000, 16000, 2, 1},
+		{48000, 48000, 1, 1},
+	} {
+		r, err := NewResampler(c.in, c.out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if up, down := r.Ratio(); up != c.up || down != c.down {
+			t.Errorf("Ratio(%d, %d) = %d/%d; want %d/%d", c.in, c.out, up, down, c.up, c.down)
+		}
+	}
+	if _, err := NewResampler(0, 48000); err != ErrResampleRate {
+		t.Errorf("NewResampler(0, 48000) returned %v; want %v", err, ErrResampleRate)
+	}
+}
+
+// checkTone compares y against a cosine at freq cycles per output sample,
+// delayed by delay samples, after the filter has settled.
+func checkTone(t *testing.T, name string, y []float32, freq, delay, tol float64) {
+	t.Helper()
+	settle := int(2*delay) + 1
+	if settle >= len(y) {
+		t.Fatalf("%s: only %d outputs", name, len(y))
+	}
+	for i := settle; i < len(y); i++ {
+		want := math.Cos(2 * math.Pi * freq * (float64(i) - delay))
+		if math.Abs(float64(y[i])-want) > tol {
+			t.Fatalf("%s: output [%d] = %f; want %f", name, i, y[i], want)
+		}
+	}
+}
+
+func TestResampler(t *testing.T) {
+	for _, c := range []struct{ in, out int }{
+		{44100, 48000},
+		{48000, 44100},
+		{8000, 48000},
+		{48000, 8000},
+		{48000, 48000},
+	} {
+		const tone = 1000.0
+		x := make([]float32, c.in/5)
+		for i := range x {
+			x[i] = float32(math.Cos(2 * math.Pi * tone * float64(i) / float64(c.in)))
+		}
+		r, err := NewResampler(c.in, c.out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n, want := r.OutputLen(len(x)), (len(x)*c.out+c.in-1)/c.in; n != want {
+			t.Errorf("%d->%d: OutputLen = %d; want %d", c.in, c.out, n, want)
+		}
+		whole := r.Process(nil, x)
+		checkTone(t, "whole", whole, tone/float64(c.out), r.Delay(), 1e-3)
+
+		// Blocks of awkward sizes give the same samples.
+		r.Reset()
+		var chunked []float32
+		sizes := []int{1, 0, 17, 441, 3, 1000}
+		for i, start := 0, 0; start < len(x); i++ {
+			end := min(start+sizes[i%len(sizes)], len(x))
+			chunked = append(chunked, r.Process(nil, x[start:end])...)
+			start = end
+		}
+		if len(chunked) != len(whole) {
+			t.Fatalf("%d->%d: %d chunked outputs; want %d", c.in, c.out, len(chunked), len(whole))
+		}
+		for i := range whole {
+			if chunked[i] != whole[i] {
+				t.Fatalf("%d->%d: chunked output [%d] = %g; want %g", c.in, c.out, i, chunked[i], whole[i])
+			}
+		}
+	}
+}
+
+func TestResamplerComplex(t *testing.T) {
+	const in, out = 2400000, 48000
+	r, err := NewResampler(in, out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A 5 kHz tone passes and a 100 kHz one, which would alias to 4 kHz,
+	// is removed.
+	x := makeSplit(in / 10)
+	for i := range x.Real {
+		s, c := math.Sincos(2 * math.Pi * 5000 * float64(i) / in)
+		s2, c2 := math.Sincos(2 * math.Pi * -100000 * float64(i) / in)
+		x.Real[i] = float32(c + c2)
+		x.Imag[i] = float32(s + s2)
+	}
+	var y DSPSplitComplex
+	for start := 0; start < len(x.Real); start += 10007 {
+		end := min(start+10007, len(x.Real))
+		block := r.ProcessComplex(DSPSplitComplex{}, DSPSplitComplex{Real: x.Real[start:end], Imag: x.Imag[start:end]})
+		y.Real = append(y.Real, block.Real...)
+		y.Imag = append(y.Imag, block.Imag...)
+	}
+	if len(y.Real) != out/10 {
+		t.Fatalf("%d outputs; want %d", len(y.Real), out/10)
+	}
+	checkTone(t, "real", y.Real, 5000.0/out, r.Delay(), 1e-3)
+	imag := make([]float32, len(y.Imag))
+	for i, v := range y.Imag {
+		imag[i] = -v
+	}
+	// -sin(θ) = cos(θ + π/2), a quarter cycle earlier.
+	checkTone(t, "imag", imag, 5000.0/out, r.Delay()-out/5000.0/4, 1e-3)
+}
+
+func BenchmarkResampler(b *testing.B) {
+	r, err := NewResampler(44100, 48000)
+	if err != nil {
+		b.Fatal(err)
+	}
+	x := make([]float32, 4410)
+	for i := range x {
+		x[i] = float32(math.Sin(float64(i) * 0.1))
+	}
+	var y []float32
+	b.SetBytes(int64(len(x) * 4))
+	for i := 0; i < b.N; i++ {
+		y = r.Process(y, x)
+	}
+}
@@ -0,0 +1,140 @@
+//go:build darwin && cgo && !purego
+
+package accel
+
+// #include <Accelerate/Accelerate.h>
+import "C"
+
+// Maxvi returns the maximum value of the input vector and its index,
+// counted in elements of input (a multiple of stride). The first of equal
+// values is reported.
+func Maxvi(input []float32, stride int) (float32, int) {
+	var out C.float
+	var index C.vDSP_Length
+	C.vDSP_maxvi((*C.float)(&input[0]), C.vDSP_Stride(stride), &out, &index, C.vDSP_Length(len(input)/stride))
+	return float32(out), int(index)
+}
+
+// Minvi returns the minimum value of the input vector and its index,
+// counted in elements of input (a multiple of stride). The first of equal
+// values is reported.
+func Minvi(input []float32, stride int) (float32, int) {
+	var out C.float
+	var index C.vDSP_Length
+	C.vDSP_minvi((*C.float)(&input[0]), C.vDSP_Stride(stride), &out, &index, C.vDSP_Length(len(input)/stride))
+	return float32(out), int(index)
+}
+
+// Rmsqv returns the root mean square of the input vector.
+func Rmsqv(input []float32, stride int) float32 {
+	var out C.float
+	C.vDSP_rmsqv((*C.float)(&input[0]), C.vDSP_Stride(stride), &out, C.vDSP_Length(len(input)/stride))
+	return float32(out)
+}
+
+// Measqv returns the mean of the squares of the input vector.
+func Measqv(input []float32, stride int) float32 {
+	var out C.float
+	C.vDSP_measqv((*C.float)(&input[0]), C.vDSP_Stride(stride), &out, C.vDSP_Length(len(input)/stride))
+	return float32(out)
+}
+
+// Svesq returns the sum of the squares of the input vector.
+func Svesq(input []float32, stride int) float32 {
+	var out C.float
+	C.vDSP_svesq((*C.float)(&input[0]), C.vDSP_Stride(stride), &out, C.vDSP_Length(len(input)/stride))
+	return float32(out)
+}
+
+// Svs returns the sum of the signed squares of the input vector, Σ x·|x|.
+func Svs(input []float32, stride int) float32 {
+	var out C.float
+	C.vDSP_svs((*C.float)(&input[0]), C.vDSP_Stride(stride), &out, C.vDSP_Length(len(input)/stride))
+	return float32(out)
+}
+
+// Maxmgv returns the largest magnitude in the input vector.
+func Maxmgv(input []float32, stride int) float32 {
+	var out C.float
+	C.vDSP_maxmgv((*C.float)(&input[0]), C.vDSP_Stride(stride), &out, C.vDSP_Length(len(input)/stride))
+	return float32(out)
+}
+
+// Normalize returns the mean and standard deviation of the input vector
+// and stores (x-mean)/stdDev for every element in output, unless output is
+// nil. The standard deviation divides by the number of elements.
+func Normalize(input []float32, inputStride int, output []float32, outputStride int) (mean, stdDev float32) {
+	var cMean, cStdDev C.float
+	n := len(input) / inputStride
+	var out *C.float
+	if output != nil {
+		n = minLenGeneric(n, len(output)/outputStride)
+		out = (*C.float)(&output[0])
+	}
+	C.vDSP_normalize((*C.float)(&input[0]), C.vDSP_Stride(inputStride), out, C.vDSP_Stride(outputStride), &cMean, &cStdDev, C.vDSP_Length(n))
+	return float32(cMean), float32(cStdDev)
+}
+
+// MaxviD is the double-precision version of Maxvi.
+func MaxviD(input []float64, stride int) (float64, int) {
+	var out C.double
+	var index C.vDSP_Length
+	C.vDSP_maxviD((*C.double)(&input[0]), C.vDSP_Stride(stride), &out, &index, C.vDSP_Length(len(input)/stride))
+	return float64(out), int(index)
+}
+
+// MinviD is the double-precision version of Minvi.
+func MinviD(input []float64, stride int) (float64, int) {
+	var out C.double
+	var index C.vDSP_Length
+	C.vDSP_minviD((*C.double)(&input[0]), C.vDSP_Stride(stride), &out, &index, C.vDSP_Length(len(input)/stride))
+	return float64(out), int(index)
+}
+
+// RmsqvD is the double-precision version of Rmsqv.
+func RmsqvD(input []float64, stride int) float64 {
+	var out C.double
+	C.vDSP_rmsqvD((*C.double)(&input[0]), C.vDSP_Stride(stride), &out, C.vDSP_Length(len(input)/stride))
+	return float64(out)
+}
+
+// MeasqvD is the double-precision version of Measqv.
+func MeasqvD(input []float64, stride int) float64 {
+	var out C.double
+	C.vDSP_measqvD((*C.double)(&input[0]), C.vDSP_Stride(stride), &out, C.vDSP_Length(len(input)/stride))
+	return float64(out)
+}
+
+// SvesqD is the double-precision version of Svesq.
+func SvesqD(input []float64, stride int) float64 {
+	var out C.double
+	C.vDSP_svesqD((*C.double)(&input[0]), C.vDSP_Stride(stride), &out, C.vDSP_Length(len(input)/stride))
+	return float64(out)
+}
+
+// SvsD is the double-precision version of Svs.
+func SvsD(input []float64, stride int) float64 {
+	var out C.double
+	C.vDSP_svsD((*C.double)(&input[0]), C.vDSP_Stride(stride), &out, C.vDSP_Length(len(input)/stride))
+	return float64(out)
+}
+
+// MaxmgvD is the double-precision version of Maxmgv.
+func MaxmgvD(input []float64, stride int) float64 {
+	var out C.double
+	C.vDSP_maxmgvD((*C.double)(&input[0]), C.vDSP_Stride(stride), &out, C.vDSP_Length(len(input)/stride))
+	return float64(out)
+}
+
+// NormalizeD is the double-precision version of Normalize.
+func NormalizeD(input []float64, inputStride int, output []float64, outputStride int) (mean, stdDev float64) {
+	var cMean, cStdDev C.double
+	n := len(input) / inputStride
+	var out *C.double
+	if output != nil {
+		n = minLenGeneric(n, len(output)/outputStride)
+		out = (*C.double)(&output[0])
+	}
+	C.vDSP_normalizeD((*C.double)(&input[0]), C.vDSP_Stride(inputStride), out, C.vDSP_Stride(outputStride), &cMean, &cStdDev, C.vDSP_Length(n))
+	return float64(cMean), float64(cStdDev)
+}
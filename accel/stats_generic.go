@@ -0,0 +1,90 @@
+package accel
+
+import "math"
+
+func maxviGeneric[T floating](input []T, stride int) (T, int) {
+	max, index := T(math.Inf(-1)), 0
+	n := len(input) / stride
+	for i := 0; i < n; i++ {
+		if v := input[i*stride]; v > max {
+			max, index = v, i*stride
+		}
+	}
+	return max, index
+}
+
+func minviGeneric[T floating](input []T, stride int) (T, int) {
+	min, index := T(math.Inf(1)), 0
+	n := len(input) / stride
+	for i := 0; i < n; i++ {
+		if v := input[i*stride]; v < min {
+			min, index = v, i*stride
+		}
+	}
+	return min, index
+}
+
+func svesqGeneric[T floating](input []T, stride int) T {
+	var sum T
+	n := len(input) / stride
+	for i := 0; i < n; i++ {
+		v := input[i*stride]
+		sum += v * v
+	}
+	return sum
+}
+
+func measqvGeneric[T floating](input []T, stride int) T {
+	n := len(input) / stride
+	if n == 0 {
+		return 0
+	}
+	return svesqGeneric(input, stride) / T(n)
+}
+
+func rmsqvGeneric[T floating](input []T, stride int) T {
+	return T(math.Sqrt(float64(measqvGeneric(input, stride))))
+}
+
+func svsGeneric[T floating](input []T, stride int) T {
+	var sum T
+	n := len(input) / stride
+	for i := 0; i < n; i++ {
+		v := input[i*stride]
+		sum += v * T(math.Abs(float64(v)))
+	}
+	return sum
+}
+
+func maxmgvGeneric[T floating](input []T, stride int) T {
+	var max T
+	n := len(input) / stride
+	for i := 0; i < n; i++ {
+		if v := T(math.Abs(float64(input[i*stride]))); v > max {
+			max = v
+		}
+	}
+	return max
+}
+
+func normalizeGeneric[T floating](input []T, inputStride int, output []T, outputStride int) (mean, stdDev T) {
+	n := len(input) / inputStride
+	if output != nil {
+		n = minLenGeneric(n, len(output)/outputStride)
+	}
+	if n == 0 {
+		return 0, 0
+	}
+	var sum, sumSq T
+	for i := 0; i < n; i++ {
+		v := input[i*inputStride]
+		sum += v
+		sumSq += v * v
+	}
+	mean = sum / T(n)
+	stdDev = T(math.Sqrt(math.Max(float64(sumSq/T(n)-mean*mean), 0)))
+	for i := 0; output != nil && i < n; i++ {
+		output[i*outputStride] = (input[i*inputStride] - mean) / stdDev
+	}
+	return mean, stdDev
+}
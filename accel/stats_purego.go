@@ -0,0 +1,89 @@
+//go:build !darwin || !cgo || purego
+
+package accel
+
+// Maxvi returns the maximum value of the input vector and its index,
+// counted in elements of input (a multiple of stride). The first of equal
+// values is reported.
+func Maxvi(input []float32, stride int) (float32, int) {
+	return maxviGeneric(input, stride)
+}
+
+// Minvi returns the minimum value of the input vector and its index,
+// counted in elements of input (a multiple of stride). The first of equal
+// values is reported.
+func Minvi(input []float32, stride int) (float32, int) {
+	return minviGeneric(input, stride)
+}
+
+// Rmsqv returns the root mean square of the input vector.
+func Rmsqv(input []float32, stride int) float32 {
+	return rmsqvGeneric(input, stride)
+}
+
+// Measqv returns the mean of the squares of the input vector.
+func Measqv(input []float32, stride int) float32 {
+	return measqvGeneric(input, stride)
+}
+
+// Svesq returns the sum of the squares of the input vector.
+func Svesq(input []float32, stride int) float32 {
+	return svesqGeneric(input, stride)
+}
+
+// Svs returns the sum of the signed squares of the input vector, Σ x·|x|.
+func Svs(input []float32, stride int) float32 {
+	return svsGeneric(input, stride)
+}
+
+// Maxmgv returns the largest magnitude in the input vector.
+func Maxmgv(input []float32, stride int) float32 {
+	return maxmgvGeneric(input, stride)
+}
+
+// Normalize returns the mean and standard deviation of the input vector
+// and stores (x-mean)/stdDev for every element in output, unless output is
+// nil. The standard deviation divides by the number of elements.
+func Normalize(input []float32, inputStride int, output []float32, outputStride int) (mean, stdDev float32) {
+	return normalizeGeneric(input, inputStride, output, outputStride)
+}
+
+// MaxviD is the double-precision version of Maxvi.
+func MaxviD(input []float64, stride int) (float64, int) {
+	return maxviGeneric(input, stride)
+}
+
+// MinviD is the double-precision version of Minvi.
+func MinviD(input []float64, stride int) (float64, int) {
+	return minviGeneric(input, stride)
+}
+
+// RmsqvD is the double-precision version of Rmsqv.
+func RmsqvD(input []float64, stride int) float64 {
+	return rmsqvGeneric(input, stride)
+}
+
+// MeasqvD is the double-precision version of Measqv.
+func MeasqvD(input []float64, stride int) float64 {
+	return measqvGeneric(input, stride)
+}
+
+// SvesqD is the double-precision version of Svesq.
+func SvesqD(input []float64, stride int) float64 {
+	return svesqGeneric(input, stride)
+}
+
+// SvsD is the double-precision version of Svs.
+func SvsD(input []float64, stride int) float64 {
+	return svsGeneric(input, stride)
+}
+
+// MaxmgvD is the double-precision version of Maxmgv.
+func MaxmgvD(input []float64, stride int) float64 {
+	return maxmgvGeneric(input, stride)
+}
+
+// NormalizeD is the double-precision version of Normalize.
+func NormalizeD(input []float64, inputStride int, output []float64, outputStride int) (mean, stdDev float64) {
+	return normalizeGeneric(input, inputStride, output, outputStride)
+}
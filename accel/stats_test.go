@@ -0,0 +1,116 @@
+package accel
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStatsFunctions(t *testing.T) {
+	x := []float32{3, -7, 2, 9, -7, 9, 1, -2}
+	x64 := make([]float64, len(x))
+	for i, v := range x {
+		x64[i] = float64(v)
+	}
+	var sumSq, signed float64
+	for _, v := range x64 {
+		sumSq += v * v
+		signed += v * math.Abs(v)
+	}
+	n := float64(len(x))
+	scalars := []struct {
+		name      string
+		got, want float64
+	}{
+		{"Svesq", float64(Svesq(x, 1)), sumSq},
+		{"Measqv", float64(Measqv(x, 1)), sumSq / n},
+		{"Rmsqv", float64(Rmsqv(x, 1)), math.Sqrt(sumSq / n)},
+		{"Svs", float64(Svs(x, 1)), signed},
+		{"Maxmgv", float64(Maxmgv(x, 1)), 9},
+		{"Svesq stride 2", float64(Svesq(x, 2)), 9 + 4 + 49 + 1},
+		{"SvesqD", SvesqD(x64, 1), sumSq},
+		{"MeasqvD", MeasqvD(x64, 1), sumSq / n},
+		{"RmsqvD", RmsqvD(x64, 1), math.Sqrt(sumSq / n)},
+		{"SvsD", SvsD(x64, 1), signed},
+		{"MaxmgvD", MaxmgvD(x64, 1), 9},
+	}
+	for _, s := range scalars {
+		if !almostEqual64(s.got, s.want, 1e-4) {
+			t.Errorf("%s = %f; want %f", s.name, s.got, s.want)
+		}
+	}
+
+	// Indices count elements of the input, and the first extreme wins.
+	type indexed struct {
+		value float64
+		index int
+	}
+	check := func(name string, got, want indexed) {
+		t.Helper()
+		if got != want {
+			t.Errorf("%s = %v at %d; want %v at %d", name, got.value, got.index, want.value, want.index)
+		}
+	}
+	v, i := Maxvi(x, 1)
+	check("Maxvi", indexed{float64(v), i}, indexed{9, 3})
+	v, i = Minvi(x, 1)
+	check("Minvi", indexed{float64(v), i}, indexed{-7, 1})
+	v, i = Maxvi(x, 2)
+	check("Maxvi stride 2", indexed{float64(v), i}, indexed{3, 0})
+	v, i = Maxvi(x[1:], 2)
+	check("Maxvi odd elements", indexed{float64(v), i}, indexed{9, 2})
+	v, i = Minvi(x[1:], 2)
+	check("Minvi odd elements", indexed{float64(v), i}, indexed{-7, 0})
+	vd, id := MaxviD(x64, 1)
+	check("MaxviD", indexed{vd, id}, indexed{9, 3})
+	vd, id = MinviD(x64, 1)
+	check("MinviD", indexed{vd, id}, indexed{-7, 1})
+}
+
+func TestNormalize(t *testing.T) {
+	x := []float32{2, 4, 4, 4, 5, 5, 7, 9}
+	out := make([]float32, len(x))
+	mean, std := Normalize(x, 1, out, 1)
+	if mean != 5 || !almostEqual32(std, 2, 1e-6) {
+		t.Errorf("Normalize mean, stdDev = %f, %f; want 5, 2", mean, std)
+	}
+	for i, v := range x {
+		if want := (v - 5) / 2; !almostEqual32(out[i], want, 1e-6) {
+			t.Errorf("Normalize output [%d] = %f; want %f", i, out[i], want)
+		}
+	}
+	if mean, std := Normalize(x, 1, nil, 1); mean != 5 || !almostEqual32(std, 2, 1e-6) {
+		t.Errorf("Normalize without output = %f, %f; want 5, 2", mean, std)
+	}
+	x64 := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+	out64 := make([]float64, len(x64))
+	if mean, std := NormalizeD(x64, 1, out64, 1); mean != 5 || !almostEqual64(std, 2, 1e-12) || out64[7] != 2 {
+		t.Errorf("NormalizeD = %f, %f, last output %f; want 5, 2, 2", mean, std, out64[7])
+	}
+}
+
+func TestStats(t *testing.T) {
+	x := make([]float32, 1001)
+	for i := range x {
+		x[i] = float32(1000 + math.Sin(float64(i)*0.37)*3)
+	}
+	s := Stats(x)
+	max, argMax := Maxvi(x, 1)
+	min, argMin := Minvi(x, 1)
+	x64 := make([]float64, len(x))
+	for i, v := range x {
+		x64[i] = float64(v)
+	}
+	mean, std := NormalizeD(x64, 1, nil, 1)
+	if s.Max != max || s.ArgMax != argMax || s.Min != min || s.ArgMin != argMin {
+		t.Errorf("Stats extremes %v at %d, %v at %d; want %v at %d, %v at %d", s.Min, s.ArgMin, s.Max, s.ArgMax, min, argMin, max, argMax)
+	}
+	if !almostEqual64(float64(s.Mean), mean, 1e-4) || !almostEqual64(float64(s.StdDev), std, 1e-5) {
+		t.Errorf("Stats mean, std = %f, %f; want %f, %f", s.Mean, s.StdDev, mean, std)
+	}
+	if want := Rmsqv(x, 1); !almostEqual32(s.RMS, want, 1e-2) {
+		t.Errorf("Stats RMS = %f; want %f", s.RMS, want)
+	}
+	if e := Stats(nil); e.ArgMin != -1 || e.ArgMax != -1 {
+		t.Errorf("Stats(nil) = %+v", e)
+	}
+}
@@ -0,0 +1,342 @@
+// Package stft computes short-time Fourier transforms of real and complex
+// signals and inverts them with overlap-add.
+//
+// A signal is cut into frames of Config.FrameSize samples that start
+// Config.HopSize samples apart. Each frame is multiplied by the analysis
+// window and transformed, giving one row of the spectrogram matrix. The
+// inverse transforms every row back, multiplies it by the window again,
+// overlap-adds the frames and divides by the overlap-added squared window,
+// so Inverse(Forward(x)) reproduces x wherever that sum is non-zero. With
+// Config.Center set every sample of the signal is covered.
+//
+// Transforms run through accel, so the package works on every platform
+// with the pure Go FFT outside darwin.
+package stft
+
+import (
+	"errors"
+	"math/bits"
+	"slices"
+
+	"github.com/samuel/go-accelerate/accel"
+	"github.com/samuel/go-accelerate/accel/window"
+)
+
+// ErrConfig is returned by New for an invalid Config.
+var ErrConfig = errors.New("stft: invalid configuration")
+
+// PadMode selects how the signal is extended when Config.Center is set.
+type PadMode int
+
+const (
+	PadZero    PadMode = iota // extend with zeros
+	PadReflect                // mirror the signal about its first and last sample
+)
+
+// Config describes the framing of a transform.
+type Config struct {
+	// FrameSize is the number of samples in a frame and the FFT length.
+	// Powers of two use the real FFT; other sizes are supported through
+	// accel.FFT.
+	FrameSize int
+	// HopSize is the distance between the starts of consecutive frames,
+	// between 1 and FrameSize. Zero means FrameSize/4.
+	HopSize int
+	// Window is the analysis and synthesis window of FrameSize samples,
+	// usually a periodic window from the accel/window package. Nil means
+	// a periodic Hann window.
+	Window []float32
+	// Center pads FrameSize/2 samples on each side of the signal so frame
+	// t is centred on sample t*HopSize.
+	Center bool
+	// Pad is the padding used by Center. The end of the signal is always
+	// extended with zeros to fill the last frame.
+	Pad PadMode
+}
+
+// STFT holds the window, FFTs and scratch buffers for one Config. It must
+// not be used concurrently.
+type STFT struct {
+	cfg    Config
+	window []float32
+	real   *accel.RealFFT // nil unless FrameSize is a power of two
+	fft    *accel.FFT     // created on first use if real is set
+
+	frame  []float32
+	cframe []complex64
+	spec   []complex64
+}
+
+// New returns an STFT for cfg.
+func New(cfg Config) (*STFT, error) {
+	if cfg.HopSize == 0 {
+		cfg.HopSize = max(cfg.FrameSize/4, 1)
+	}
+	if cfg.Window == nil && cfg.FrameSize > 0 {
+		cfg.Window = window.Hann(make([]float32, cfg.FrameSize), window.Periodic)
+	}
+	if cfg.FrameSize < 2 || cfg.HopSize < 1 || cfg.HopSize > cfg.FrameSize || len(cfg.Window) != cfg.FrameSize {
+		return nil, ErrConfig
+	}
+	s := &STFT{
+		cfg:    cfg,
+		window: slices.Clone(cfg.Window),
+		frame:  make([]float32, cfg.FrameSize),
+		cframe: make([]complex64, cfg.FrameSize),
+		spec:   make([]complex64, cfg.FrameSize),
+	}
+	s.cfg.Window = s.window
+	var err error
+	if bits.OnesCount(uint(cfg.FrameSize)) == 1 {
+		s.real, err = accel.NewRealFFT(cfg.FrameSize)
+	} else {
+		s.fft, err = accel.NewFFT(cfg.FrameSize)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// complexFFT returns the complex FFT, creating it the first time a power of
+// two frame size needs one.
+func (s *STFT) complexFFT() *accel.FFT {
+	if s.fft == nil {
+		fft, err := accel.NewFFT(s.cfg.FrameSize)
+		if err != nil {
+			// A RealFFT of the same size was created by New.
+			panic(err)
+		}
+		s.fft = fft
+	}
+	return s.fft
+}
+
+// Config returns the configuration with defaults filled in.
+func (s *STFT) Config() Config {
+	return s.cfg
+}
+
+// Window returns the analysis window. It must not be modified.
+func (s *STFT) Window() []float32 {
+	return s.window
+}
+
+// Bins returns the number of bins in each row returned by Forward
+// (FrameSize/2+1).
+func (s *STFT) Bins() int {
+	return s.cfg.FrameSize/2 + 1
+}
+
+// Frames returns the number of frames for a signal of n samples.
+func (s *STFT) Frames(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	padded := n + 2*s.offset()
+	hop := s.cfg.HopSize
+	return 1 + (max(padded-s.cfg.FrameSize, 0)+hop-1)/hop
+}
+
+// Destroy releases the FFTs.
+func (s *STFT) Destroy() {
+	if s.fft != nil {
+		s.fft.Destroy()
+	}
+	if s.real != nil {
+		s.real.Destroy()
+	}
+}
+
+// Forward returns the spectrogram of a real signal: Frames(len(signal))
+// rows of Bins() complex values holding the non-negative frequencies.
+func (s *STFT) Forward(signal []float32) [][]complex64 {
+	out := make([][]complex64, s.Frames(len(signal)))
+	for t := range out {
+		extractFrame(s, s.frame, signal, t)
+		accel.Vmul(s.frame, 1, s.window, 1, s.frame, 1)
+		out[t] = s.forwardReal(make([]complex64, s.Bins()), s.frame)
+	}
+	return out
+}
+
+// ForwardComplex returns the spectrogram of a complex signal:
+// Frames(len(signal)) rows of FrameSize complex values in FFT order.
+func (s *STFT) ForwardComplex(signal []complex64) [][]complex64 {
+	out := make([][]complex64, s.Frames(len(signal)))
+	for t := range out {
+		extractFrame(s, s.cframe, signal, t)
+		for i, w := range s.window {
+			s.cframe[i] *= complex(w, 0)
+		}
+		out[t] = s.complexFFT().Forward(nil, s.cframe)
+	}
+	return out
+}
+
+// Inverse reconstructs n samples of a real signal from a spectrogram
+// produced by Forward. It panics with accel.ErrLengthMismatch if a row
+// does not hold Bins() values.
+func (s *STFT) Inverse(spectrum [][]complex64, n int) []float32 {
+	acc := make([]float32, s.paddedLen(len(spectrum)))
+	norm := s.windowSum(len(spectrum))
+	for t, row := range spectrum {
+		if len(row) != s.Bins() {
+			panic(accel.ErrLengthMismatch)
+		}
+		s.inverseReal(s.frame, row)
+		accel.Vmul(s.frame, 1, s.window, 1, s.frame, 1)
+		seg := acc[t*s.cfg.HopSize:]
+		accel.Vadd(seg, 1, s.frame, 1, seg, 1)
+	}
+	for i, w := range norm {
+		if w > windowFloor {
+			acc[i] /= w
+		}
+	}
+	return trim(acc, s.offset(), n)
+}
+
+// InverseComplex reconstructs n samples of a complex signal from a
+// spectrogram produced by ForwardComplex. It panics with
+// accel.ErrLengthMismatch if a row does not hold FrameSize values.
+func (s *STFT) InverseComplex(spectrum [][]complex64, n int) []complex64 {
+	acc := make([]complex64, s.paddedLen(len(spectrum)))
+	norm := s.windowSum(len(spectrum))
+	for t, row := range spectrum {
+		if len(row) != s.cfg.FrameSize {
+			panic(accel.ErrLengthMismatch)
+		}
+		s.cframe = s.complexFFT().Inverse(s.cframe, row)
+		seg := acc[t*s.cfg.HopSize:]
+		for i, w := range s.window {
+			seg[i] += s.cframe[i] * complex(w, 0)
+		}
+	}
+	for i, w := range norm {
+		if w > windowFloor {
+			acc[i] /= complex(w, 0)
+		}
+	}
+	return trim(acc, s.offset(), n)
+}
+
+// Magnitude returns the absolute value of every bin of a spectrogram.
+func Magnitude(spectrum [][]complex64) [][]float32 {
+	out := make([][]float32, len(spectrum))
+	var split accel.DSPSplitComplex
+	for t, row := range spectrum {
+		if len(split.Real) < len(row) {
+			split = accel.DSPSplitComplex{Real: make([]float32, len(row)), Imag: make([]float32, len(row))}
+		}
+		accel.Ctoz(row, 2, split, 1)
+		out[t] = make([]float32, len(row))
+		accel.Zvabs(split, 1, out[t], 1)
+	}
+	return out
+}
+
+// Decibels converts magnitudes to decibels relative to ref in place
+// (20·log10(m/ref)) and returns m.
+func Decibels(m [][]float32, ref float32) [][]float32 {
+	for _, row := range m {
+		accel.Vdbcon(row, 1, ref, row, 1, accel.DBFlagAmplitude)
+	}
+	return m
+}
+
+// windowFloor is the smallest overlap-added squared window that Inverse
+// divides by. Samples with less window energy are left unnormalised.
+const windowFloor = 1e-8
+
+func (s *STFT) offset() int {
+	if s.cfg.Center {
+		return s.cfg.FrameSize / 2
+	}
+	return 0
+}
+
+func (s *STFT) paddedLen(frames int) int {
+	if frames == 0 {
+		return 0
+	}
+	return (frames-1)*s.cfg.HopSize + s.cfg.FrameSize
+}
+
+// extractFrame copies frame t of the padded signal into frame.
+func extractFrame[T float32 | complex64](s *STFT, frame, signal []T, t int) {
+	off := s.offset()
+	n := len(signal)
+	for i := range frame {
+		j := t*s.cfg.HopSize + i - off
+		if j < 0 || j >= n {
+			var zero T
+			frame[i] = zero
+			if s.cfg.Pad != PadReflect || off == 0 {
+				continue
+			}
+			if j < 0 {
+				j = -j
+			} else {
+				j = 2*(n-1) - j
+			}
+			if j < 0 || j >= n {
+				continue
+			}
+		}
+		frame[i] = signal[j]
+	}
+}
+
+// windowSum returns the overlap-added squared window of frames frames.
+func (s *STFT) windowSum(frames int) []float32 {
+	norm := make([]float32, s.paddedLen(frames))
+	sq := make([]float32, len(s.window))
+	accel.Vsq(s.window, 1, sq, 1)
+	for t := 0; t < frames; t++ {
+		seg := norm[t*s.cfg.HopSize:]
+		accel.Vadd(seg, 1, sq, 1, seg, 1)
+	}
+	return norm
+}
+
+// trim removes the centring padding and returns n samples, zero-extended
+// if the spectrogram covers fewer. A negative n keeps everything the
+// frames cover.
+func trim[T float32 | complex64](acc []T, off, n int) []T {
+	acc = acc[min(off, len(acc)):]
+	if n < 0 {
+		return acc
+	}
+	out := make([]T, n)
+	copy(out, acc)
+	return out
+}
+
+func (s *STFT) forwardReal(dst []complex64, frame []float32) []complex64 {
+	if s.real != nil {
+		return s.real.Forward(dst, frame)
+	}
+	for i, v := range frame {
+		s.cframe[i] = complex(v, 0)
+	}
+	s.spec = s.fft.Forward(s.spec, s.cframe)
+	copy(dst, s.spec)
+	return dst
+}
+
+func (s *STFT) inverseReal(dst []float32, bins []complex64) {
+	if s.real != nil {
+		s.real.Inverse(dst, bins)
+		return
+	}
+	size := s.cfg.FrameSize
+	copy(s.spec, bins)
+	for k := 1; k < len(bins); k++ {
+		s.spec[size-k] = complex(real(bins[k]), -imag(bins[k]))
+	}
+	s.cframe = s.fft.Inverse(s.cframe, s.spec)
+	for i, v := range s.cframe {
+		dst[i] = real(v)
+	}
+}
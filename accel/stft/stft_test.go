@@ -0,0 +1,189 @@
+package stft
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/samuel/go-accelerate/accel/internal/testsignal"
+	"github.com/samuel/go-accelerate/accel/window"
+)
+
+func TestRoundTrip(t *testing.T) {
+	for _, cfg := range []Config{
+		{FrameSize: 256, Center: true},
+		{FrameSize: 256, HopSize: 128, Window: window.Hann(make([]float32, 256), window.Periodic), Center: true, Pad: PadReflect},
+		{FrameSize: 200, HopSize: 50, Window: window.Hamming(make([]float32, 200), window.Periodic), Center: true},
+		{FrameSize: 64, HopSize: 64, Window: window.Rectangular(make([]float32, 64))},
+		{FrameSize: 100, HopSize: 30, Window: window.Blackman(make([]float32, 100), window.Periodic), Center: true, Pad: PadReflect},
+	} {
+		name := fmt.Sprintf("FrameSize=%d HopSize=%d Center=%v Pad=%d", cfg.FrameSize, cfg.HopSize, cfg.Center, cfg.Pad)
+		s, err := New(cfg)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		x := testsignal.Make(1000, 0.05)
+		spec := s.Forward(x)
+		if len(spec) != s.Frames(len(x)) {
+			t.Errorf("%s: %d frames; want %d", name, len(spec), s.Frames(len(x)))
+		}
+		y := s.Inverse(spec, len(x))
+		for i := range x {
+			if math.Abs(float64(x[i]-y[i])) > 1e-4 {
+				t.Errorf("%s: sample %d = %f; want %f", name, i, y[i], x[i])
+				break
+			}
+		}
+		s.Destroy()
+	}
+}
+
+func TestRoundTripComplex(t *testing.T) {
+	s, err := New(Config{FrameSize: 128, HopSize: 32, Center: true, Pad: PadReflect})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Destroy()
+	x := make([]complex64, 777)
+	for i := range x {
+		x[i] = complex64(cmplx.Exp(complex(0, float64(i)*0.2))) + complex(0.25, 0)
+	}
+	spec := s.ForwardComplex(x)
+	if len(spec[0]) != 128 {
+		t.Fatalf("row has %d bins; want 128", len(spec[0]))
+	}
+	y := s.InverseComplex(spec, len(x))
+	for i := range x {
+		if cmplx.Abs(complex128(x[i]-y[i])) > 1e-4 {
+			t.Fatalf("sample %d = %v; want %v", i, y[i], x[i])
+		}
+	}
+}
+
+// TestFFTSetups checks that a power of two frame size only creates the
+// complex FFT once a complex signal is transformed.
+func TestFFTSetups(t *testing.T) {
+	s, err := New(Config{FrameSize: 64})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Destroy()
+	s.Inverse(s.Forward(testsignal.Make(200, 0.05)), 200)
+	if s.real == nil || s.fft != nil {
+		t.Fatalf("real transforms used real=%v fft=%v; want only the RealFFT", s.real != nil, s.fft != nil)
+	}
+	s.ForwardComplex(make([]complex64, 200))
+	if s.fft == nil {
+		t.Error("ForwardComplex did not create the complex FFT")
+	}
+
+	odd, err := New(Config{FrameSize: 60})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer odd.Destroy()
+	if odd.real != nil || odd.fft == nil {
+		t.Errorf("frame size 60 has real=%v fft=%v; want only the FFT", odd.real != nil, odd.fft != nil)
+	}
+}
+
+// TestForwardFrame checks a frame against a direct DFT of the windowed
+// samples, including the centring padding.
+func TestForwardFrame(t *testing.T) {
+	for _, size := range []int{16, 12} {
+		s, err := New(Config{FrameSize: size, HopSize: 4, Center: true, Pad: PadReflect})
+		if err != nil {
+			t.Fatal(err)
+		}
+		x := testsignal.Make(40, 0.05)
+		spec := s.Forward(x)
+		window := s.Window()
+		for _, frame := range []int{0, 3} {
+			for k := 0; k < s.Bins(); k++ {
+				var want complex128
+				for i := 0; i < size; i++ {
+					j := frame*4 + i - size/2
+					if j < 0 {
+						j = -j
+					}
+					v := float64(x[j]) * float64(window[i])
+					want += complex(v, 0) * cmplx.Exp(complex(0, -2*math.Pi*float64(i*k)/float64(size)))
+				}
+				if cmplx.Abs(complex128(spec[frame][k])-want) > 1e-3 {
+					t.Errorf("size %d frame %d bin %d = %v; want %v", size, frame, k, spec[frame][k], want)
+				}
+			}
+		}
+		s.Destroy()
+	}
+}
+
+func TestFrames(t *testing.T) {
+	s, err := New(Config{FrameSize: 8, HopSize: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Destroy()
+	for _, c := range []struct{ n, want int }{{0, 0}, {1, 1}, {8, 1}, {9, 2}, {12, 2}, {13, 3}} {
+		if got := s.Frames(c.n); got != c.want {
+			t.Errorf("Frames(%d) = %d; want %d", c.n, got, c.want)
+		}
+	}
+	if hop := s.Config().HopSize; hop != 4 {
+		t.Errorf("HopSize = %d; want 4", hop)
+	}
+}
+
+func TestMagnitude(t *testing.T) {
+	const size, bin = 64, 5
+	s, err := New(Config{FrameSize: size, HopSize: size, Window: window.Rectangular(make([]float32, size))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Destroy()
+	x := make([]float32, size)
+	for i := range x {
+		x[i] = float32(math.Cos(2 * math.Pi * bin * float64(i) / size))
+	}
+	m := Magnitude(s.Forward(x))
+	for k, v := range m[0] {
+		want := float32(0)
+		if k == bin {
+			want = size / 2
+		}
+		if math.Abs(float64(v-want)) > 1e-3 {
+			t.Errorf("bin %d = %f; want %f", k, v, want)
+		}
+	}
+	db := Decibels(m, size/2)
+	if math.Abs(float64(db[0][bin])) > 1e-4 {
+		t.Errorf("peak = %f dB; want 0", db[0][bin])
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	for _, cfg := range []Config{
+		{FrameSize: 1},
+		{FrameSize: 64, HopSize: 65},
+		{FrameSize: 64, HopSize: -1},
+		{FrameSize: 64, Window: make([]float32, 32)},
+	} {
+		if _, err := New(cfg); err != ErrConfig {
+			t.Errorf("New(%+v) returned %v; want %v", cfg, err, ErrConfig)
+		}
+	}
+}
+
+func BenchmarkForward(b *testing.B) {
+	s, err := New(Config{FrameSize: 1024, HopSize: 256, Center: true})
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer s.Destroy()
+	x := testsignal.Make(48000, 0.05)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Forward(x)
+	}
+}
@@ -0,0 +1,44 @@
+package accel
+
+import "math"
+
+// Statistics summarises a vector. ArgMin and ArgMax are the indices of the
+// first minimum and maximum, and StdDev is the population standard
+// deviation (dividing by the number of elements).
+type Statistics struct {
+	Min, Max       float32
+	ArgMin, ArgMax int
+	Mean           float32
+	RMS            float32
+	StdDev         float32
+}
+
+// Stats computes the statistics of x in a single pass, accumulating in
+// double precision with Welford's method so that the standard deviation
+// of a signal with a large offset stays accurate. For an empty x it
+// returns zero statistics with ArgMin and ArgMax set to -1.
+func Stats(x []float32) Statistics {
+	if len(x) == 0 {
+		return Statistics{ArgMin: -1, ArgMax: -1}
+	}
+	s := Statistics{Min: x[0], Max: x[0]}
+	var mean, m2, sumSq float64
+	for i, v := range x {
+		if v < s.Min {
+			s.Min, s.ArgMin = v, i
+		}
+		if v > s.Max {
+			s.Max, s.ArgMax = v, i
+		}
+		f := float64(v)
+		sumSq += f * f
+		d := f - mean
+		mean += d / float64(i+1)
+		m2 += d * (f - mean)
+	}
+	n := float64(len(x))
+	s.Mean = float32(mean)
+	s.RMS = float32(math.Sqrt(sumSq / n))
+	s.StdDev = float32(math.Sqrt(m2 / n))
+	return s
+}
@@ -1,13 +1,9 @@
 package accel
 
-// #include <Accelerate/Accelerate.h>
-import "C"
-
 import (
 	"errors"
 	"fmt"
 	"image"
-	"unsafe"
 )
 
 type ErrOther int
@@ -48,43 +44,15 @@ var (
 	ErrImageUnknownFlagsBit = errors.New("accel: Unknown flag bits")
 )
 
-func toError(code C.vImage_Error) error {
-	switch code {
-	case C.kvImageNoError:
-		return nil
-	case C.kvImageRoiLargerThanInputBuffer:
-		return ErrImageRoiLargerThanInputBuffer
-	case C.kvImageInvalidKernelSize:
-		return ErrImageInvalidKernelSize
-	case C.kvImageInvalidEdgeStyle:
-		return ErrImageInvalidEdgeStyle
-	case C.kvImageInvalidOffset_X:
-		return ErrImageInvalidOffsetX
-	case C.kvImageInvalidOffset_Y:
-		return ErrImageInvalidOffsetY
-	case C.kvImageMemoryAllocationError:
-		return ErrImageMemoryAllocationError
-	case C.kvImageNullPointerArgument:
-		return ErrImageNullPointerArgument
-	case C.kvImageInvalidParameter:
-		return ErrImageInvalidParameter
-	case C.kvImageBufferSizeMismatch:
-		return ErrImageBufferSizeMismatch
-	case C.kvImageUnknownFlagsBit:
-		return ErrImageUnknownFlagsBit
-	}
-	return ErrOther(int(code))
-}
-
 type VImageFlag int
 
 const (
 	// Do not set any flags.
-	VImageFlagNoFlags VImageFlag = C.kvImageNoFlags
+	VImageFlagNoFlags VImageFlag = 0
 	// Operate on red, green, and blue channels only. When you set this flag,
 	// the alpha value is copied from source to destination. You can set this
 	// flag only for interleaved image formats.
-	VImageFlagLeaveAlphaUnchanged VImageFlag = C.kvImageLeaveAlphaUnchanged
+	VImageFlagLeaveAlphaUnchanged VImageFlag = 1
 	// Copy the value of the edge pixel in the source to the destination. When
 	// you set this flag, and a convolution function is processing an image
 	// pixel for which some of the kernel extends beyond the image boundaries,
@@ -93,14 +61,14 @@ const (
 	// This flag is valid only for convolution operations. The morphology
 	// functions do not use this flag because they do not use pixels outside
 	// the image in any of their calculations.
-	VImageFlagCopyInPlace VImageFlag = C.kvImageCopyInPlace
+	VImageFlagCopyInPlace VImageFlag = 2
 	// A background color fill. The associated value is a background color
 	// (that is, a pixel value). When you set this flag, vImage assigns the
 	// pixel value to all pixels outside the image. You can set this flag
 	// for convolution and geometry functions. The morphology functions do
 	// not use this flag because they do not use pixels outside the image
 	// in any of their calculations.
-	VImageFlagBackgroundColorFill VImageFlag = C.kvImageBackgroundColorFill
+	VImageFlagBackgroundColorFill VImageFlag = 4
 	// Extend the edges of the image infinitely. When you set this flag,
 	// vImage replicates the edges of the image outward. It repeats the top
 	// row of the image infinitely above the image, the bottom row infinitely
@@ -114,15 +82,15 @@ const (
 	// flag for convolution and geometry functions. The morphology functions
 	// do not use this flag because they do not use pixels outside the image
 	// in any of their calculations.
-	VImageFlagEdgeExtend VImageFlag = C.kvImageEdgeExtend
+	VImageFlagEdgeExtend VImageFlag = 8
 	// Do not use vImage internal tiling routines. When you set this flag,
 	// vImage turns off internal tiling. Set this flag if you want to perform
 	// your own tiling or your own multithreading, or to use the minimum or
 	// maximum filters in place.
-	VImageFlagDoNotTile VImageFlag = C.kvImageDoNotTile
+	VImageFlagDoNotTile VImageFlag = 16
 	// Use a higher quality, slower resampling filter for for geometry
 	// operations—shear, scale, rotate, affine transform, and so forth.
-	VImageFlagHighQualityResampling VImageFlag = C.kvImageHighQualityResampling
+	VImageFlagHighQualityResampling VImageFlag = 32
 	// Use the part of the kernel that overlaps the image. This flag is valid
 	// only for convolution operations. When you set this flag, vImage restricts
 	// calculations to the portion of the kernel overlapping the image. It
@@ -141,12 +109,12 @@ const (
 	// edge detection filters, or other filters that are designed to find the
 	// slope of a signal. For those kinds of filters, you should use the
 	// kvImageEdgeExtend option instead.
-	VImageFlagTruncateKernel VImageFlag = C.kvImageTruncateKernel
+	VImageFlagTruncateKernel VImageFlag = 64
 	// Get the minimum temporary buffer size for the operation, given the
 	// parameters provided. When you set this flag, the function returns the
 	// number of bytes required for the temporary buffer. A negative value
 	// specifies an error.
-	VImageFlagGetTempBufferSize VImageFlag = C.kvImageGetTempBufferSize
+	VImageFlagGetTempBufferSize VImageFlag = 128
 )
 
 type VImageBuffer struct {
@@ -155,15 +123,6 @@ type VImageBuffer struct {
 	Data          []byte
 }
 
-func (vib *VImageBuffer) toC() C.vImage_Buffer {
-	var cv C.vImage_Buffer
-	cv.data = unsafe.Pointer(&vib.Data[0])
-	cv.width = C.vImagePixelCount(vib.Width)
-	cv.height = C.vImagePixelCount(vib.Height)
-	cv.rowBytes = C.size_t(vib.RowBytes)
-	return cv
-}
-
 // Return a VImageBuffer of the given image. The memory may or may not
 // be shared depending on the format. Also return the format of the returned
 // image. (e.g. argb8888, rgba8888, 8, ...)
@@ -198,8 +157,8 @@ func VImageBufferFromImage(img image.Image) (*VImageBuffer, string) {
 // rowBytes may be 0 in which case it will be calculated as width*channels.
 func CreateVImageBuffer(width, height, channels, rowBytes int) *VImageBuffer {
 	if rowBytes <= 0 {
-		rowBytes = width * height * channels
-	} else if rowBytes < width*height*channels {
+		rowBytes = width * channels
+	} else if rowBytes < width*channels {
 		panic("accel: trying to create a buffer with an invalid rowBytes size")
 	}
 	return &VImageBuffer{
@@ -219,3 +178,70 @@ func (vib *VImageBuffer) ToRGBA() *image.RGBA {
 		Rect:   image.Rect(0, 0, vib.Width, vib.Height),
 	}
 }
+
+type DSPSplitComplex struct {
+	Real []float32
+	Imag []float32
+}
+
+type DSPDoubleSplitComplex struct {
+	Real []float64
+	Imag []float64
+}
+
+type WindowFlag int
+
+const (
+	WindowFlagHannDenorm WindowFlag = 0 // creates a denormalized window.
+	WindowFlagHannNorm   WindowFlag = 2 // creates a normalized window.
+	WindowFlagHalfWindow WindowFlag = 1 // creates only the first (N+1)/2 points.
+)
+
+type DBFlag int
+
+const (
+	DBFlagPower     DBFlag = 0
+	DBFlagAmplitude DBFlag = 1
+)
+
+var (
+	// A stride is zero or negative.
+	ErrStrideInvalid = errors.New("accel: invalid stride")
+	// A vector holds fewer elements than the operation requires.
+	ErrLengthMismatch = errors.New("accel: length mismatch")
+	// An output vector partially overlaps an input vector. Operating in
+	// place is only allowed where both share the same start and stride.
+	ErrOverlap = errors.New("accel: vectors overlap")
+)
+
+var ErrFailedToCreateFFTSetup = errors.New("accel: failed to create FFT setup")
+
+// ErrFailedToCreateBiquadSetup is returned for a biquad setup without
+// sections, or a multichannel setup whose channels differ in their number
+// of sections.
+var ErrFailedToCreateBiquadSetup = errors.New("accel: failed to create biquad setup")
+
+// ErrFFTLength is returned when a transform is requested for a length the
+// FFT implementation does not support.
+var ErrFFTLength = errors.New("accel: unsupported FFT length")
+
+// ErrPSDParameters is returned by WelchPSD for a window length, overlap or
+// sample rate it cannot use, an all-zero window, or a signal shorter than
+// one segment.
+var ErrPSDParameters = errors.New("accel: invalid PSD parameters")
+
+// ErrResampleRate is returned by NewResampler for a rate that is not
+// positive.
+var ErrResampleRate = errors.New("accel: invalid resampling rate")
+
+type FFTRadix int
+type FFTDirection int
+
+const (
+	FFTRadix2 FFTRadix = 0
+	FFTRadix3 FFTRadix = 1
+	FFTRadix5 FFTRadix = 2
+
+	FFTDirectionForward FFTDirection = 1
+	FFTDirectionInverse FFTDirection = -1
+)
@@ -0,0 +1,34 @@
+package accel
+
+import "math"
+
+// Unwrap removes the jumps between successive elements of phase, such as
+// the output of Zvphas, by adding multiples of 2π wherever the difference
+// exceeds discont in magnitude, following numpy.unwrap. A discont below π
+// is treated as π. The correction is accumulated in double precision, so
+// long phase ramps do not drift.
+func Unwrap(phase []float32, discont float32) {
+	limit := math.Max(float64(discont), math.Pi)
+	var correction float64
+	prev := 0.0
+	for i, p := range phase {
+		v := float64(p)
+		if i > 0 {
+			d := v - prev
+			// d wrapped to [-π, π), with π kept for positive jumps.
+			dm := math.Mod(d+math.Pi, 2*math.Pi)
+			if dm < 0 {
+				dm += 2 * math.Pi
+			}
+			dm -= math.Pi
+			if dm == -math.Pi && d > 0 {
+				dm = math.Pi
+			}
+			if math.Abs(d) >= limit {
+				correction += dm - d
+			}
+		}
+		prev = v
+		phase[i] = float32(v + correction)
+	}
+}
@@ -1,3 +1,5 @@
+//go:build darwin && cgo && !purego
+
 package accel
 
 // #include <Accelerate/Accelerate.h>
@@ -5,7 +7,337 @@ import "C"
 
 // Vvlog10f performs a log base 10 on every value in input and
 // writes the result into output.
+//
+// Deprecated: Vvlog10f takes its output first, unlike the other vForce
+// wrappers. Use Vvlog10_float.
 func Vvlog10f(output, input []float32) {
-	n := C.int(len(output))
+	n := C.int(minLenGeneric(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvlog10f((*C.float)(&output[0]), (*C.float)(&input[0]), &n)
+}
+
+// Vvlog10_float performs a log base 10 on every value in input and
+// writes the result into output.
+func Vvlog10_float(input, output []float32) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
 	C.vvlog10f((*C.float)(&output[0]), (*C.float)(&input[0]), &n)
 }
+
+// Vvexpf computes e**x for every value in input and
+// writes the result into output.
+func Vvexpf(input, output []float32) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvexpf((*C.float)(&output[0]), (*C.float)(&input[0]), &n)
+}
+
+// Vvlogf computes the natural logarithm of every value in input and
+// writes the result into output.
+func Vvlogf(input, output []float32) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvlogf((*C.float)(&output[0]), (*C.float)(&input[0]), &n)
+}
+
+// Vvlog2f computes the base 2 logarithm of every value in input and
+// writes the result into output.
+func Vvlog2f(input, output []float32) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvlog2f((*C.float)(&output[0]), (*C.float)(&input[0]), &n)
+}
+
+// Vvsqrtf computes the square root of every value in input and
+// writes the result into output.
+func Vvsqrtf(input, output []float32) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvsqrtf((*C.float)(&output[0]), (*C.float)(&input[0]), &n)
+}
+
+// Vvrsqrtf computes the reciprocal square root of every value in input and
+// writes the result into output.
+func Vvrsqrtf(input, output []float32) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvrsqrtf((*C.float)(&output[0]), (*C.float)(&input[0]), &n)
+}
+
+// Vvsinf computes the sine of every value in input and
+// writes the result into output.
+func Vvsinf(input, output []float32) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvsinf((*C.float)(&output[0]), (*C.float)(&input[0]), &n)
+}
+
+// Vvcosf computes the cosine of every value in input and
+// writes the result into output.
+func Vvcosf(input, output []float32) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvcosf((*C.float)(&output[0]), (*C.float)(&input[0]), &n)
+}
+
+// Vvtanf computes the tangent of every value in input and
+// writes the result into output.
+func Vvtanf(input, output []float32) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvtanf((*C.float)(&output[0]), (*C.float)(&input[0]), &n)
+}
+
+// Vvtanhf computes the hyperbolic tangent of every value in input and
+// writes the result into output.
+func Vvtanhf(input, output []float32) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvtanhf((*C.float)(&output[0]), (*C.float)(&input[0]), &n)
+}
+
+// Vvrecf computes the reciprocal of every value in input and
+// writes the result into output.
+func Vvrecf(input, output []float32) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvrecf((*C.float)(&output[0]), (*C.float)(&input[0]), &n)
+}
+
+// Vvfloorf rounds every value in input down to an integer and
+// writes the result into output.
+func Vvfloorf(input, output []float32) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvfloorf((*C.float)(&output[0]), (*C.float)(&input[0]), &n)
+}
+
+// Vvceilf rounds every value in input up to an integer and
+// writes the result into output.
+func Vvceilf(input, output []float32) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvceilf((*C.float)(&output[0]), (*C.float)(&input[0]), &n)
+}
+
+// Vvintf truncates every value in input towards zero and
+// writes the result into output.
+func Vvintf(input, output []float32) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvintf((*C.float)(&output[0]), (*C.float)(&input[0]), &n)
+}
+
+// Vvpowf raises every value in base to the power of the matching value in
+// exponent and writes the result into output.
+func Vvpowf(base, exponent, output []float32) {
+	n := C.int(vvLen(len(output), len(base), len(exponent)))
+	if n == 0 {
+		return
+	}
+	C.vvpowf((*C.float)(&output[0]), (*C.float)(&exponent[0]), (*C.float)(&base[0]), &n)
+}
+
+// Vvatan2f computes the arc tangent of y/x for every pair of values in y and x,
+// using their signs to pick the quadrant, and writes the result into output.
+func Vvatan2f(y, x, output []float32) {
+	n := C.int(vvLen(len(output), len(y), len(x)))
+	if n == 0 {
+		return
+	}
+	C.vvatan2f((*C.float)(&output[0]), (*C.float)(&y[0]), (*C.float)(&x[0]), &n)
+}
+
+// Vvsincosf computes the sine and cosine of every value in input and writes
+// them into sin and cos. It panics with ErrLengthMismatch if sin and cos
+// differ in length or input is shorter than them.
+func Vvsincosf(input, sin, cos []float32) {
+	n := C.int(vvSincosLen(len(input), len(sin), len(cos)))
+	if n == 0 {
+		return
+	}
+	C.vvsincosf((*C.float)(&sin[0]), (*C.float)(&cos[0]), (*C.float)(&input[0]), &n)
+}
+
+// Vvexp is the double-precision version of Vvexpf.
+func Vvexp(input, output []float64) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvexp((*C.double)(&output[0]), (*C.double)(&input[0]), &n)
+}
+
+// Vvlog is the double-precision version of Vvlogf.
+func Vvlog(input, output []float64) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvlog((*C.double)(&output[0]), (*C.double)(&input[0]), &n)
+}
+
+// Vvlog2 is the double-precision version of Vvlog2f.
+func Vvlog2(input, output []float64) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvlog2((*C.double)(&output[0]), (*C.double)(&input[0]), &n)
+}
+
+// Vvsqrt is the double-precision version of Vvsqrtf.
+func Vvsqrt(input, output []float64) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvsqrt((*C.double)(&output[0]), (*C.double)(&input[0]), &n)
+}
+
+// Vvrsqrt is the double-precision version of Vvrsqrtf.
+func Vvrsqrt(input, output []float64) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvrsqrt((*C.double)(&output[0]), (*C.double)(&input[0]), &n)
+}
+
+// Vvsin is the double-precision version of Vvsinf.
+func Vvsin(input, output []float64) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvsin((*C.double)(&output[0]), (*C.double)(&input[0]), &n)
+}
+
+// Vvcos is the double-precision version of Vvcosf.
+func Vvcos(input, output []float64) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvcos((*C.double)(&output[0]), (*C.double)(&input[0]), &n)
+}
+
+// Vvtan is the double-precision version of Vvtanf.
+func Vvtan(input, output []float64) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvtan((*C.double)(&output[0]), (*C.double)(&input[0]), &n)
+}
+
+// Vvtanh is the double-precision version of Vvtanhf.
+func Vvtanh(input, output []float64) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvtanh((*C.double)(&output[0]), (*C.double)(&input[0]), &n)
+}
+
+// Vvrec is the double-precision version of Vvrecf.
+func Vvrec(input, output []float64) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvrec((*C.double)(&output[0]), (*C.double)(&input[0]), &n)
+}
+
+// Vvfloor is the double-precision version of Vvfloorf.
+func Vvfloor(input, output []float64) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvfloor((*C.double)(&output[0]), (*C.double)(&input[0]), &n)
+}
+
+// Vvceil is the double-precision version of Vvceilf.
+func Vvceil(input, output []float64) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvceil((*C.double)(&output[0]), (*C.double)(&input[0]), &n)
+}
+
+// Vvint is the double-precision version of Vvintf.
+func Vvint(input, output []float64) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvint((*C.double)(&output[0]), (*C.double)(&input[0]), &n)
+}
+
+// Vvpow is the double-precision version of Vvpowf.
+func Vvpow(base, exponent, output []float64) {
+	n := C.int(vvLen(len(output), len(base), len(exponent)))
+	if n == 0 {
+		return
+	}
+	C.vvpow((*C.double)(&output[0]), (*C.double)(&exponent[0]), (*C.double)(&base[0]), &n)
+}
+
+// Vvatan2 is the double-precision version of Vvatan2f.
+func Vvatan2(y, x, output []float64) {
+	n := C.int(vvLen(len(output), len(y), len(x)))
+	if n == 0 {
+		return
+	}
+	C.vvatan2((*C.double)(&output[0]), (*C.double)(&y[0]), (*C.double)(&x[0]), &n)
+}
+
+// Vvsincos is the double-precision version of Vvsincosf.
+func Vvsincos(input, sin, cos []float64) {
+	n := C.int(vvSincosLen(len(input), len(sin), len(cos)))
+	if n == 0 {
+		return
+	}
+	C.vvsincos((*C.double)(&sin[0]), (*C.double)(&cos[0]), (*C.double)(&input[0]), &n)
+}
+
+// Vvlog10 is the double-precision version of Vvlog10_float.
+func Vvlog10(input, output []float64) {
+	n := C.int(vvLen(len(output), len(input)))
+	if n == 0 {
+		return
+	}
+	C.vvlog10((*C.double)(&output[0]), (*C.double)(&input[0]), &n)
+}
@@ -0,0 +1,234 @@
+// Package vec provides vector operations over float32 and float64 slices.
+// Each function dispatches to the single or double precision accel routine
+// (vDSP on darwin, the pure Go fallback elsewhere) for its element type so
+// numeric code can be written once for both precisions.
+//
+// All vectors are contiguous. Functions taking several vectors panic with
+// accel.ErrLengthMismatch unless they all have the same length. The
+// destination may be the same slice as a source.
+package vec
+
+import (
+	"math"
+
+	"github.com/samuel/go-accelerate/accel"
+)
+
+// Float is the set of element types supported by the package.
+type Float interface {
+	float32 | float64
+}
+
+// sameLen returns the common length of the vectors or panics.
+func sameLen(n int, others ...int) int {
+	for _, m := range others {
+		if m != n {
+			panic(accel.ErrLengthMismatch)
+		}
+	}
+	return n
+}
+
+// Add sets dst[i] = a[i] + b[i].
+func Add[T Float](dst, a, b []T) {
+	if sameLen(len(dst), len(a), len(b)) == 0 {
+		return
+	}
+	switch d := any(dst).(type) {
+	case []float32:
+		accel.Vadd(any(a).([]float32), 1, any(b).([]float32), 1, d, 1)
+	case []float64:
+		accel.VaddD(any(a).([]float64), 1, any(b).([]float64), 1, d, 1)
+	}
+}
+
+// Sub sets dst[i] = a[i] - b[i].
+func Sub[T Float](dst, a, b []T) {
+	if sameLen(len(dst), len(a), len(b)) == 0 {
+		return
+	}
+	switch d := any(dst).(type) {
+	case []float32:
+		accel.Vsub(any(a).([]float32), 1, any(b).([]float32), 1, d, 1)
+	case []float64:
+		accel.VsubD(any(a).([]float64), 1, any(b).([]float64), 1, d, 1)
+	}
+}
+
+// Mul sets dst[i] = a[i] * b[i].
+func Mul[T Float](dst, a, b []T) {
+	if sameLen(len(dst), len(a), len(b)) == 0 {
+		return
+	}
+	switch d := any(dst).(type) {
+	case []float32:
+		accel.Vmul(any(a).([]float32), 1, any(b).([]float32), 1, d, 1)
+	case []float64:
+		accel.VmulD(any(a).([]float64), 1, any(b).([]float64), 1, d, 1)
+	}
+}
+
+// Div sets dst[i] = a[i] / b[i].
+func Div[T Float](dst, a, b []T) {
+	if sameLen(len(dst), len(a), len(b)) == 0 {
+		return
+	}
+	switch d := any(dst).(type) {
+	case []float32:
+		accel.Vdiv(any(a).([]float32), 1, any(b).([]float32), 1, d, 1)
+	case []float64:
+		accel.VdivD(any(a).([]float64), 1, any(b).([]float64), 1, d, 1)
+	}
+}
+
+// Scale sets dst[i] = src[i] * s.
+func Scale[T Float](dst, src []T, s T) {
+	ScaleAdd(dst, src, s, 0)
+}
+
+// ScaleAdd sets dst[i] = src[i]*mult + add.
+func ScaleAdd[T Float](dst, src []T, mult, add T) {
+	if sameLen(len(dst), len(src)) == 0 {
+		return
+	}
+	switch d := any(dst).(type) {
+	case []float32:
+		accel.Vsmsa(any(src).([]float32), 1, float32(mult), float32(add), d, 1)
+	case []float64:
+		accel.VsmsaD(any(src).([]float64), 1, float64(mult), float64(add), d, 1)
+	}
+}
+
+// AddScalar sets dst[i] = src[i] + s.
+func AddScalar[T Float](dst, src []T, s T) {
+	if sameLen(len(dst), len(src)) == 0 {
+		return
+	}
+	switch d := any(dst).(type) {
+	case []float32:
+		accel.Vsadd(any(src).([]float32), 1, float32(s), d, 1)
+	case []float64:
+		accel.VsaddD(any(src).([]float64), 1, float64(s), d, 1)
+	}
+}
+
+// Neg sets dst[i] = -src[i].
+func Neg[T Float](dst, src []T) {
+	if sameLen(len(dst), len(src)) == 0 {
+		return
+	}
+	switch d := any(dst).(type) {
+	case []float32:
+		accel.Vneg(any(src).([]float32), 1, d, 1)
+	case []float64:
+		accel.VnegD(any(src).([]float64), 1, d, 1)
+	}
+}
+
+// Abs sets dst[i] = |src[i]|.
+func Abs[T Float](dst, src []T) {
+	if sameLen(len(dst), len(src)) == 0 {
+		return
+	}
+	switch d := any(dst).(type) {
+	case []float32:
+		accel.Vabs(any(src).([]float32), 1, d, 1)
+	case []float64:
+		accel.VabsD(any(src).([]float64), 1, d, 1)
+	}
+}
+
+// Square sets dst[i] = src[i] * src[i].
+func Square[T Float](dst, src []T) {
+	if sameLen(len(dst), len(src)) == 0 {
+		return
+	}
+	switch d := any(dst).(type) {
+	case []float32:
+		accel.Vsq(any(src).([]float32), 1, d, 1)
+	case []float64:
+		accel.VsqD(any(src).([]float64), 1, d, 1)
+	}
+}
+
+// Clip sets dst[i] to src[i] limited to the range [low, high].
+func Clip[T Float](dst, src []T, low, high T) {
+	if sameLen(len(dst), len(src)) == 0 {
+		return
+	}
+	switch d := any(dst).(type) {
+	case []float32:
+		accel.Vclip(any(src).([]float32), 1, float32(low), float32(high), d, 1)
+	case []float64:
+		accel.VclipD(any(src).([]float64), 1, float64(low), float64(high), d, 1)
+	}
+}
+
+// Threshold sets dst[i] to src[i] or low if src[i] is below it.
+func Threshold[T Float](dst, src []T, low T) {
+	if sameLen(len(dst), len(src)) == 0 {
+		return
+	}
+	switch d := any(dst).(type) {
+	case []float32:
+		accel.Vthr(any(src).([]float32), 1, float32(low), d, 1)
+	case []float64:
+		accel.VthrD(any(src).([]float64), 1, float64(low), d, 1)
+	}
+}
+
+// Sum returns the sum of the elements of x.
+func Sum[T Float](x []T) T {
+	if len(x) == 0 {
+		return 0
+	}
+	switch v := any(x).(type) {
+	case []float32:
+		return T(accel.Sve(v, 1))
+	case []float64:
+		return T(accel.SveD(v, 1))
+	}
+	panic("unreachable")
+}
+
+// Max returns the largest element of x or -Inf if x is empty.
+func Max[T Float](x []T) T {
+	if len(x) == 0 {
+		return T(math.Inf(-1))
+	}
+	switch v := any(x).(type) {
+	case []float32:
+		return T(accel.Maxv(v, 1))
+	case []float64:
+		return T(accel.MaxvD(v, 1))
+	}
+	panic("unreachable")
+}
+
+// Min returns the smallest element of x or +Inf if x is empty.
+func Min[T Float](x []T) T {
+	if len(x) == 0 {
+		return T(math.Inf(1))
+	}
+	switch v := any(x).(type) {
+	case []float32:
+		return T(accel.Minv(v, 1))
+	case []float64:
+		return T(accel.MinvD(v, 1))
+	}
+	panic("unreachable")
+}
+
+// Mean returns the arithmetic mean of x or 0 if x is empty.
+func Mean[T Float](x []T) T {
+	if len(x) == 0 {
+		return 0
+	}
+	switch v := any(x).(type) {
+	case []float32:
+		return T(accel.Meanv(v, 1))
+	case []float64:
+		return T(accel.MeanvD(v, 1))
+	}
+	panic("unreachable")
+}
@@ -0,0 +1,91 @@
+package vec
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/samuel/go-accelerate/accel"
+)
+
+func testElementwise[T Float](t *testing.T) {
+	a := []T{1, -2, 3, -4, 5}
+	b := []T{2, 2, -1, 0.5, 10}
+	dst := make([]T, len(a))
+	cases := []struct {
+		name string
+		fn   func()
+		want []T
+	}{
+		{"Add", func() { Add(dst, a, b) }, []T{3, 0, 2, -3.5, 15}},
+		{"Sub", func() { Sub(dst, a, b) }, []T{-1, -4, 4, -4.5, -5}},
+		{"Mul", func() { Mul(dst, a, b) }, []T{2, -4, -3, -2, 50}},
+		{"Div", func() { Div(dst, a, b) }, []T{0.5, -1, -3, -8, 0.5}},
+		{"Scale", func() { Scale(dst, a, 2) }, []T{2, -4, 6, -8, 10}},
+		{"ScaleAdd", func() { ScaleAdd(dst, a, 2, 1) }, []T{3, -3, 7, -7, 11}},
+		{"AddScalar", func() { AddScalar(dst, a, 1) }, []T{2, -1, 4, -3, 6}},
+		{"Neg", func() { Neg(dst, a) }, []T{-1, 2, -3, 4, -5}},
+		{"Abs", func() { Abs(dst, a) }, []T{1, 2, 3, 4, 5}},
+		{"Square", func() { Square(dst, a) }, []T{1, 4, 9, 16, 25}},
+		{"Clip", func() { Clip(dst, a, -2, 3) }, []T{1, -2, 3, -2, 3}},
+		{"Threshold", func() { Threshold(dst, a, 0) }, []T{1, 0, 3, 0, 5}},
+	}
+	for _, c := range cases {
+		c.fn()
+		for i := range c.want {
+			if dst[i] != c.want[i] {
+				t.Errorf("%s: dst[%d] = %v; want %v", c.name, i, dst[i], c.want[i])
+			}
+		}
+	}
+}
+
+func testReductions[T Float](t *testing.T) {
+	x := []T{1, -2, 3, -4, 6}
+	if v := Sum(x); v != 4 {
+		t.Errorf("Sum = %v; want 4", v)
+	}
+	if v := Max(x); v != 6 {
+		t.Errorf("Max = %v; want 6", v)
+	}
+	if v := Min(x); v != -4 {
+		t.Errorf("Min = %v; want -4", v)
+	}
+	if v := Mean(x); math.Abs(float64(v)-0.8) > 1e-6 {
+		t.Errorf("Mean = %v; want 0.8", v)
+	}
+	if v := Sum([]T(nil)); v != 0 {
+		t.Errorf("Sum(nil) = %v; want 0", v)
+	}
+	if v := Max([]T(nil)); !math.IsInf(float64(v), -1) {
+		t.Errorf("Max(nil) = %v; want -Inf", v)
+	}
+}
+
+func TestElementwise(t *testing.T) {
+	t.Run("float32", testElementwise[float32])
+	t.Run("float64", testElementwise[float64])
+}
+
+func TestReductions(t *testing.T) {
+	t.Run("float32", testReductions[float32])
+	t.Run("float64", testReductions[float64])
+}
+
+func TestInPlace(t *testing.T) {
+	x := []float64{1, 2, 3}
+	Add(x, x, x)
+	if x[2] != 6 {
+		t.Errorf("x[2] = %f; want 6", x[2])
+	}
+}
+
+func TestLengthMismatch(t *testing.T) {
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, accel.ErrLengthMismatch) {
+			t.Errorf("recovered %v; want %v", err, accel.ErrLengthMismatch)
+		}
+	}()
+	Add(make([]float32, 3), make([]float32, 3), make([]float32, 2))
+}
@@ -0,0 +1,108 @@
+package accel
+
+import "math"
+
+// vvLen returns the element count of a vForce call, the length of its
+// output, and panics if an input is shorter.
+func vvLen(n int, inputs ...int) int {
+	for _, m := range inputs {
+		if m < n {
+			panic(ErrLengthMismatch)
+		}
+	}
+	return n
+}
+
+// vvSincosLen is vvLen for Vvsincosf and Vvsincos, which also require sin
+// and cos to have the same length.
+func vvSincosLen(input, sin, cos int) int {
+	if cos != sin {
+		panic(ErrLengthMismatch)
+	}
+	return vvLen(sin, input)
+}
+
+func vvMap[T floating](input, output []T, f func(float64) float64) {
+	n := vvLen(len(output), len(input))
+	for i := 0; i < n; i++ {
+		output[i] = T(f(float64(input[i])))
+	}
+}
+
+func vvlog10Generic[T floating](input, output []T) {
+	vvMap(input, output, math.Log10)
+}
+
+func vvexpGeneric[T floating](input, output []T) {
+	vvMap(input, output, math.Exp)
+}
+
+func vvlogGeneric[T floating](input, output []T) {
+	vvMap(input, output, math.Log)
+}
+
+func vvlog2Generic[T floating](input, output []T) {
+	vvMap(input, output, math.Log2)
+}
+
+func vvsqrtGeneric[T floating](input, output []T) {
+	vvMap(input, output, math.Sqrt)
+}
+
+func vvrsqrtGeneric[T floating](input, output []T) {
+	vvMap(input, output, func(x float64) float64 { return 1 / math.Sqrt(x) })
+}
+
+func vvsinGeneric[T floating](input, output []T) {
+	vvMap(input, output, math.Sin)
+}
+
+func vvcosGeneric[T floating](input, output []T) {
+	vvMap(input, output, math.Cos)
+}
+
+func vvtanGeneric[T floating](input, output []T) {
+	vvMap(input, output, math.Tan)
+}
+
+func vvtanhGeneric[T floating](input, output []T) {
+	vvMap(input, output, math.Tanh)
+}
+
+func vvrecGeneric[T floating](input, output []T) {
+	vvMap(input, output, func(x float64) float64 { return 1 / x })
+}
+
+func vvfloorGeneric[T floating](input, output []T) {
+	vvMap(input, output, math.Floor)
+}
+
+func vvceilGeneric[T floating](input, output []T) {
+	vvMap(input, output, math.Ceil)
+}
+
+func vvintGeneric[T floating](input, output []T) {
+	vvMap(input, output, math.Trunc)
+}
+
+func vvpowGeneric[T floating](base, exponent, output []T) {
+	n := vvLen(len(output), len(base), len(exponent))
+	for i := 0; i < n; i++ {
+		output[i] = T(math.Pow(float64(base[i]), float64(exponent[i])))
+	}
+}
+
+func vvatan2Generic[T floating](y, x, output []T) {
+	n := vvLen(len(output), len(y), len(x))
+	for i := 0; i < n; i++ {
+		output[i] = T(math.Atan2(float64(y[i]), float64(x[i])))
+	}
+}
+
+func vvsincosGeneric[T floating](input, sin, cos []T) {
+	n := vvSincosLen(len(input), len(sin), len(cos))
+	for i := 0; i < n; i++ {
+		s, c := math.Sincos(float64(input[i]))
+		sin[i], cos[i] = T(s), T(c)
+	}
+}
@@ -0,0 +1,201 @@
+//go:build !darwin || !cgo || purego
+
+package accel
+
+// Vvlog10f performs a log base 10 on every value in input and
+// writes the result into output.
+//
+// Deprecated: Vvlog10f takes its output first, unlike the other vForce
+// wrappers. Use Vvlog10_float.
+func Vvlog10f(output, input []float32) {
+	n := minLenGeneric(len(output), len(input))
+	vvlog10Generic(input[:n], output[:n])
+}
+
+// Vvlog10_float performs a log base 10 on every value in input and
+// writes the result into output.
+func Vvlog10_float(input, output []float32) {
+	vvlog10Generic(input, output)
+}
+
+// Vvexpf computes e**x for every value in input and
+// writes the result into output.
+func Vvexpf(input, output []float32) {
+	vvexpGeneric(input, output)
+}
+
+// Vvlogf computes the natural logarithm of every value in input and
+// writes the result into output.
+func Vvlogf(input, output []float32) {
+	vvlogGeneric(input, output)
+}
+
+// Vvlog2f computes the base 2 logarithm of every value in input and
+// writes the result into output.
+func Vvlog2f(input, output []float32) {
+	vvlog2Generic(input, output)
+}
+
+// Vvsqrtf computes the square root of every value in input and
+// writes the result into output.
+func Vvsqrtf(input, output []float32) {
+	vvsqrtGeneric(input, output)
+}
+
+// Vvrsqrtf computes the reciprocal square root of every value in input and
+// writes the result into output.
+func Vvrsqrtf(input, output []float32) {
+	vvrsqrtGeneric(input, output)
+}
+
+// Vvsinf computes the sine of every value in input and
+// writes the result into output.
+func Vvsinf(input, output []float32) {
+	vvsinGeneric(input, output)
+}
+
+// Vvcosf computes the cosine of every value in input and
+// writes the result into output.
+func Vvcosf(input, output []float32) {
+	vvcosGeneric(input, output)
+}
+
+// Vvtanf computes the tangent of every value in input and
+// writes the result into output.
+func Vvtanf(input, output []float32) {
+	vvtanGeneric(input, output)
+}
+
+// Vvtanhf computes the hyperbolic tangent of every value in input and
+// writes the result into output.
+func Vvtanhf(input, output []float32) {
+	vvtanhGeneric(input, output)
+}
+
+// Vvrecf computes the reciprocal of every value in input and
+// writes the result into output.
+func Vvrecf(input, output []float32) {
+	vvrecGeneric(input, output)
+}
+
+// Vvfloorf rounds every value in input down to an integer and
+// writes the result into output.
+func Vvfloorf(input, output []float32) {
+	vvfloorGeneric(input, output)
+}
+
+// Vvceilf rounds every value in input up to an integer and
+// writes the result into output.
+func Vvceilf(input, output []float32) {
+	vvceilGeneric(input, output)
+}
+
+// Vvintf truncates every value in input towards zero and
+// writes the result into output.
+func Vvintf(input, output []float32) {
+	vvintGeneric(input, output)
+}
+
+// Vvpowf raises every value in base to the power of the matching value in
+// exponent and writes the result into output.
+func Vvpowf(base, exponent, output []float32) {
+	vvpowGeneric(base, exponent, output)
+}
+
+// Vvatan2f computes the arc tangent of y/x for every pair of values in y and x,
+// using their signs to pick the quadrant, and writes the result into output.
+func Vvatan2f(y, x, output []float32) {
+	vvatan2Generic(y, x, output)
+}
+
+// Vvsincosf computes the sine and cosine of every value in input and writes
+// them into sin and cos. It panics with ErrLengthMismatch if sin and cos
+// differ in length or input is shorter than them.
+func Vvsincosf(input, sin, cos []float32) {
+	vvsincosGeneric(input, sin, cos)
+}
+
+// Vvexp is the double-precision version of Vvexpf.
+func Vvexp(input, output []float64) {
+	vvexpGeneric(input, output)
+}
+
+// Vvlog is the double-precision version of Vvlogf.
+func Vvlog(input, output []float64) {
+	vvlogGeneric(input, output)
+}
+
+// Vvlog2 is the double-precision version of Vvlog2f.
+func Vvlog2(input, output []float64) {
+	vvlog2Generic(input, output)
+}
+
+// Vvsqrt is the double-precision version of Vvsqrtf.
+func Vvsqrt(input, output []float64) {
+	vvsqrtGeneric(input, output)
+}
+
+// Vvrsqrt is the double-precision version of Vvrsqrtf.
+func Vvrsqrt(input, output []float64) {
+	vvrsqrtGeneric(input, output)
+}
+
+// Vvsin is the double-precision version of Vvsinf.
+func Vvsin(input, output []float64) {
+	vvsinGeneric(input, output)
+}
+
+// Vvcos is the double-precision version of Vvcosf.
+func Vvcos(input, output []float64) {
+	vvcosGeneric(input, output)
+}
+
+// Vvtan is the double-precision version of Vvtanf.
+func Vvtan(input, output []float64) {
+	vvtanGeneric(input, output)
+}
+
+// Vvtanh is the double-precision version of Vvtanhf.
+func Vvtanh(input, output []float64) {
+	vvtanhGeneric(input, output)
+}
+
+// Vvrec is the double-precision version of Vvrecf.
+func Vvrec(input, output []float64) {
+	vvrecGeneric(input, output)
+}
+
+// Vvfloor is the double-precision version of Vvfloorf.
+func Vvfloor(input, output []float64) {
+	vvfloorGeneric(input, output)
+}
+
+// Vvceil is the double-precision version of Vvceilf.
+func Vvceil(input, output []float64) {
+	vvceilGeneric(input, output)
+}
+
+// Vvint is the double-precision version of Vvintf.
+func Vvint(input, output []float64) {
+	vvintGeneric(input, output)
+}
+
+// Vvpow is the double-precision version of Vvpowf.
+func Vvpow(base, exponent, output []float64) {
+	vvpowGeneric(base, exponent, output)
+}
+
+// Vvatan2 is the double-precision version of Vvatan2f.
+func Vvatan2(y, x, output []float64) {
+	vvatan2Generic(y, x, output)
+}
+
+// Vvsincos is the double-precision version of Vvsincosf.
+func Vvsincos(input, sin, cos []float64) {
+	vvsincosGeneric(input, sin, cos)
+}
+
+// Vvlog10 is the double-precision version of Vvlog10_float.
+func Vvlog10(input, output []float64) {
+	vvlog10Generic(input, output)
+}
@@ -2,6 +2,7 @@ package accel
 
 import (
 	"math"
+	"math/big"
 	"testing"
 )
 
@@ -23,3 +24,301 @@ func TestVvlog10f(t *testing.T) {
 		}
 	}
 }
+
+// ulps32 and ulps64 return the distance between a and b in units in the
+// last place, counting the representable values between them.
+func ulps32(a, b float32) int64 {
+	ordered := func(f float32) int64 {
+		i := int64(int32(math.Float32bits(f)))
+		if i < 0 {
+			i = math.MinInt32 - i
+		}
+		return i
+	}
+	d := ordered(a) - ordered(b)
+	if d < 0 {
+		d = -d
+	}
+	return d
+}
+
+func ulps64(a, b float64) uint64 {
+	ordered := func(f float64) int64 {
+		i := int64(math.Float64bits(f))
+		if i < 0 {
+			i = math.MinInt64 - i
+		}
+		return i
+	}
+	x, y := ordered(a), ordered(b)
+	if x < y {
+		x, y = y, x
+	}
+	return uint64(x) - uint64(y)
+}
+
+// The vForce references are computed with math/big at refPrec bits and
+// rounded once to the precision under test, so they don't share the
+// rounding errors of the math package that the fallback is built on.
+const refPrec = 256
+
+func newRef() *big.Float {
+	return new(big.Float).SetPrec(refPrec)
+}
+
+func refFloat(x float64) *big.Float {
+	return newRef().SetFloat64(x)
+}
+
+// refSmall reports whether term is negligible next to a sum of order one.
+func refSmall(term *big.Float) bool {
+	return term.Sign() == 0 || term.MantExp(nil) < -refPrec-16
+}
+
+// refExp returns e^x as exp(x/2^16)^(2^16) with the Taylor series for the
+// reduced argument.
+func refExp(x *big.Float) *big.Float {
+	const k = 16
+	r := newRef().SetMantExp(x, -k)
+	sum, term := newRef().SetInt64(1), newRef().SetInt64(1)
+	for n := int64(1); !refSmall(term); n++ {
+		term.Mul(term, r)
+		term.Quo(term, newRef().SetInt64(n))
+		sum.Add(sum, term)
+	}
+	for i := 0; i < k; i++ {
+		sum.Mul(sum, sum)
+	}
+	return sum
+}
+
+// refLog solves e^y = x with Halley's method.
+func refLog(x *big.Float) *big.Float {
+	f, _ := x.Float64()
+	y := refFloat(math.Log(f))
+	for i := 0; i < 6; i++ {
+		e := refExp(y)
+		num := newRef().Sub(x, e)
+		num.Mul(num, refFloat(2))
+		y.Add(y, num.Quo(num, newRef().Add(x, e)))
+	}
+	return y
+}
+
+// refSinCos sums the Taylor series of sine and cosine together.
+func refSinCos(x *big.Float) (sin, cos *big.Float) {
+	sin, cos = newRef(), newRef().SetInt64(1)
+	term := newRef().SetInt64(1)
+	for n := int64(1); n < 16 || !refSmall(term); n++ {
+		term.Mul(term, x)
+		term.Quo(term, newRef().SetInt64(n))
+		switch n % 4 {
+		case 1:
+			sin.Add(sin, term)
+		case 2:
+			cos.Sub(cos, term)
+		case 3:
+			sin.Sub(sin, term)
+		case 0:
+			cos.Add(cos, term)
+		}
+	}
+	return sin, cos
+}
+
+// refAtan halves the argument with atan(z) = 2·atan(z/(1+√(1+z²))) until
+// the Taylor series converges quickly.
+func refAtan(z *big.Float) *big.Float {
+	z = newRef().Set(z)
+	k := 0
+	for z.Sign() != 0 && z.MantExp(nil) > -3 {
+		d := newRef().Mul(z, z)
+		d.Add(d, refFloat(1))
+		d.Sqrt(d)
+		d.Add(d, refFloat(1))
+		z.Quo(z, d)
+		k++
+	}
+	sum, pow := newRef().Set(z), newRef().Set(z)
+	z2 := newRef().Mul(z, z)
+	for n := int64(1); ; n++ {
+		pow.Mul(pow, z2)
+		pow.Neg(pow)
+		term := newRef().Quo(pow, newRef().SetInt64(2*n+1))
+		if refSmall(term) {
+			break
+		}
+		sum.Add(sum, term)
+	}
+	return sum.SetMantExp(sum, k)
+}
+
+func refAtan2(y, x *big.Float) *big.Float {
+	pi := refAtan(refFloat(1))
+	pi.SetMantExp(pi, 2)
+	if x.Sign() == 0 {
+		pi.SetMantExp(pi, -1)
+		if y.Sign() < 0 {
+			pi.Neg(pi)
+		}
+		return pi
+	}
+	a := refAtan(newRef().Quo(y, x))
+	switch {
+	case x.Sign() > 0:
+		return a
+	case y.Sign() >= 0:
+		return a.Add(a, pi)
+	default:
+		return a.Sub(a, pi)
+	}
+}
+
+// refTrunc rounds x towards zero.
+func refTrunc(x *big.Float) *big.Float {
+	i, _ := x.Int(nil)
+	return newRef().SetInt(i)
+}
+
+func TestVForceULPs(t *testing.T) {
+	const max32, max64 = 1, 2
+	signed := []float64{-7.3, -2.5, -1.25, -0.9, -0.3, 0, 0.1, 0.5, 0.75, 1, 1.5, 3.1, 6.02}
+	positive := []float64{1e-3, 0.1, 0.5, 0.75, 1, 1.5, 2, 3.1, 10, 123.4, 1e4}
+	one := refFloat(1)
+	recip := func(x *big.Float) *big.Float { return newRef().Quo(one, x) }
+	cases := []struct {
+		name   string
+		f32    func(input, output []float32)
+		f64    func(input, output []float64)
+		ref    func(*big.Float) *big.Float
+		inputs []float64
+	}{
+		{"exp", Vvexpf, Vvexp, refExp, signed},
+		{"log", Vvlogf, Vvlog, refLog, positive},
+		{"log2", Vvlog2f, Vvlog2, func(x *big.Float) *big.Float { l := refLog(x); return l.Quo(l, refLog(refFloat(2))) }, positive},
+		{"log10", Vvlog10_float, Vvlog10, func(x *big.Float) *big.Float { l := refLog(x); return l.Quo(l, refLog(refFloat(10))) }, positive},
+		{"sqrt", Vvsqrtf, Vvsqrt, func(x *big.Float) *big.Float { return newRef().Sqrt(x) }, positive},
+		{"rsqrt", Vvrsqrtf, Vvrsqrt, func(x *big.Float) *big.Float { return recip(newRef().Sqrt(x)) }, positive},
+		{"sin", Vvsinf, Vvsin, func(x *big.Float) *big.Float { s, _ := refSinCos(x); return s }, signed},
+		{"cos", Vvcosf, Vvcos, func(x *big.Float) *big.Float { _, c := refSinCos(x); return c }, signed},
+		{"tan", Vvtanf, Vvtan, func(x *big.Float) *big.Float { s, c := refSinCos(x); return s.Quo(s, c) }, signed},
+		{"tanh", Vvtanhf, Vvtanh, func(x *big.Float) *big.Float {
+			e := refExp(newRef().Mul(x, refFloat(2)))
+			return newRef().Quo(newRef().Sub(e, one), newRef().Add(e, one))
+		}, signed},
+		{"rec", Vvrecf, Vvrec, recip, positive},
+		{"floor", Vvfloorf, Vvfloor, func(x *big.Float) *big.Float {
+			t := refTrunc(x)
+			if x.Sign() < 0 && t.Cmp(x) != 0 {
+				t.Sub(t, one)
+			}
+			return t
+		}, signed},
+		{"ceil", Vvceilf, Vvceil, func(x *big.Float) *big.Float {
+			t := refTrunc(x)
+			if x.Sign() > 0 && t.Cmp(x) != 0 {
+				t.Add(t, one)
+			}
+			return t
+		}, signed},
+		{"int", Vvintf, Vvint, refTrunc, signed},
+	}
+	for _, c := range cases {
+		in32 := make([]float32, len(c.inputs))
+		out32 := make([]float32, len(c.inputs))
+		out64 := make([]float64, len(c.inputs))
+		for i, x := range c.inputs {
+			in32[i] = float32(x)
+		}
+		c.f32(in32, out32)
+		c.f64(c.inputs, out64)
+		for i, x := range c.inputs {
+			if want, _ := c.ref(refFloat(float64(in32[i]))).Float32(); ulps32(out32[i], want) > max32 {
+				t.Errorf("Vv%sf(%v) = %v; want %v", c.name, in32[i], out32[i], want)
+			}
+			if want, _ := c.ref(refFloat(x)).Float64(); ulps64(out64[i], want) > max64 {
+				t.Errorf("Vv%s(%v) = %v; want %v", c.name, x, out64[i], want)
+			}
+		}
+	}
+
+	// The two-input functions and sincos, over every pair of values.
+	var x64, y64 []float64
+	for _, x := range signed {
+		for _, y := range positive {
+			x64, y64 = append(x64, x), append(y64, y)
+		}
+	}
+	x32, y32 := make([]float32, len(x64)), make([]float32, len(y64))
+	for i := range x64 {
+		x32[i], y32[i] = float32(x64[i]), float32(y64[i])
+	}
+	n := len(x64)
+	a32, b32 := make([]float32, n), make([]float32, n)
+	a64, b64 := make([]float64, n), make([]float64, n)
+	// check compares got32 and got64 with ref evaluated on the i-th
+	// elements of the float32 and float64 arguments, allowing max64 ULPs
+	// in double precision.
+	check := func(name string, ref func(args ...float64) *big.Float, args32 [][]float32, args64 [][]float64, got32 []float32, got64 []float64, max64 uint64) {
+		t.Helper()
+		for i := 0; i < n; i++ {
+			var in32, in64 []float64
+			for j := range args32 {
+				in32 = append(in32, float64(args32[j][i]))
+				in64 = append(in64, args64[j][i])
+			}
+			if want, _ := ref(in32...).Float32(); ulps32(got32[i], want) > max32 {
+				t.Errorf("%sf%v = %v; want %v", name, in32, got32[i], want)
+			}
+			if want, _ := ref(in64...).Float64(); ulps64(got64[i], want) > max64 {
+				t.Errorf("%s%v = %v; want %v", name, in64, got64[i], want)
+			}
+		}
+	}
+	pow := func(a ...float64) *big.Float { return refExp(newRef().Mul(refFloat(a[1]), refLog(refFloat(a[0])))) }
+	atan2 := func(a ...float64) *big.Float { return refAtan2(refFloat(a[0]), refFloat(a[1])) }
+	Vvpowf(y32, x32, a32)
+	Vvpow(y64, x64, a64)
+	// math.Pow, behind the pure Go Vvpow, is only accurate to a few ULPs
+	// for results far from 1.
+	check("Vvpow", pow, [][]float32{y32, x32}, [][]float64{y64, x64}, a32, a64, 4)
+	Vvatan2f(x32, y32, a32)
+	Vvatan2(x64, y64, a64)
+	check("Vvatan2", atan2, [][]float32{x32, y32}, [][]float64{x64, y64}, a32, a64, max64)
+	Vvatan2f(y32, x32, a32)
+	Vvatan2(y64, x64, a64)
+	check("Vvatan2", atan2, [][]float32{y32, x32}, [][]float64{y64, x64}, a32, a64, max64)
+	Vvsincosf(x32, a32, b32)
+	Vvsincos(x64, a64, b64)
+	check("Vvsincos sin", func(a ...float64) *big.Float { s, _ := refSinCos(refFloat(a[0])); return s },
+		[][]float32{x32}, [][]float64{x64}, a32, a64, max64)
+	check("Vvsincos cos", func(a ...float64) *big.Float { _, c := refSinCos(refFloat(a[0])); return c },
+		[][]float32{x32}, [][]float64{x64}, b32, b64, max64)
+}
+
+func TestVForceLengths(t *testing.T) {
+	// A longer input is fine: the output decides how many values are computed.
+	output := make([]float32, 2)
+	Vvsqrtf([]float32{4, 9, 16}, output)
+	if output[0] != 2 || output[1] != 3 {
+		t.Errorf("Vvsqrtf with a longer input = %v; want [2 3]", output)
+	}
+
+	for name, f := range map[string]func(){
+		"Vvexpf":             func() { Vvexpf(make([]float32, 2), make([]float32, 3)) },
+		"Vvpow":              func() { Vvpow(make([]float64, 3), make([]float64, 2), make([]float64, 3)) },
+		"Vvatan2f":           func() { Vvatan2f(make([]float32, 3), make([]float32, 1), make([]float32, 3)) },
+		"Vvsincosf":          func() { Vvsincosf(make([]float32, 2), make([]float32, 3), make([]float32, 3)) },
+		"Vvsincos short cos": func() { Vvsincos(make([]float64, 3), make([]float64, 3), make([]float64, 2)) },
+		"Vvsincos long cos":  func() { Vvsincos(make([]float64, 3), make([]float64, 3), make([]float64, 4)) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != ErrLengthMismatch {
+					t.Errorf("%s panicked with %v; want %v", name, r, ErrLengthMismatch)
+				}
+			}()
+			f()
+		}()
+	}
+}
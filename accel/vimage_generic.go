@@ -0,0 +1,391 @@
+package accel
+
+// Portable implementations of the vImage routines. Buffers use the same
+// layout as vImage_Buffer: Height rows of RowBytes bytes each.
+
+const edgeFlags = VImageFlagCopyInPlace | VImageFlagBackgroundColorFill | VImageFlagEdgeExtend | VImageFlagTruncateKernel
+
+func checkBuffer(buf *VImageBuffer, bytesPerPixel int) error {
+	if buf == nil || len(buf.Data) == 0 {
+		return ErrImageNullPointerArgument
+	}
+	if buf.Width < 0 || buf.Height < 0 || buf.RowBytes < buf.Width*bytesPerPixel {
+		return ErrImageInvalidParameter
+	}
+	if buf.Height > 0 && len(buf.Data) < (buf.Height-1)*buf.RowBytes+buf.Width*bytesPerPixel {
+		return ErrImageInvalidParameter
+	}
+	return nil
+}
+
+func checkSameSize(bytesPerPixel int, bufs ...*VImageBuffer) error {
+	for _, b := range bufs {
+		if err := checkBuffer(b, bytesPerPixel); err != nil {
+			return err
+		}
+	}
+	for _, b := range bufs[1:] {
+		if b.Width != bufs[0].Width || b.Height != bufs[0].Height {
+			return ErrImageBufferSizeMismatch
+		}
+	}
+	return nil
+}
+
+// checkConvolve validates the arguments shared by the convolution family.
+func checkConvolve(src, dst *VImageBuffer, bytesPerPixel, roiX, roiY, kernelLen, kernelHeight, kernelWidth int, flags VImageFlag) error {
+	if err := checkBuffer(src, bytesPerPixel); err != nil {
+		return err
+	}
+	if err := checkBuffer(dst, bytesPerPixel); err != nil {
+		return err
+	}
+	if kernelHeight <= 0 || kernelWidth <= 0 || kernelHeight%2 == 0 || kernelWidth%2 == 0 {
+		return ErrImageInvalidKernelSize
+	}
+	if kernelLen < kernelHeight*kernelWidth {
+		return ErrImageInvalidParameter
+	}
+	if edge := flags & edgeFlags; edge == 0 || edge&(edge-1) != 0 {
+		return ErrImageInvalidEdgeStyle
+	}
+	if roiX < 0 || roiX > src.Width {
+		return ErrImageInvalidOffsetX
+	}
+	if roiY < 0 || roiY > src.Height {
+		return ErrImageInvalidOffsetY
+	}
+	if roiX+dst.Width > src.Width || roiY+dst.Height > src.Height {
+		return ErrImageRoiLargerThanInputBuffer
+	}
+	return nil
+}
+
+func clampInt(v, low, high int) int {
+	if v < low {
+		return low
+	}
+	if v > high {
+		return high
+	}
+	return v
+}
+
+func clampUint8(v int) uint8 {
+	return uint8(clampInt(v, 0, 255))
+}
+
+func vImagePermuteChannelsARGB8888Generic(src, dst *VImageBuffer, permuteMap [4]uint8, flags VImageFlag) error {
+	if err := checkSameSize(4, src, dst); err != nil {
+		return err
+	}
+	for _, p := range permuteMap {
+		if p > 3 {
+			return ErrImageInvalidParameter
+		}
+	}
+	for y := 0; y < dst.Height; y++ {
+		s := src.Data[y*src.RowBytes : y*src.RowBytes+src.Width*4]
+		d := dst.Data[y*dst.RowBytes : y*dst.RowBytes+dst.Width*4]
+		for x := 0; x < len(d); x += 4 {
+			px := [4]uint8{s[x], s[x+1], s[x+2], s[x+3]}
+			for c, p := range permuteMap {
+				d[x+c] = px[p]
+			}
+		}
+	}
+	return nil
+}
+
+func vImageAlphaBlendARGB8888Generic(srcTop, srcBottom, dst *VImageBuffer, flags VImageFlag) error {
+	if err := checkSameSize(4, srcTop, srcBottom, dst); err != nil {
+		return err
+	}
+	for y := 0; y < dst.Height; y++ {
+		top := srcTop.Data[y*srcTop.RowBytes:]
+		bottom := srcBottom.Data[y*srcBottom.RowBytes:]
+		d := dst.Data[y*dst.RowBytes:]
+		for x := 0; x < dst.Width*4; x += 4 {
+			topA := int(top[x])
+			bottomA := ((255-topA)*int(bottom[x]) + 127) / 255
+			a := (topA*255 + (255-topA)*int(bottom[x]) + 127) / 255
+			var px [4]uint8
+			px[0] = uint8(a)
+			if a != 0 {
+				for c := 1; c < 4; c++ {
+					px[c] = clampUint8((topA*int(top[x+c]) + bottomA*int(bottom[x+c]) + a/2) / a)
+				}
+			}
+			copy(d[x:x+4], px[:])
+		}
+	}
+	return nil
+}
+
+func vImagePremultipliedConstAlphaBlendARGB8888Generic(srcTop *VImageBuffer, constAlpha uint8, srcBottom, dst *VImageBuffer, flags VImageFlag) error {
+	if err := checkSameSize(4, srcTop, srcBottom, dst); err != nil {
+		return err
+	}
+	ca := int(constAlpha)
+	for y := 0; y < dst.Height; y++ {
+		top := srcTop.Data[y*srcTop.RowBytes:]
+		bottom := srcBottom.Data[y*srcBottom.RowBytes:]
+		d := dst.Data[y*dst.RowBytes:]
+		for x := 0; x < dst.Width*4; x += 4 {
+			inv := 255 - (int(top[x])*ca+127)/255
+			var px [4]uint8
+			for c := 0; c < 4; c++ {
+				px[c] = clampUint8((int(top[x+c])*ca + inv*int(bottom[x+c]) + 127) / 255)
+			}
+			copy(d[x:x+4], px[:])
+		}
+	}
+	return nil
+}
+
+func vImageConvolveARGB8888Generic(src, dst *VImageBuffer, roiX, roiY int, kernel []int16, kernelHeight, kernelWidth, divisor int, backgroundColor [4]uint8, flags VImageFlag) error {
+	if err := checkConvolve(src, dst, 4, roiX, roiY, len(kernel), kernelHeight, kernelWidth, flags); err != nil {
+		return err
+	}
+	if flags&VImageFlagGetTempBufferSize != 0 {
+		return nil
+	}
+	if divisor == 0 {
+		divisor = 1
+	}
+	kernelSum := 0
+	for _, k := range kernel[:kernelHeight*kernelWidth] {
+		kernelSum += int(k)
+	}
+	firstChannel := 0
+	if flags&VImageFlagLeaveAlphaUnchanged != 0 {
+		firstChannel = 1
+	}
+	edge := flags & edgeFlags
+	kh2, kw2 := kernelHeight/2, kernelWidth/2
+	for y := 0; y < dst.Height; y++ {
+		sy := y + roiY
+		for x := 0; x < dst.Width; x++ {
+			sx := x + roiX
+			d := dst.Data[y*dst.RowBytes+x*4:]
+			s := src.Data[sy*src.RowBytes+sx*4:]
+			inside := sy-kh2 >= 0 && sy+kh2 < src.Height && sx-kw2 >= 0 && sx+kw2 < src.Width
+			if !inside && edge == VImageFlagCopyInPlace {
+				copy(d[:4], s[:4])
+				continue
+			}
+			d[0] = s[0]
+			for c := firstChannel; c < 4; c++ {
+				sum, used := 0, 0
+				for i := 0; i < kernelHeight; i++ {
+					py := sy + i - kh2
+					for j := 0; j < kernelWidth; j++ {
+						k := int(kernel[i*kernelWidth+j])
+						px := sx + j - kw2
+						if px < 0 || px >= src.Width || py < 0 || py >= src.Height {
+							switch edge {
+							case VImageFlagEdgeExtend:
+								px = clampInt(px, 0, src.Width-1)
+								sum += k * int(src.Data[clampInt(py, 0, src.Height-1)*src.RowBytes+px*4+c])
+							case VImageFlagBackgroundColorFill:
+								sum += k * int(backgroundColor[c])
+							}
+							continue
+						}
+						sum += k * int(src.Data[py*src.RowBytes+px*4+c])
+						used += k
+					}
+				}
+				if edge == VImageFlagTruncateKernel && used != 0 && used != kernelSum {
+					sum = sum * kernelSum / used
+				}
+				d[c] = clampUint8((sum + divisor/2) / divisor)
+			}
+		}
+	}
+	return nil
+}
+
+// convolvePlaneF convolves a single channel stored as float64 values of a
+// w×h image using the edge handling selected by flags.
+func convolvePlaneF(dst, src []float64, w, h int, kernel []float64, kernelHeight, kernelWidth int, background float64, flags VImageFlag) {
+	edge := flags & edgeFlags
+	kernelSum := 0.0
+	for _, k := range kernel[:kernelHeight*kernelWidth] {
+		kernelSum += k
+	}
+	kh2, kw2 := kernelHeight/2, kernelWidth/2
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			inside := y-kh2 >= 0 && y+kh2 < h && x-kw2 >= 0 && x+kw2 < w
+			if !inside && edge == VImageFlagCopyInPlace {
+				dst[y*w+x] = src[y*w+x]
+				continue
+			}
+			sum, used := 0.0, 0.0
+			for i := 0; i < kernelHeight; i++ {
+				py := y + i - kh2
+				for j := 0; j < kernelWidth; j++ {
+					k := kernel[i*kernelWidth+j]
+					px := x + j - kw2
+					if px < 0 || px >= w || py < 0 || py >= h {
+						switch edge {
+						case VImageFlagEdgeExtend:
+							sum += k * src[clampInt(py, 0, h-1)*w+clampInt(px, 0, w-1)]
+						case VImageFlagBackgroundColorFill:
+							sum += k * background
+						}
+						continue
+					}
+					sum += k * src[py*w+px]
+					used += k
+				}
+			}
+			if edge == VImageFlagTruncateKernel && used != 0 {
+				sum *= kernelSum / used
+			}
+			dst[y*w+x] = sum
+		}
+	}
+}
+
+// richardsonLucyPlane runs the Richardson-Lucy iteration
+//
+//	e[n+1] = e[n] * ((I / (e[n] ⊗ kernel)) ⊗ kernel2)
+//
+// on one channel starting from the observed image.
+func richardsonLucyPlane(observed []float64, w, h int, kernel, kernel2 []float64, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2 int, background float64, iterationCount int, flags VImageFlag) []float64 {
+	estimate := append([]float64(nil), observed...)
+	blurred := make([]float64, len(observed))
+	correction := make([]float64, len(observed))
+	for it := 0; it < iterationCount; it++ {
+		convolvePlaneF(blurred, estimate, w, h, kernel, kernelHeight, kernelWidth, background, flags)
+		for i, b := range blurred {
+			if b != 0 {
+				blurred[i] = observed[i] / b
+			} else {
+				blurred[i] = 0
+			}
+		}
+		convolvePlaneF(correction, blurred, w, h, kernel2, kernelHeight2, kernelWidth2, 1, flags)
+		for i, c := range correction {
+			estimate[i] *= c
+		}
+	}
+	return estimate
+}
+
+func vImageRichardsonLucyDeConvolveGeneric(src, dst *VImageBuffer, bytesPerChannel, roiX, roiY int, kernel, kernel2 []float64, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2 int, backgroundColor [4]float64, iterationCount int, flags VImageFlag, read func([]byte) float64, write func([]byte, float64)) error {
+	if err := checkConvolve(src, dst, 4*bytesPerChannel, roiX, roiY, len(kernel), kernelHeight, kernelWidth, flags); err != nil {
+		return err
+	}
+	if kernel2 == nil {
+		kernel2, kernelHeight2, kernelWidth2 = kernel, kernelHeight, kernelWidth
+	} else if kernelHeight2 <= 0 || kernelWidth2 <= 0 || kernelHeight2%2 == 0 || kernelWidth2%2 == 0 {
+		return ErrImageInvalidKernelSize
+	} else if len(kernel2) < kernelHeight2*kernelWidth2 {
+		return ErrImageInvalidParameter
+	}
+	if flags&VImageFlagGetTempBufferSize != 0 {
+		return nil
+	}
+	w, h := src.Width, src.Height
+	plane := make([]float64, w*h)
+	pixelBytes := 4 * bytesPerChannel
+	for c := 0; c < 4; c++ {
+		off := c * bytesPerChannel
+		if c == 0 && flags&VImageFlagLeaveAlphaUnchanged != 0 {
+			for y := 0; y < dst.Height; y++ {
+				for x := 0; x < dst.Width; x++ {
+					s := src.Data[(y+roiY)*src.RowBytes+(x+roiX)*pixelBytes:]
+					copy(dst.Data[y*dst.RowBytes+x*pixelBytes:][:bytesPerChannel], s[:bytesPerChannel])
+				}
+			}
+			continue
+		}
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				plane[y*w+x] = read(src.Data[y*src.RowBytes+x*pixelBytes+off:])
+			}
+		}
+		out := richardsonLucyPlane(plane, w, h, kernel, kernel2, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, backgroundColor[c], iterationCount, flags)
+		for y := 0; y < dst.Height; y++ {
+			for x := 0; x < dst.Width; x++ {
+				write(dst.Data[y*dst.RowBytes+x*pixelBytes+off:], out[(y+roiY)*w+x+roiX])
+			}
+		}
+	}
+	return nil
+}
+
+func vImageRichardsonLucyDeConvolveARGBFFFFGeneric(src, dst *VImageBuffer, roiX, roiY int, kernel, kernel2 []float32, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2 int, backgroundColor [4]float32, iterationCount int, flags VImageFlag) error {
+	var bg [4]float64
+	for i, c := range backgroundColor {
+		bg[i] = float64(c)
+	}
+	read := func(b []byte) float64 { return float64(bytesAsFloat32(b[:4])[0]) }
+	write := func(b []byte, v float64) { bytesAsFloat32(b[:4])[0] = float32(v) }
+	return vImageRichardsonLucyDeConvolveGeneric(src, dst, 4, roiX, roiY, kernelToFloat64(kernel, 1), kernelToFloat64(kernel2, 1),
+		kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, bg, iterationCount, flags, read, write)
+}
+
+func vImageRichardsonLucyDeConvolveARGB8888Generic(src, dst *VImageBuffer, roiX, roiY int, kernel, kernel2 []int16, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, divisor, divisor2 int, backgroundColor [4]uint8, iterationCount int, flags VImageFlag) error {
+	if divisor == 0 {
+		divisor = 1
+	}
+	if divisor2 == 0 {
+		divisor2 = 1
+	}
+	var bg [4]float64
+	for i, c := range backgroundColor {
+		bg[i] = float64(c)
+	}
+	read := func(b []byte) float64 { return float64(b[0]) }
+	write := func(b []byte, v float64) { b[0] = clampUint8(int(v + 0.5)) }
+	return vImageRichardsonLucyDeConvolveGeneric(src, dst, 1, roiX, roiY, kernelToFloat64(kernel, divisor), kernelToFloat64(kernel2, divisor2),
+		kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, bg, iterationCount, flags, read, write)
+}
+
+func kernelToFloat64[T int16 | float32](kernel []T, divisor int) []float64 {
+	if kernel == nil {
+		return nil
+	}
+	out := make([]float64, len(kernel))
+	for i, k := range kernel {
+		out[i] = float64(k) / float64(divisor)
+	}
+	return out
+}
+
+func vImageHistogramCalculationARGB8888Generic(src *VImageBuffer, flags VImageFlag) ([4][]int, error) {
+	if err := checkBuffer(src, 4); err != nil {
+		return [4][]int{}, err
+	}
+	var hist [4][]int
+	for c := range hist {
+		hist[c] = make([]int, 256)
+	}
+	for y := 0; y < src.Height; y++ {
+		row := src.Data[y*src.RowBytes : y*src.RowBytes+src.Width*4]
+		for x := 0; x < len(row); x += 4 {
+			hist[0][row[x]]++
+			hist[1][row[x+1]]++
+			hist[2][row[x+2]]++
+			hist[3][row[x+3]]++
+		}
+	}
+	return hist, nil
+}
+
+func vImageHistogramCalculationPlanar8Generic(src *VImageBuffer, flags VImageFlag) ([]int, error) {
+	if err := checkBuffer(src, 1); err != nil {
+		return nil, err
+	}
+	hist := make([]int, 256)
+	for y := 0; y < src.Height; y++ {
+		for _, v := range src.Data[y*src.RowBytes : y*src.RowBytes+src.Width] {
+			hist[v]++
+		}
+	}
+	return hist, nil
+}
@@ -0,0 +1,55 @@
+//go:build !darwin || !cgo || purego
+
+package accel
+
+// VImagePermuteChannels_ARGB8888 reorders the channels in an ARGB8888 image.
+//
+// permuteMap:
+//
+//	An array of four 8-bit integers with the values 0, 1, 2, and 3,
+//	in some order. Each value specifies a plane from the source image
+//	that should be copied to that plane in the destination image. 0
+//	denotes the alpha channel, 1 the red channel, 2 the green channel,
+//	and 3 the blue channel. The following figure shows the result of
+//	using a permute map shows values are (0, 3, 2, 1). The data in the
+//	alpha and green channels remain the same, but the data in the source
+//	red channel maps to the destination blue channel while the data in
+//	the source blue channel maps to the destination red channel.
+func VImagePermuteChannels_ARGB8888(src, dst *VImageBuffer, permuteMap [4]uint8, flags VImageFlag) error {
+	return vImagePermuteChannelsARGB8888Generic(src, dst, permuteMap, flags)
+}
+
+// VImageAlphaBlend_ARGB8888 performs nonpremultiplied alpha compositing of two ARGB8888 images, placing the result in a destination buffer.
+func VImageAlphaBlend_ARGB8888(srcTop, srcBottom, dst *VImageBuffer, flags VImageFlag) error {
+	return vImageAlphaBlendARGB8888Generic(srcTop, srcBottom, dst, flags)
+}
+
+// VImagePremultipliedConstAlphaBlend_ARGB8888 performs premultiplied alpha compositing of two ARGB8888 images, using a single alpha value for the whole image and placing the result in a destination buffer.
+func VImagePremultipliedConstAlphaBlend_ARGB8888(srcTop *VImageBuffer, constAlpha uint8, srcBottom, dst *VImageBuffer, flags VImageFlag) error {
+	return vImagePremultipliedConstAlphaBlendARGB8888Generic(srcTop, constAlpha, srcBottom, dst, flags)
+}
+
+// VImageRichardsonLucyDeConvolve_ARGBFFFF sharpens an ARGBFFFF image by undoing a previous convolution that blurred the image, such as diffraction effects in a camera lens.
+func VImageRichardsonLucyDeConvolve_ARGBFFFF(src, dst *VImageBuffer, tempBuffer []byte, roiX, roiY int, kernel, kernel2 []float32, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2 int, backgroundColor [4]float32, iterationCount int, flags VImageFlag) error {
+	return vImageRichardsonLucyDeConvolveARGBFFFFGeneric(src, dst, roiX, roiY, kernel, kernel2, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, backgroundColor, iterationCount, flags)
+}
+
+// VImageRichardsonLucyDeConvolve_ARGB8888 sharpens an ARGB8888 image by undoing a previous convolution that blurred the image, such as diffraction effects in a camera lens.
+func VImageRichardsonLucyDeConvolve_ARGB8888(src, dst *VImageBuffer, tempBuffer []byte, roiX, roiY int, kernel, kernel2 []int16, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, divisor, divisor2 int, backgroundColor [4]uint8, iterationCount int, flags VImageFlag) error {
+	return vImageRichardsonLucyDeConvolveARGB8888Generic(src, dst, roiX, roiY, kernel, kernel2, kernelHeight, kernelWidth, kernelHeight2, kernelWidth2, divisor, divisor2, backgroundColor, iterationCount, flags)
+}
+
+// VImageConvolve_ARGB8888 convolves a region of interest within a source image by an M x N kernel, then divides the pixel values by a divisor.
+func VImageConvolve_ARGB8888(src, dst *VImageBuffer, tempBuffer []byte, roiX, roiY int, kernel []int16, kernelHeight, kernelWidth, divisor int, backgroundColor [4]uint8, flags VImageFlag) error {
+	return vImageConvolveARGB8888Generic(src, dst, roiX, roiY, kernel, kernelHeight, kernelWidth, divisor, backgroundColor, flags)
+}
+
+// Calculates histograms for each channel of an ARGB8888 image.
+func VImageHistogramCalculation_ARGB8888(src *VImageBuffer, flags VImageFlag) ([4][]int, error) {
+	return vImageHistogramCalculationARGB8888Generic(src, flags)
+}
+
+// Calculates a histogram for a Planar8 image.
+func VImageHistogramCalculation_Planar8(src *VImageBuffer, flags VImageFlag) ([]int, error) {
+	return vImageHistogramCalculationPlanar8Generic(src, flags)
+}
@@ -0,0 +1,212 @@
+// Package window generates window functions for spectral analysis and
+// filter design, and reports the figures of merit needed to read
+// amplitudes and noise levels off a windowed FFT.
+//
+// accel only wraps the Hann, Hamming and Blackman windows built into vDSP,
+// all in their periodic form. Every window here comes in two forms:
+// Symmetric windows (w[n] == w[N-1-n]) are the ones to use for FIR filter
+// design, while Periodic windows are the first N points of the symmetric
+// window of length N+1 and are the ones to use with the DFT.
+//
+// The generators fill dst and return it, so they work for float32 and
+// float64 and can be used inline:
+//
+//	w := window.Kaiser(make([]float32, 1024), 8.6, window.Periodic)
+package window
+
+import "math"
+
+// Float is the set of element types supported by the package.
+type Float interface {
+	float32 | float64
+}
+
+// Form selects the symmetric or periodic form of a window.
+type Form int
+
+const (
+	Symmetric Form = iota
+	Periodic
+)
+
+// fill evaluates f(n, m) for n in [0, len(dst)) where m is the index of
+// the last point of the symmetric window (N-1 or, for the periodic form,
+// N).
+func fill[T Float](dst []T, form Form, f func(n, m float64) float64) []T {
+	m := len(dst) - 1
+	if form == Periodic {
+		m++
+	}
+	if m == 0 {
+		for i := range dst {
+			dst[i] = 1
+		}
+		return dst
+	}
+	for i := range dst {
+		dst[i] = T(f(float64(i), float64(m)))
+	}
+	return dst
+}
+
+// cosineSum fills dst with the generalised cosine window
+// Σ (-1)^k a[k] cos(2πkn/M).
+func cosineSum[T Float](dst []T, form Form, a ...float64) []T {
+	return fill(dst, form, func(n, m float64) float64 {
+		var sum, sign float64 = 0, 1
+		for k, c := range a {
+			sum += sign * c * math.Cos(2*math.Pi*float64(k)*n/m)
+			sign = -sign
+		}
+		return sum
+	})
+}
+
+// Rectangular fills dst with ones.
+func Rectangular[T Float](dst []T) []T {
+	for i := range dst {
+		dst[i] = 1
+	}
+	return dst
+}
+
+// Hann fills dst with a Hann window, 0.5 - 0.5cos(2πn/M).
+func Hann[T Float](dst []T, form Form) []T {
+	return cosineSum(dst, form, 0.5, 0.5)
+}
+
+// Hamming fills dst with a Hamming window, 0.54 - 0.46cos(2πn/M).
+func Hamming[T Float](dst []T, form Form) []T {
+	return cosineSum(dst, form, 0.54, 0.46)
+}
+
+// Blackman fills dst with the classic Blackman window.
+func Blackman[T Float](dst []T, form Form) []T {
+	return cosineSum(dst, form, 0.42, 0.5, 0.08)
+}
+
+// BlackmanHarris fills dst with the minimum 4-term Blackman-Harris window
+// (92 dB sidelobes).
+func BlackmanHarris[T Float](dst []T, form Form) []T {
+	return cosineSum(dst, form, 0.35875, 0.48829, 0.14128, 0.01168)
+}
+
+// Nuttall fills dst with Nuttall's minimum 4-term window with a continuous
+// first derivative, as in SciPy.
+func Nuttall[T Float](dst []T, form Form) []T {
+	return cosineSum(dst, form, 0.3635819, 0.4891775, 0.1365995, 0.0106411)
+}
+
+// FlatTop fills dst with a flat-top window. Its passband is flat to within
+// about 0.01 dB, so a tone's amplitude can be read from the peak bin
+// wherever it falls between bins.
+func FlatTop[T Float](dst []T, form Form) []T {
+	return cosineSum(dst, form, 0.21557895, 0.41663158, 0.277263158, 0.083578947, 0.006947368)
+}
+
+// Bartlett fills dst with a triangular window that is zero at both ends
+// of the symmetric form.
+func Bartlett[T Float](dst []T, form Form) []T {
+	return fill(dst, form, func(n, m float64) float64 {
+		return 1 - math.Abs(2*n/m-1)
+	})
+}
+
+// Kaiser fills dst with a Kaiser window with shape parameter beta. beta 0
+// is rectangular; larger values trade a wider main lobe for lower
+// sidelobes (about 5 for a Hamming-like and 8.6 for a Blackman-like
+// window).
+func Kaiser[T Float](dst []T, beta float64, form Form) []T {
+	scale := 1 / besselI0(beta)
+	return fill(dst, form, func(n, m float64) float64 {
+		x := 2*n/m - 1
+		return besselI0(beta*math.Sqrt(max(1-x*x, 0))) * scale
+	})
+}
+
+// Tukey fills dst with a tapered cosine window: cosine tapers cover a
+// fraction alpha of the window, split between the two ends, and the rest
+// is flat. alpha 0 is rectangular and 1 is Hann.
+func Tukey[T Float](dst []T, alpha float64, form Form) []T {
+	if alpha <= 0 {
+		return Rectangular(dst)
+	}
+	alpha = min(alpha, 1)
+	return fill(dst, form, func(n, m float64) float64 {
+		width := alpha * m / 2
+		switch {
+		case n < width:
+			return 0.5 * (1 - math.Cos(math.Pi*n/width))
+		case n > m-width:
+			return 0.5 * (1 - math.Cos(math.Pi*(m-n)/width))
+		}
+		return 1
+	})
+}
+
+// Gaussian fills dst with a Gaussian window whose standard deviation is
+// sigma samples.
+func Gaussian[T Float](dst []T, sigma float64, form Form) []T {
+	return fill(dst, form, func(n, m float64) float64 {
+		x := (n - m/2) / sigma
+		return math.Exp(-0.5 * x * x)
+	})
+}
+
+// besselI0 evaluates the modified Bessel function of the first kind of
+// order zero with its power series, which converges quickly for the
+// arguments used by Kaiser windows.
+func besselI0(x float64) float64 {
+	sum, term := 1.0, 1.0
+	q := x * x / 4
+	for k := 1; term > sum*1e-17; k++ {
+		term *= q / float64(k*k)
+		sum += term
+	}
+	return sum
+}
+
+// CoherentGain returns the DC gain of w normalised by its length,
+// Σw[n]/N. Divide an FFT magnitude by N times the coherent gain to read a
+// tone's amplitude.
+func CoherentGain[T Float](w []T) float64 {
+	if len(w) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range w {
+		sum += float64(v)
+	}
+	return sum / float64(len(w))
+}
+
+// ENBW returns the equivalent noise bandwidth of w in bins,
+// N·Σw[n]²/(Σw[n])². Multiply by the bin width to get it in Hz.
+func ENBW[T Float](w []T) float64 {
+	if len(w) == 0 {
+		return 0
+	}
+	var sum, sumSq float64
+	for _, v := range w {
+		sum += float64(v)
+		sumSq += float64(v) * float64(v)
+	}
+	return float64(len(w)) * sumSq / (sum * sum)
+}
+
+// ScallopingLoss returns the worst-case attenuation in dB (a positive
+// number) of a tone that falls halfway between two bins, relative to one
+// centred on a bin.
+func ScallopingLoss[T Float](w []T) float64 {
+	if len(w) == 0 {
+		return 0
+	}
+	var sum, re, im float64
+	for i, v := range w {
+		s, c := math.Sincos(-math.Pi * float64(i) / float64(len(w)))
+		sum += float64(v)
+		re += float64(v) * c
+		im += float64(v) * s
+	}
+	return -20 * math.Log10(math.Hypot(re, im)/sum)
+}
@@ -0,0 +1,117 @@
+package window
+
+import (
+	"math"
+	"testing"
+)
+
+func checkWindow(t *testing.T, name string, got []float64, want []float64, tol float64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s has %d points; want %d", name, len(got), len(want))
+	}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > tol {
+			t.Errorf("%s = %v; want %v", name, got, want)
+			return
+		}
+	}
+}
+
+func TestWindowValues(t *testing.T) {
+	// Reference values from scipy.signal.get_window.
+	checkWindow(t, "Hann", Hann(make([]float64, 5), Symmetric), []float64{0, 0.5, 1, 0.5, 0}, 1e-15)
+	checkWindow(t, "periodic Hann", Hann(make([]float64, 4), Periodic), []float64{0, 0.5, 1, 0.5}, 1e-15)
+	checkWindow(t, "Bartlett", Bartlett(make([]float64, 5), Symmetric), []float64{0, 0.5, 1, 0.5, 0}, 1e-15)
+	checkWindow(t, "Hamming", Hamming(make([]float64, 3), Symmetric), []float64{0.08, 1, 0.08}, 1e-15)
+	checkWindow(t, "Tukey", Tukey(make([]float64, 5), 0.5, Symmetric), []float64{0, 1, 1, 1, 0}, 1e-15)
+	checkWindow(t, "Tukey 1", Tukey(make([]float64, 7), 1, Symmetric), Hann(make([]float64, 7), Symmetric), 1e-15)
+	checkWindow(t, "Tukey 0", Tukey(make([]float64, 3), 0, Periodic), []float64{1, 1, 1}, 0)
+	checkWindow(t, "Gaussian", Gaussian(make([]float64, 5), 1, Symmetric),
+		[]float64{math.Exp(-2), math.Exp(-0.5), 1, math.Exp(-0.5), math.Exp(-2)}, 1e-15)
+	checkWindow(t, "Kaiser 0", Kaiser(make([]float64, 4), 0, Symmetric), []float64{1, 1, 1, 1}, 1e-15)
+	// I0(5) = 27.239871823604442.
+	kaiser := Kaiser(make([]float64, 9), 5, Symmetric)
+	if want := 1 / 27.239871823604442; math.Abs(kaiser[0]-want) > 1e-15 || kaiser[4] != 1 {
+		t.Errorf("Kaiser ends = %g, centre = %g; want %g and 1", kaiser[0], kaiser[4], want)
+	}
+	checkWindow(t, "Blackman-Harris", BlackmanHarris(make([]float64, 3), Symmetric), []float64{6e-5, 1, 6e-5}, 1e-12)
+	checkWindow(t, "Nuttall", Nuttall(make([]float64, 3), Symmetric), []float64{0.0003628, 1, 0.0003628}, 1e-12)
+	checkWindow(t, "flat-top", FlatTop(make([]float64, 3), Symmetric), []float64{-0.000421051, 1.000000003, -0.000421051}, 1e-9)
+	checkWindow(t, "single point", Blackman(make([]float64, 1), Symmetric), []float64{1}, 0)
+}
+
+func TestWindowForms(t *testing.T) {
+	gens := map[string]func(dst []float64, form Form) []float64{
+		"Hann":     Hann[float64],
+		"Hamming":  Hamming[float64],
+		"Blackman": Blackman[float64],
+		"Nuttall":  Nuttall[float64],
+		"Bartlett": Bartlett[float64],
+		"FlatTop":  FlatTop[float64],
+		"Kaiser":   func(dst []float64, form Form) []float64 { return Kaiser(dst, 7, form) },
+		"Tukey":    func(dst []float64, form Form) []float64 { return Tukey(dst, 0.3, form) },
+		"Gaussian": func(dst []float64, form Form) []float64 { return Gaussian(dst, 4, form) },
+	}
+	for name, gen := range gens {
+		sym := gen(make([]float64, 33), Symmetric)
+		for i := range sym {
+			if math.Abs(sym[i]-sym[len(sym)-1-i]) > 1e-12 {
+				t.Errorf("%s: symmetric form is not symmetric at %d", name, i)
+				break
+			}
+		}
+		periodic := gen(make([]float64, 32), Periodic)
+		checkWindow(t, name+" periodic", periodic, sym[:32], 1e-12)
+	}
+}
+
+func TestWindowFloat32(t *testing.T) {
+	w32 := Kaiser(make([]float32, 64), 8.6, Periodic)
+	w64 := Kaiser(make([]float64, 64), 8.6, Periodic)
+	for i := range w32 {
+		if float64(w32[i]) != float64(float32(w64[i])) {
+			t.Fatalf("float32 Kaiser [%d] = %g; want %g", i, w32[i], w64[i])
+		}
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	const n = 4096
+	cases := []struct {
+		name              string
+		w                 []float64
+		gain, enbw, scall float64
+	}{
+		// Figures from Harris, "On the use of windows for harmonic analysis
+		// with the discrete Fourier transform" (1978).
+		{"rectangular", Rectangular(make([]float64, n)), 1, 1, 3.92},
+		{"Hann", Hann(make([]float64, n), Periodic), 0.5, 1.5, 1.42},
+		{"Hamming", Hamming(make([]float64, n), Periodic), 0.54, 1.36, 1.75},
+		{"Blackman-Harris", BlackmanHarris(make([]float64, n), Periodic), 0.36, 2.00, 0.83},
+		{"Bartlett", Bartlett(make([]float64, n), Periodic), 0.5, 1.33, 1.82},
+	}
+	for _, c := range cases {
+		if g := CoherentGain(c.w); math.Abs(g-c.gain) > 0.005 {
+			t.Errorf("%s: coherent gain %f; want %f", c.name, g, c.gain)
+		}
+		if e := ENBW(c.w); math.Abs(e-c.enbw) > 0.01 {
+			t.Errorf("%s: ENBW %f; want %f", c.name, e, c.enbw)
+		}
+		if s := ScallopingLoss(c.w); math.Abs(s-c.scall) > 0.01 {
+			t.Errorf("%s: scalloping loss %f dB; want %f", c.name, s, c.scall)
+		}
+	}
+	if s := ScallopingLoss(FlatTop(make([]float32, n), Periodic)); math.Abs(s) > 0.02 {
+		t.Errorf("flat-top scalloping loss %f dB; want about 0", s)
+	}
+	if g := CoherentGain([]float32{}); g != 0 {
+		t.Errorf("CoherentGain of empty window = %f; want 0", g)
+	}
+	if e := ENBW([]float32{}); e != 0 {
+		t.Errorf("ENBW of empty window = %f; want 0", e)
+	}
+	if s := ScallopingLoss([]float32{}); s != 0 {
+		t.Errorf("ScallopingLoss of empty window = %f; want 0", s)
+	}
+}
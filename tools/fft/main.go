@@ -12,6 +12,7 @@ import (
 	"os"
 
 	"github.com/samuel/go-accelerate/accel"
+	"github.com/samuel/go-accelerate/accel/window"
 )
 
 var (
@@ -24,7 +25,7 @@ var (
 	flagMaxHeight    = flag.Int("maxHeight", 480, "Max height of image.")
 	flagHeight       = flag.Int("height", 0, "Height of output image (default is 0 meaning to make it up to maxHeight or out of samples)")
 	flagWidth        = flag.Int("width", 640, "Width of output image")
-	flagWindow       = flag.String("window", "hanning", "Window function (hanning, hamming, blackman, triangle)")
+	flagWindow       = flag.String("window", "hanning", "Window function (hanning, hamming, blackman, triangle, bartlett, blackmanharris, nuttall, flattop, kaiser)")
 )
 
 var windowFuncs = map[string]func([]float32){
@@ -42,6 +43,21 @@ var windowFuncs = map[string]func([]float32){
 			output[n] = float32(1 - math.Abs((float64(n)-float64(len(output)-1)/2.0)/(float64(len(output)+1)/2.0)))
 		}
 	},
+	"bartlett": func(output []float32) {
+		window.Bartlett(output, window.Periodic)
+	},
+	"blackmanharris": func(output []float32) {
+		window.BlackmanHarris(output, window.Periodic)
+	},
+	"nuttall": func(output []float32) {
+		window.Nuttall(output, window.Periodic)
+	},
+	"flattop": func(output []float32) {
+		window.FlatTop(output, window.Periodic)
+	},
+	"kaiser": func(output []float32) {
+		window.Kaiser(output, 8.6, window.Periodic)
+	},
 }
 
 var gradient = [13]color.RGBA{
@@ -163,12 +179,6 @@ func main() {
 		Real: make([]float32, nSamples),
 		Imag: make([]float32, nSamples),
 	}
-	// Align the buffers to 16-byte boundaries to allow SIMD to do its thing
-	// var data accel.DSPSplitComplex
-	// sampleBuf := make([]float32, (nSamples+4)*2)
-	// data.Real = sampleBuf[:nSamples]
-	// data.Imag = sampleBuf[nSamples : nSamples*2]
-	// println(uintptr(unsafe.Pointer(&data.Imag[0])) & 0xf)
 
 	img := image.NewRGBA(image.Rect(0, 0, width, height))
 
@@ -201,7 +211,7 @@ func main() {
 		maxM := accel.Maxv(data.Real, 1)
 		if !*flagScaleLinear {
 			accel.Vsdiv(data.Real, 1, maxM, data.Real, 1)
-			accel.Vvlog10f(data.Real, data.Real)
+			accel.Vvlog10_float(data.Real, data.Real)
 			if scale == 0.0 {
 				// mean := accel.Meanv(data.Real, 1)
 				// scale = 1 / (mean * float32(*flagScaleRatio))